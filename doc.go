@@ -1,8 +1,12 @@
 // Package ros7api implements a client for the ROS7 (Mikrotik RouterOS 7) REST
-// API (https://help.mikrotik.com/docs/display/ROS/REST+API).
+// API (https://help.mikrotik.com/docs/display/ROS/REST+API). The binary API
+// (https://help.mikrotik.com/docs/display/ROS/API) is also supported.
 //
 // The API types are autogenerated from a high-level description. The main
-// client and generated types are in the ros subpackage.
+// client and generated types are in the ros subpackage. A fake ROS server for
+// use in tests is in the rostest subpackage. Declarative configuration of
+// menus is implemented in the reconcile subpackage, and running operations
+// across many devices in the fleet subpackage.
 //
 // The gen subpackage contains the code generator used to generate the API
 // client types from a Protobuf description contained in gen/types.text.pb.
@@ -37,20 +37,23 @@ func Example() {
 	}
 
 	// Add ether8 if needed.
+	desired := *vl3005
+	desired.Tagged = append(ros.StringList(nil), vl3005.Tagged...)
 	add := true
-	tagged := vl3005.Tagged
-	for _, t := range tagged {
+	for _, t := range desired.Tagged {
 		if t == "ether8" {
 			add = false
 			break
 		}
 	}
-
 	if add {
-		tagged = append(tagged, "ether8")
-		_, err = c.InterfaceBridgeVlanPatch(ctx, vl3005.ID, &ros.InterfaceBridgeVlan_Update{
-			Tagged: &tagged,
-		})
+		desired.Tagged = append(desired.Tagged, "ether8")
+	}
+
+	// Only the changed fields are sent, and nothing if nothing changed.
+	u := ros.DiffInterfaceBridgeVlan(vl3005, &desired)
+	if !u.IsEmpty() {
+		_, err = c.InterfaceBridgeVlanPatch(ctx, vl3005.ID, u)
 		if err != nil {
 			log.Fatalf("Could not update vlan: %v", err)
 		}
@@ -0,0 +1,345 @@
+// Package fleet runs operations against many ROS devices concurrently. Devices
+// are described by an inventory loaded from YAML or JSON, eg.:
+//
+//	credentials: netrc
+//	devices:
+//	  - name: sw1.waw
+//	    address: 10.0.0.1
+//	    tags: [switch, waw]
+//	  - name: sw2.waw
+//	    address: 10.0.0.2
+//	    credentials: file:/etc/ros7api/sw2
+//	    tags: [switch, waw]
+//
+// Credentials are given by reference (see ParseCredentials), and default to
+// the top-level credentials of the inventory.
+package fleet
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/q3k/ros7api/ros"
+)
+
+// Device is a ROS device in the inventory.
+type Device struct {
+	// Name of the device, unique within the inventory, eg. sw1.waw.
+	Name string `yaml:"name"`
+	// Address of the device, as in ros.Client.Address.
+	Address string `yaml:"address"`
+	// Credentials is a reference to the credentials used to connect to the
+	// device, as accepted by ParseCredentials. If not set, the inventory's
+	// default is used.
+	Credentials string `yaml:"credentials"`
+	// Tags of the device, eg. switch or waw, used to select devices.
+	Tags []string `yaml:"tags"`
+}
+
+// HasTags returns whether the device has all the given tags.
+func (d *Device) HasTags(tags ...string) bool {
+	for _, t := range tags {
+		found := false
+		for _, dt := range d.Tags {
+			if dt == t {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Fleet is an inventory of devices, and the configuration used to run
+// operations against them. Clients are created on first use and reused across
+// runs.
+type Fleet struct {
+	// Devices in the inventory.
+	Devices []*Device
+	// Credentials is the default credentials reference, used for devices
+	// which don't have any set. If not set either, netrc is used.
+	Credentials string
+
+	// Concurrency is the maximum number of devices operated on at once. If
+	// not set, 10 is used.
+	Concurrency int
+	// Timeout for an operation on a single device. If not set, operations
+	// are only limited by the context passed to Run.
+	Timeout time.Duration
+	// NewClient, if set, is used to create clients for devices instead of
+	// the default REST client using the device's credentials. It can eg.
+	// configure a different HTTP client or Transport.
+	NewClient func(d *Device, creds ros.Credentials) (*ros.Client, error)
+
+	mu      sync.Mutex
+	clients map[string]*ros.Client
+}
+
+// inventory is the serialized form of an inventory.
+type inventory struct {
+	Credentials string    `yaml:"credentials"`
+	Devices     []*Device `yaml:"devices"`
+}
+
+// Parse parses a YAML or JSON inventory.
+func Parse(data []byte) (*Fleet, error) {
+	var inv inventory
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(&inv); err != nil {
+		return nil, fmt.Errorf("invalid inventory: %w", err)
+	}
+	if _, err := ParseCredentials(inv.Credentials); err != nil {
+		return nil, fmt.Errorf("invalid default credentials: %w", err)
+	}
+	names := make(map[string]bool)
+	for i, d := range inv.Devices {
+		if d.Name == "" {
+			return nil, fmt.Errorf("device %d: name not set", i)
+		}
+		if d.Address == "" {
+			return nil, fmt.Errorf("device %q: address not set", d.Name)
+		}
+		if names[d.Name] {
+			return nil, fmt.Errorf("device %q: duplicate name", d.Name)
+		}
+		names[d.Name] = true
+		if _, err := ParseCredentials(d.Credentials); err != nil {
+			return nil, fmt.Errorf("device %q: invalid credentials: %w", d.Name, err)
+		}
+	}
+	return &Fleet{
+		Devices:     inv.Devices,
+		Credentials: inv.Credentials,
+	}, nil
+}
+
+// Load loads a YAML or JSON inventory from a file.
+func Load(path string) (*Fleet, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	f, err := Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return f, nil
+}
+
+// ParseCredentials returns ros.Credentials from a reference, one of:
+//
+//	netrc              ros.NetrcCredentials reading ~/.netrc
+//	netrc:/path        ros.NetrcCredentials reading the given file
+//	file:/path         ros.FileCredentials reading the given file
+//	env                ros.EnvCredentials reading ROS7API_USERNAME/PASSWORD
+//	env:USER,PASSWORD  ros.EnvCredentials reading the given variables
+//
+// An empty reference is the same as netrc.
+func ParseCredentials(ref string) (ros.Credentials, error) {
+	parts := strings.SplitN(ref, ":", 2)
+	arg := ""
+	if len(parts) == 2 {
+		arg = parts[1]
+	}
+	switch parts[0] {
+	case "", "netrc":
+		return &ros.NetrcCredentials{Path: arg}, nil
+	case "file":
+		if arg == "" {
+			return nil, fmt.Errorf("file credentials need a path")
+		}
+		return &ros.FileCredentials{Path: arg}, nil
+	case "env":
+		if arg == "" {
+			return &ros.EnvCredentials{}, nil
+		}
+		vars := strings.Split(arg, ",")
+		if len(vars) != 2 {
+			return nil, fmt.Errorf("env credentials need two variable names")
+		}
+		return &ros.EnvCredentials{UsernameVar: vars[0], PasswordVar: vars[1]}, nil
+	}
+	return nil, fmt.Errorf("unknown credentials %q", ref)
+}
+
+// Device returns a device by name, or nil if not found.
+func (f *Fleet) Device(name string) *Device {
+	for _, d := range f.Devices {
+		if d.Name == name {
+			return d
+		}
+	}
+	return nil
+}
+
+// Select returns all devices which have all of the given tags, or all devices
+// if no tags are given.
+func (f *Fleet) Select(tags ...string) []*Device {
+	var res []*Device
+	for _, d := range f.Devices {
+		if d.HasTags(tags...) {
+			res = append(res, d)
+		}
+	}
+	return res
+}
+
+// Client returns the client for a device, creating it on first use.
+func (f *Fleet) Client(d *Device) (*ros.Client, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if c, ok := f.clients[d.Name]; ok {
+		return c, nil
+	}
+	ref := d.Credentials
+	if ref == "" {
+		ref = f.Credentials
+	}
+	creds, err := ParseCredentials(ref)
+	if err != nil {
+		return nil, fmt.Errorf("invalid credentials: %w", err)
+	}
+	var c *ros.Client
+	if f.NewClient != nil {
+		c, err = f.NewClient(d, creds)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		c = &ros.Client{
+			Address:     d.Address,
+			Credentials: creds,
+		}
+	}
+	if f.clients == nil {
+		f.clients = make(map[string]*ros.Client)
+	}
+	f.clients[d.Name] = c
+	return c, nil
+}
+
+// Func is an operation run against a single device.
+type Func func(ctx context.Context, d *Device, c *ros.Client) error
+
+// Result is the result of running an operation against a single device.
+type Result struct {
+	Device *Device
+	// Err is the error returned by the operation, or the reason it could
+	// not be run (eg. the context passed to Run being canceled).
+	Err error
+	// Duration is how long the operation took.
+	Duration time.Duration
+}
+
+// DeviceError is an error of an operation on a single device.
+type DeviceError struct {
+	Device string
+	Err    error
+}
+
+func (e *DeviceError) Error() string {
+	return fmt.Sprintf("%s: %v", e.Device, e.Err)
+}
+
+func (e *DeviceError) Unwrap() error {
+	return e.Err
+}
+
+// Errors are the errors of a Run, one per failed device, sorted by device
+// name.
+type Errors []*DeviceError
+
+func (e Errors) Error() string {
+	var parts []string
+	for _, de := range e {
+		parts = append(parts, de.Error())
+	}
+	return fmt.Sprintf("%d device(s) failed: %s", len(e), strings.Join(parts, "; "))
+}
+
+// Is returns whether any of the device errors is target, so that errors.Is can
+// be used to check for eg. context.DeadlineExceeded.
+func (e Errors) Is(target error) bool {
+	for _, de := range e {
+		if errors.Is(de, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// Run runs an operation against the given devices (eg. from Select), at most
+// Concurrency at a time, each with its own Timeout. If the context is canceled,
+// operations in progress see their context canceled, and operations not yet
+// started fail with the context's error. The results are returned in the same
+// order as the devices. If any operation failed, Errors is also returned.
+func (f *Fleet) Run(ctx context.Context, devices []*Device, fn Func) ([]Result, error) {
+	concurrency := f.Concurrency
+	if concurrency <= 0 {
+		concurrency = 10
+	}
+	results := make([]Result, len(devices))
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i, d := range devices {
+		results[i].Device = d
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			results[i].Err = ctx.Err()
+			continue
+		}
+		wg.Add(1)
+		go func(res *Result, d *Device) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			start := time.Now()
+			res.Err = f.runOne(ctx, d, fn)
+			res.Duration = time.Since(start)
+		}(&results[i], d)
+	}
+	wg.Wait()
+
+	var errs Errors
+	for _, res := range results {
+		if res.Err != nil {
+			errs = append(errs, &DeviceError{Device: res.Device.Name, Err: res.Err})
+		}
+	}
+	if len(errs) == 0 {
+		return results, nil
+	}
+	sort.Slice(errs, func(i, j int) bool {
+		return errs[i].Device < errs[j].Device
+	})
+	return results, errs
+}
+
+func (f *Fleet) runOne(ctx context.Context, d *Device, fn Func) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if f.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, f.Timeout)
+		defer cancel()
+	}
+	c, err := f.Client(d)
+	if err != nil {
+		return err
+	}
+	return fn(ctx, d, c)
+}
@@ -0,0 +1,163 @@
+package fleet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/q3k/ros7api/ros"
+	"github.com/q3k/ros7api/rostest"
+)
+
+// TestParse ensures both YAML and JSON inventories are parsed and validated.
+func TestParse(t *testing.T) {
+	f, err := Parse([]byte(`
+credentials: env
+devices:
+  - name: sw1.waw
+    address: 10.0.0.1
+    tags: [switch, waw]
+  - name: sw2.waw
+    address: 10.0.0.2
+    credentials: file:/etc/ros7api/sw2
+    tags: [switch, waw]
+  - name: rtr1.waw
+    address: 10.0.0.254
+    tags: [router, waw]
+`))
+	if err != nil {
+		t.Fatalf("Parse YAML: %v", err)
+	}
+	if want, got := 2, len(f.Select("switch", "waw")); want != got {
+		t.Errorf("wanted %d switches, got %d", want, got)
+	}
+	if want, got := 3, len(f.Select()); want != got {
+		t.Errorf("wanted %d devices, got %d", want, got)
+	}
+	if d := f.Device("sw2.waw"); d == nil || d.Credentials != "file:/etc/ros7api/sw2" {
+		t.Errorf("unexpected device %+v", d)
+	}
+
+	f, err = Parse([]byte(`{"devices": [{"name": "sw1", "address": "10.0.0.1", "tags": ["switch"]}]}`))
+	if err != nil {
+		t.Fatalf("Parse JSON: %v", err)
+	}
+	if want, got := "10.0.0.1", f.Device("sw1").Address; want != got {
+		t.Errorf("wanted address %q, got %q", want, got)
+	}
+
+	for i, in := range []string{
+		`devices: [{name: sw1}]`,
+		`devices: [{address: 10.0.0.1}]`,
+		`devices: [{name: sw1, address: a}, {name: sw1, address: b}]`,
+		`devices: [{name: sw1, address: a, credentials: "vault:foo"}]`,
+		`devices: [{name: sw1, address: a, port: 443}]`,
+	} {
+		if _, err := Parse([]byte(in)); err == nil {
+			t.Errorf("%d: Parse should have failed", i)
+		}
+	}
+}
+
+// TestRun ensures operations run against all selected devices with bounded
+// concurrency, and that per-device errors and timeouts are reported.
+func TestRun(t *testing.T) {
+	ctx := context.Background()
+	servers := make(map[string]*rostest.Server)
+	f := &Fleet{
+		Concurrency: 2,
+		Timeout:     time.Second,
+		NewClient: func(d *Device, _ ros.Credentials) (*ros.Client, error) {
+			return servers[d.Name].Client(), nil
+		},
+	}
+	for i := 0; i < 5; i++ {
+		name := fmt.Sprintf("sw%d", i)
+		s := rostest.NewServer()
+		defer s.Close()
+		servers[name] = s
+		f.Devices = append(f.Devices, &Device{Name: name, Address: s.Address(), Tags: []string{"switch"}})
+	}
+	f.Devices = append(f.Devices, &Device{Name: "rtr1", Address: "192.0.2.1", Tags: []string{"router"}})
+
+	var mu sync.Mutex
+	inFlight, maxInFlight := 0, 0
+	results, err := f.Run(ctx, f.Select("switch"), func(ctx context.Context, d *Device, c *ros.Client) error {
+		mu.Lock()
+		inFlight++
+		if inFlight > maxInFlight {
+			maxInFlight = inFlight
+		}
+		mu.Unlock()
+		defer func() {
+			mu.Lock()
+			inFlight--
+			mu.Unlock()
+		}()
+		time.Sleep(10 * time.Millisecond)
+
+		switch d.Name {
+		case "sw3":
+			return fmt.Errorf("broken")
+		case "sw4":
+			<-ctx.Done()
+			return ctx.Err()
+		}
+		_, err := c.InterfaceBridgeVlanAdd(ctx, &ros.InterfaceBridgeVlan_Update{
+			Bridge:  ros.StringPtr("bridge1"),
+			VlanIDs: ros.NumberPtr(3005),
+		})
+		return err
+	})
+	if maxInFlight > 2 {
+		t.Errorf("wanted at most 2 concurrent operations, got %d", maxInFlight)
+	}
+	if want, got := 5, len(results); want != got {
+		t.Fatalf("wanted %d results, got %d", want, got)
+	}
+	for i, res := range results {
+		if want, got := fmt.Sprintf("sw%d", i), res.Device.Name; want != got {
+			t.Errorf("result %d: wanted device %s, got %s", i, want, got)
+		}
+	}
+	for _, name := range []string{"sw0", "sw1", "sw2"} {
+		if want, got := 1, len(servers[name].Records("interface/bridge/vlan")); want != got {
+			t.Errorf("%s: wanted %d vlans, got %d", name, want, got)
+		}
+	}
+
+	var errs Errors
+	if !errors.As(err, &errs) {
+		t.Fatalf("Run should have returned Errors, got %v", err)
+	}
+	if len(errs) != 2 || errs[0].Device != "sw3" || errs[1].Device != "sw4" {
+		t.Errorf("unexpected errors %v", errs)
+	}
+	if !errors.Is(errs[1], context.DeadlineExceeded) {
+		t.Errorf("sw4 should have timed out, got %v", errs[1])
+	}
+}
+
+// TestRunCanceled ensures no operations are started after the context is
+// canceled.
+func TestRunCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	f := &Fleet{
+		Devices: []*Device{{Name: "sw1", Address: "192.0.2.1"}},
+	}
+	ran := false
+	_, err := f.Run(ctx, f.Devices, func(ctx context.Context, d *Device, c *ros.Client) error {
+		ran = true
+		return nil
+	})
+	if ran {
+		t.Errorf("operation should not have run")
+	}
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Run should have returned context.Canceled, got %v", err)
+	}
+}
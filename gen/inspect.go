@@ -0,0 +1,423 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+
+	kpb "github.com/q3k/ros7api/gen/kinds"
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
+)
+
+// inspectRow is a row returned by ROS' /console/inspect. Child rows describe
+// elements of the syntax tree below the inspected path, syntax rows describe
+// the inspected path itself.
+type inspectRow struct {
+	// Type is either child or syntax.
+	Type string `json:"type"`
+
+	// Name is the name of a child element, eg. vlan or add.
+	Name string `json:"name"`
+	// NodeType is the type of a child element: dir or path for menus, cmd
+	// for commands and arg for command arguments.
+	NodeType string `json:"node-type"`
+
+	// Symbol is the name of the syntax element described, eg. vlan-ids.
+	Symbol string `json:"symbol"`
+	// SymbolType is either explanation (a human readable description) or
+	// definition (a syntax definition).
+	SymbolType string `json:"symbol-type"`
+	// Text is the explanation or definition.
+	Text string `json:"text"`
+}
+
+// inspectDump is a saved dump of /console/inspect output. It maps an inspected
+// path, as passed to /console/inspect (eg. interface,bridge,vlan,set,vlan-ids,
+// empty for the root) to the rows returned by '/console/inspect
+// request=child,syntax path=...'.
+type inspectDump map[string][]inspectRow
+
+func (d inspectDump) children(path []string, nodeTypes ...string) []string {
+	var res []string
+	for _, r := range d[strings.Join(path, ",")] {
+		if r.Type != "child" {
+			continue
+		}
+		for _, t := range nodeTypes {
+			if r.NodeType == t {
+				res = append(res, r.Name)
+				break
+			}
+		}
+	}
+	sort.Strings(res)
+	return res
+}
+
+// syntax returns the explanation and definitions of the element at path.
+func (d inspectDump) syntax(path []string) (explanation string, definitions []string) {
+	name := path[len(path)-1]
+	for _, r := range d[strings.Join(path, ",")] {
+		if r.Type != "syntax" {
+			continue
+		}
+		switch r.SymbolType {
+		case "explanation":
+			if r.Symbol == name || r.Symbol == "<"+name+">" {
+				explanation = r.Text
+			}
+		case "definition":
+			definitions = append(definitions, r.Text)
+		}
+	}
+	return
+}
+
+// ignoredArguments are arguments of add/set which are not record properties.
+var ignoredArguments = map[string]bool{
+	".id":          true,
+	"numbers":      true,
+	"copy-from":    true,
+	"place-before": true,
+}
+
+// importMenu builds a menu tree from the dump, rooted at path.
+func (d inspectDump) importMenu(path []string) *kpb.Menu {
+	m := &kpb.Menu{}
+	if len(path) > 0 {
+		m.Name = path[len(path)-1]
+	}
+	for _, name := range d.children(path, "dir", "path") {
+		sub := d.importMenu(append(append([]string(nil), path...), name))
+		if sub.Record != nil || len(sub.Sub) > 0 {
+			m.Sub = append(m.Sub, sub)
+		}
+	}
+
+	cmds := make(map[string]bool)
+	for _, name := range d.children(path, "cmd") {
+		cmds[name] = true
+	}
+	if !cmds["set"] {
+		return m
+	}
+	r := &kpb.Record{
+		Singleton: !cmds["add"],
+	}
+	settable := make(map[string]bool)
+	for _, cmd := range []string{"add", "set"} {
+		if !cmds[cmd] {
+			continue
+		}
+		cpath := append(append([]string(nil), path...), cmd)
+		for _, name := range d.children(cpath, "arg") {
+			if ignoredArguments[name] || settable[name] {
+				continue
+			}
+			settable[name] = true
+			explanation, definitions := d.syntax(append(cpath, name))
+			p := inferProperty(name, definitions)
+			if explanation != "" && !strings.EqualFold(explanation, name) {
+				p.Description = explanation
+			}
+			r.Property = append(r.Property, p)
+		}
+	}
+	// Properties which can be read (through get) but not set are read-only.
+	if cmds["get"] {
+		_, definitions := d.syntax(append(append([]string(nil), path...), "get", "value-name"))
+		for _, def := range definitions {
+			for _, name := range enumVariants(def) {
+				if ignoredArguments[name] || settable[name] {
+					continue
+				}
+				settable[name] = true
+				p := &kpb.Property{
+					Name:     name,
+					ReadOnly: true,
+					Type:     &kpb.Property_TypeString{TypeString: &kpb.TypeString{}},
+				}
+				r.Property = append(r.Property, p)
+			}
+		}
+	}
+	m.Record = r
+	return m
+}
+
+var (
+	reVariant   = regexp.MustCompile(`^[a-z0-9][a-z0-9-]*$`)
+	reIPPrefix  = regexp.MustCompile(`(?i)A\.B\.C\.D/M|IP(v6)?/Mask|IP(v6)? ?Prefix`)
+	reIP        = regexp.MustCompile(`(?i)A\.B\.C\.D|IP(v6)? ?Address`)
+	reMAC       = regexp.MustCompile(`(?i)MAC ?Address|XX:XX`)
+	reDuration  = regexp.MustCompile(`(?i)time ?interval|^time$`)
+	reNumber    = regexp.MustCompile(`(?i)integer|^num`)
+	reListDelim = regexp.MustCompile(`\[,`)
+)
+
+// enumVariants returns the variants of an alternative-style syntax definition,
+// eg. auto | yes | no, or nil if the definition is not one.
+func enumVariants(def string) []string {
+	var res []string
+	for _, part := range strings.Split(def, "|") {
+		part = strings.TrimSpace(part)
+		if !reVariant.MatchString(part) {
+			return nil
+		}
+		res = append(res, part)
+	}
+	if len(res) < 2 {
+		return nil
+	}
+	return res
+}
+
+// inferProperty guesses the kind of a property from its syntax definitions. As
+// the definitions are meant for humans, this is best-effort, and anything not
+// recognized becomes a string.
+func inferProperty(name string, definitions []string) *kpb.Property {
+	p := &kpb.Property{
+		Name: name,
+	}
+	def := strings.TrimSpace(strings.Join(definitions, " "))
+	if variants := enumVariants(def); variants != nil {
+		sorted := append([]string(nil), variants...)
+		sort.Strings(sorted)
+		if len(sorted) == 2 && sorted[0] == "no" && sorted[1] == "yes" {
+			p.Type = &kpb.Property_TypeBoolean{TypeBoolean: &kpb.TypeBoolean{}}
+			return p
+		}
+		e := &kpb.TypeEnum{}
+		for _, v := range variants {
+			e.Variant = append(e.Variant, &kpb.TypeEnum_Variant{Value: v})
+		}
+		p.Type = &kpb.Property_TypeEnum{TypeEnum: e}
+		return p
+	}
+	list := reListDelim.MatchString(def)
+	switch {
+	case reIPPrefix.MatchString(def):
+		p.Type = &kpb.Property_TypeIpPrefix{TypeIpPrefix: &kpb.TypeIPPrefix{}}
+	case reIP.MatchString(def):
+		p.Type = &kpb.Property_TypeIp{TypeIp: &kpb.TypeIP{}}
+	case reMAC.MatchString(def):
+		p.Type = &kpb.Property_TypeMac{TypeMac: &kpb.TypeMAC{}}
+	case reDuration.MatchString(def):
+		p.Type = &kpb.Property_TypeDuration{TypeDuration: &kpb.TypeDuration{}}
+	case reNumber.MatchString(def) && list:
+		p.Type = &kpb.Property_TypeNumberList{TypeNumberList: &kpb.TypeNumberList{}}
+	case reNumber.MatchString(def):
+		p.Type = &kpb.Property_TypeNumber{TypeNumber: &kpb.TypeNumber{}}
+	case list:
+		p.Type = &kpb.Property_TypeStringList{TypeStringList: &kpb.TypeStringList{}}
+	default:
+		p.Type = &kpb.Property_TypeString{TypeString: &kpb.TypeString{}}
+	}
+	return p
+}
+
+// mergeMenu merges an imported menu tree into an existing (possibly
+// hand-written) one. Imported menus, properties and enum variants are added.
+// Existing types, go_name, description, key, singleton and read_only
+// overrides, commands and anything not present in the import are kept, with
+// a warning logged where the inferred singleton or read_only flag differs.
+// Imported properties provided by a property set included in the existing
+// record are skipped.
+func mergeMenu(dst, src *kpb.Menu) {
+	sets := make(map[string]*kpb.PropertySet)
+	for _, set := range dst.PropertySet {
+		sets[set.Name] = set
+	}
+	mergeSubmenu(dst, src, "", sets)
+}
+
+// mergeSubmenu implements mergeMenu for a (sub)menu at a given path, given
+// the property sets of the root menu.
+func mergeSubmenu(dst, src *kpb.Menu, path string, sets map[string]*kpb.PropertySet) {
+	for _, s := range src.Sub {
+		spath := s.Name
+		if path != "" {
+			spath = path + "/" + s.Name
+		}
+		var existing *kpb.Menu
+		for _, d := range dst.Sub {
+			if d.Name == s.Name {
+				existing = d
+				break
+			}
+		}
+		if existing == nil {
+			dst.Sub = append(dst.Sub, s)
+			continue
+		}
+		mergeSubmenu(existing, s, spath, sets)
+	}
+
+	if src.Record == nil {
+		return
+	}
+	if dst.Record == nil {
+		dst.Record = src.Record
+		return
+	}
+	if dst.Record.Singleton != src.Record.Singleton {
+		log.Printf("%s: keeping singleton: %v, import has %v", path, dst.Record.Singleton, src.Record.Singleton)
+	}
+	included := make(map[string]bool)
+	for _, name := range dst.Record.Include {
+		if set, ok := sets[name]; ok {
+			for _, p := range set.Property {
+				included[p.Name] = true
+			}
+		}
+	}
+	for _, sp := range src.Record.Property {
+		if included[sp.Name] {
+			continue
+		}
+		var existing *kpb.Property
+		for _, dp := range dst.Record.Property {
+			if dp.Name == sp.Name {
+				existing = dp
+				break
+			}
+		}
+		if existing == nil {
+			dst.Record.Property = append(dst.Record.Property, sp)
+			continue
+		}
+		if existing.ReadOnly != sp.ReadOnly {
+			log.Printf("%s: %s: keeping read_only: %v, import has %v", path, sp.Name, existing.ReadOnly, sp.ReadOnly)
+		}
+		if existing.Description == "" {
+			existing.Description = sp.Description
+		}
+		de, dok := existing.Type.(*kpb.Property_TypeEnum)
+		se, sok := sp.Type.(*kpb.Property_TypeEnum)
+		if !dok || !sok {
+			continue
+		}
+		for _, sv := range se.TypeEnum.Variant {
+			found := false
+			for _, dv := range de.TypeEnum.Variant {
+				if dv.Value == sv.Value {
+					found = true
+					break
+				}
+			}
+			if !found {
+				de.TypeEnum.Variant = append(de.TypeEnum.Variant, sv)
+			}
+		}
+	}
+}
+
+// filterMenu removes all menus from the tree which are not within, or
+// parents of, any of the given paths (eg. interface/bridge).
+func filterMenu(m *kpb.Menu, path string, prefixes []string) bool {
+	for _, p := range prefixes {
+		if path == p || strings.HasPrefix(path, p+"/") {
+			return true
+		}
+	}
+	var subs []*kpb.Menu
+	for _, s := range m.Sub {
+		spath := s.Name
+		if path != "" {
+			spath = path + "/" + s.Name
+		}
+		if filterMenu(s, spath, prefixes) {
+			subs = append(subs, s)
+		}
+	}
+	m.Sub = subs
+	m.Record = nil
+	return len(subs) > 0
+}
+
+// importedMenus returns the menus of a merged tree which are present in the
+// imported tree it was merged with, ie. only the new or merged menus. Their
+// parents are returned with just a name, and submenus which were not imported
+// are left out.
+func importedMenus(merged, imported *kpb.Menu) *kpb.Menu {
+	res := &kpb.Menu{Name: merged.Name}
+	if imported.Record != nil {
+		res = proto.Clone(merged).(*kpb.Menu)
+		res.Sub = nil
+	}
+	for _, ms := range merged.Sub {
+		for _, is := range imported.Sub {
+			if ms.Name == is.Name {
+				res.Sub = append(res.Sub, importedMenus(ms, is))
+				break
+			}
+		}
+	}
+	return res
+}
+
+// inspectImport implements the inspect-import subcommand.
+func inspectImport(args []string) {
+	fs := flag.NewFlagSet("inspect-import", flag.ExitOnError)
+	flagDump := fs.String("dump", "", "Path to JSON dump of /console/inspect output")
+	flagMenus := fs.String("menus", "", "Comma-separated list of menu paths (eg. interface/bridge) to import, default all")
+	flagOut := fs.String("out", "", "Path to write merged menus prototext to, default stdout")
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: gen [-types_path ...] inspect-import -dump dump.json [-menus ...] [-out ...]\n\n")
+		fmt.Fprintf(fs.Output(), "Imports menus from a dump of '/console/inspect request=child,syntax' output\n")
+		fmt.Fprintf(fs.Output(), "(a JSON object mapping inspected paths like interface,bridge,vlan to\n")
+		fmt.Fprintf(fs.Output(), "returned rows), merges them with the types prototext and writes the new or\n")
+		fmt.Fprintf(fs.Output(), "merged menus, to be pasted into the types prototext by hand.\n\n")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+	if *flagDump == "" {
+		fs.Usage()
+		os.Exit(2)
+	}
+	if *flagOut == flagTypesPath {
+		log.Fatalf("Refusing to overwrite %s, as its comments and the menus not imported would be lost", flagTypesPath)
+	}
+
+	data, err := ioutil.ReadFile(*flagDump)
+	if err != nil {
+		log.Fatalf("Could not load dump: %v", err)
+	}
+	var dump inspectDump
+	if err := json.Unmarshal(data, &dump); err != nil {
+		log.Fatalf("Could not unmarshal dump: %v", err)
+	}
+	imported := dump.importMenu(nil)
+	if *flagMenus != "" {
+		filterMenu(imported, "", strings.Split(*flagMenus, ","))
+	}
+
+	var m kpb.Menu
+	data, err = ioutil.ReadFile(flagTypesPath)
+	if err != nil && !os.IsNotExist(err) {
+		log.Fatalf("Could not load types prototext: %v", err)
+	}
+	if err := prototext.Unmarshal(data, &m); err != nil {
+		log.Fatalf("Could not unmarshal types prototext: %v", err)
+	}
+	mergeMenu(&m, imported)
+
+	out, err := prototext.MarshalOptions{Multiline: true, Indent: "  "}.Marshal(importedMenus(&m, imported))
+	if err != nil {
+		log.Fatalf("Could not marshal menus prototext: %v", err)
+	}
+	if *flagOut == "" {
+		os.Stdout.Write(out)
+		return
+	}
+	if err := ioutil.WriteFile(*flagOut, out, 0644); err != nil {
+		log.Fatalf("Could not write menus prototext: %v", err)
+	}
+}
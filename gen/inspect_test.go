@@ -0,0 +1,181 @@
+package main
+
+import (
+	"encoding/json"
+	"testing"
+
+	kpb "github.com/q3k/ros7api/gen/kinds"
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
+)
+
+const testDump = `{
+  "": [
+    {"type": "child", "name": "interface", "node-type": "dir"},
+    {"type": "child", "name": "ping", "node-type": "cmd"}
+  ],
+  "interface": [
+    {"type": "child", "name": "bridge", "node-type": "dir"}
+  ],
+  "interface,bridge": [
+    {"type": "child", "name": "vlan", "node-type": "dir"},
+    {"type": "child", "name": "settings", "node-type": "dir"}
+  ],
+  "interface,bridge,vlan": [
+    {"type": "child", "name": "add", "node-type": "cmd"},
+    {"type": "child", "name": "get", "node-type": "cmd"},
+    {"type": "child", "name": "set", "node-type": "cmd"}
+  ],
+  "interface,bridge,vlan,add": [
+    {"type": "child", "name": "bridge", "node-type": "arg"},
+    {"type": "child", "name": "copy-from", "node-type": "arg"},
+    {"type": "child", "name": "disabled", "node-type": "arg"},
+    {"type": "child", "name": "tagged", "node-type": "arg"},
+    {"type": "child", "name": "vlan-ids", "node-type": "arg"}
+  ],
+  "interface,bridge,vlan,add,bridge": [
+    {"type": "syntax", "symbol": "bridge", "symbol-type": "explanation", "text": "Bridge interface"},
+    {"type": "syntax", "symbol": "Bridge", "symbol-type": "definition", "text": "Interface name"}
+  ],
+  "interface,bridge,vlan,add,disabled": [
+    {"type": "syntax", "symbol": "disabled", "symbol-type": "definition", "text": "yes | no"}
+  ],
+  "interface,bridge,vlan,add,tagged": [
+    {"type": "syntax", "symbol": "Tagged", "symbol-type": "definition", "text": "Interface[,Interface*]"}
+  ],
+  "interface,bridge,vlan,add,vlan-ids": [
+    {"type": "syntax", "symbol": "VlanIds", "symbol-type": "definition", "text": "Num[,VlanIds*]"}
+  ],
+  "interface,bridge,vlan,set": [
+    {"type": "child", "name": "numbers", "node-type": "arg"},
+    {"type": "child", "name": "untagged", "node-type": "arg"}
+  ],
+  "interface,bridge,vlan,get,value-name": [
+    {"type": "syntax", "symbol": "value-name", "symbol-type": "definition", "text": "bridge | disabled | dynamic | tagged | untagged | vlan-ids"}
+  ],
+  "interface,bridge,settings": [
+    {"type": "child", "name": "set", "node-type": "cmd"}
+  ],
+  "interface,bridge,settings,set": [
+    {"type": "child", "name": "use-ip-firewall", "node-type": "arg"},
+    {"type": "child", "name": "allow-fast-path", "node-type": "arg"}
+  ],
+  "interface,bridge,settings,set,use-ip-firewall": [
+    {"type": "syntax", "symbol": "use-ip-firewall", "symbol-type": "definition", "text": "yes | no"}
+  ]
+}`
+
+// TestInspectImport ensures menus, records and property kinds are imported
+// from a dump, and merged into existing hand-written definitions, keeping
+// their overrides and skipping properties provided by included property sets.
+func TestInspectImport(t *testing.T) {
+	var dump inspectDump
+	if err := json.Unmarshal([]byte(testDump), &dump); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	imported := dump.importMenu(nil)
+
+	var existing kpb.Menu
+	if err := prototext.Unmarshal([]byte(`
+property_set {
+  name: "common"
+  property { name: "disabled" type_boolean { } }
+}
+sub {
+  name: "interface"
+  sub {
+    name: "bridge"
+    sub {
+      name: "vlan"
+      record {
+        key: "bridge"
+        include: "common"
+        property {
+          name: "vlan-ids" go_name: "VlanIDs" type_number { }
+          description: "The list of VLAN IDs."
+        }
+        property { name: "untagged" read_only: true type_string { } }
+      }
+    }
+  }
+}`), &existing); err != nil {
+		t.Fatalf("Unmarshal existing: %v", err)
+	}
+	mergeMenu(&existing, imported)
+
+	var want kpb.Menu
+	if err := prototext.Unmarshal([]byte(`
+property_set {
+  name: "common"
+  property { name: "disabled" type_boolean { } }
+}
+sub {
+  name: "interface"
+  sub {
+    name: "bridge"
+    sub {
+      name: "vlan"
+      record {
+        key: "bridge"
+        include: "common"
+        property {
+          name: "vlan-ids" go_name: "VlanIDs" type_number { }
+          description: "The list of VLAN IDs."
+        }
+        property { name: "untagged" read_only: true type_string { } }
+        property { name: "bridge" description: "Bridge interface" type_string { } }
+        property { name: "tagged" type_string_list { } }
+        property { name: "dynamic" read_only: true type_string { } }
+      }
+    }
+    sub {
+      name: "settings"
+      record {
+        singleton: true
+        property { name: "allow-fast-path" type_string { } }
+        property { name: "use-ip-firewall" type_boolean { } }
+      }
+    }
+  }
+}`), &want); err != nil {
+		t.Fatalf("Unmarshal want: %v", err)
+	}
+	if !proto.Equal(&want, &existing) {
+		t.Errorf("unexpected merged menu, wanted\n%s\ngot\n%s", prototext.Format(&want), prototext.Format(&existing))
+	}
+
+	// Only the imported menus should be written out.
+	want.PropertySet = nil
+	if got := importedMenus(&existing, imported); !proto.Equal(&want, got) {
+		t.Errorf("unexpected imported menus, wanted\n%s\ngot\n%s", prototext.Format(&want), prototext.Format(got))
+	}
+}
+
+// TestInferProperty ensures property kinds are guessed from syntax
+// definitions.
+func TestInferProperty(t *testing.T) {
+	for _, te := range []struct {
+		def  string
+		want string
+	}{
+		{"yes | no", `name:"p" type_boolean:{}`},
+		{"auto | yes | no-discover", `name:"p" type_enum:{variant:{value:"auto"} variant:{value:"yes"} variant:{value:"no-discover"}}`},
+		{"integer [0..4294967295]", `name:"p" type_number:{}`},
+		{"Num[,Num*]", `name:"p" type_number_list:{}`},
+		{"Interface[,Interface*]", `name:"p" type_string_list:{}`},
+		{"A.B.C.D    (IP address)", `name:"p" type_ip:{}`},
+		{"A.B.C.D/M    (IP prefix)", `name:"p" type_ip_prefix:{}`},
+		{"MAC Address", `name:"p" type_mac:{}`},
+		{"time interval", `name:"p" type_duration:{}`},
+		{"string value", `name:"p" type_string:{}`},
+	} {
+		var want kpb.Property
+		if err := prototext.Unmarshal([]byte(te.want), &want); err != nil {
+			t.Fatalf("%q: Unmarshal: %v", te.def, err)
+		}
+		got := inferProperty("p", []string{te.def})
+		if !proto.Equal(&want, got) {
+			t.Errorf("%q: wanted %s, got %s", te.def, prototext.Format(&want), prototext.Format(got))
+		}
+	}
+}
@@ -1,6 +1,10 @@
 // package main implements a ROS7 REST API client generator. It parses a
 // prototext containing definition of record types in ROS (eg. the Mikrotik
 // confluence wiki) and spits out Go files in ros/zz_*.go.
+//
+// The inspect-import subcommand imports menus into the prototext from a dump
+// of ROS' own description of its syntax tree (/console/inspect), keeping any
+// hand-written overrides.
 package main
 
 import (
@@ -11,6 +15,8 @@ import (
 	"io/ioutil"
 	"log"
 	"path"
+	"sort"
+	"strconv"
 	"strings"
 
 	kpb "github.com/q3k/ros7api/gen/kinds"
@@ -21,6 +27,9 @@ var (
 	flagTypesPath string
 )
 
+// propertySets are the property sets declared in the root menu, by name.
+var propertySets = make(map[string]*kpb.PropertySet)
+
 // menu is an element of the ROS menu tree.
 type menu struct {
 	// path is a menu path like interface/vlan
@@ -32,6 +41,8 @@ type menu struct {
 
 	// buf is the code generation buffer for this node.
 	buf bytes.Buffer
+	// imports is the set of packages imported by the generated code.
+	imports map[string]bool
 }
 
 // property is a ROS record property parsed from protobuf.
@@ -44,7 +55,16 @@ type property struct {
 	goname string
 	// gotype is the REST Client Go type of this property.
 	gotype string
-	enum   *kpb.TypeEnum
+	// kind is the name of the ros.Kind constant of this property, eg.
+	// KindNumber.
+	kind string
+	enum *kpb.TypeEnum
+	// enumType is the Go type of a single value of an enum property. It's
+	// the same as gotype, unless the enum is a list.
+	enumType string
+	// set is the name of the property set this property comes from, if
+	// any. Its enum types are emitted with the set instead of the record.
+	set string
 }
 
 func propertyFromProto(p *kpb.Property, sname string) *property {
@@ -56,31 +76,165 @@ func propertyFromProto(p *kpb.Property, sname string) *property {
 	var enum *kpb.TypeEnum
 
 	gotype := ""
+	kind := ""
 	switch v := p.Type.(type) {
 	case *kpb.Property_TypeNumber:
 		gotype = "Number"
+		kind = "KindNumber"
 	case *kpb.Property_TypeString:
 		gotype = "string"
+		kind = "KindString"
 	case *kpb.Property_TypeBoolean:
 		gotype = "Boolean"
+		kind = "KindBoolean"
 	case *kpb.Property_TypeStringList:
 		gotype = "StringList"
+		kind = "KindStringList"
 	case *kpb.Property_TypeNumberList:
 		gotype = "NumberList"
+		kind = "KindNumberList"
 	case *kpb.Property_TypeEnum:
 		gotype = fmt.Sprintf("%s_%s", sname, goname)
+		kind = "KindEnum"
 		enum = v.TypeEnum
+		if enum.List {
+			gotype += "List"
+			kind = "KindEnumList"
+		}
+	case *kpb.Property_TypeIp:
+		gotype = "IP"
+		kind = "KindIP"
+	case *kpb.Property_TypeIpPrefix:
+		gotype = "IPNet"
+		kind = "KindIPPrefix"
+		if v.TypeIpPrefix.List {
+			gotype = "IPNetList"
+			kind = "KindIPPrefixList"
+		}
+	case *kpb.Property_TypeMac:
+		gotype = "MAC"
+		kind = "KindMAC"
+	case *kpb.Property_TypeDuration:
+		gotype = "Duration"
+		kind = "KindDuration"
+	case *kpb.Property_TypeBytes:
+		gotype = "Bytes"
+		kind = "KindBytes"
+	case *kpb.Property_TypeRate:
+		gotype = "Rate"
+		kind = "KindRate"
+		if v.TypeRate.Pair {
+			gotype = "RatePair"
+			kind = "KindRatePair"
+		}
+	case *kpb.Property_TypeGateway:
+		gotype = "GatewayList"
+		kind = "KindGatewayList"
+	case *kpb.Property_TypePortList:
+		gotype = "PortList"
+		kind = "KindPortList"
+	case *kpb.Property_TypeIpRangeList:
+		gotype = "IPRangeList"
+		kind = "KindIPRangeList"
 	default:
 		panic(fmt.Sprintf("unknown type %v", p.Type))
 	}
 
 	return &property{
-		p:      p,
-		name:   p.Name,
-		goname: goname,
-		gotype: gotype,
-		enum:   enum,
+		p:        p,
+		name:     p.Name,
+		goname:   goname,
+		gotype:   gotype,
+		kind:     kind,
+		enum:     enum,
+		enumType: strings.TrimSuffix(gotype, "List"),
+	}
+}
+
+// recordProperties returns the properties of a record, followed by those of
+// the property sets it includes.
+func recordProperties(r *kpb.Record, sname string) ([]*property, error) {
+	var res []*property
+	for _, p := range r.Property {
+		res = append(res, propertyFromProto(p, sname))
+	}
+	for _, name := range r.Include {
+		set, ok := propertySets[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown property set %q", name)
+		}
+		for _, p := range set.Property {
+			prop := propertyFromProto(p, setGoName(set))
+			prop.set = set.Name
+			res = append(res, prop)
+		}
 	}
+	return res, nil
+}
+
+// setGoName returns the Go name of a property set, used as the prefix of its
+// enum types.
+func setGoName(set *kpb.PropertySet) string {
+	if set.GoName != "" {
+		return set.GoName
+	}
+	return goify(set.Name)
+}
+
+// differ returns a Go expression which is true if two values of this
+// property's type are different.
+func (p *property) differ(a, b string) string {
+	switch p.gotype {
+	case "StringList", "NumberList", "IP", "IPNet", "MAC", "GatewayList", "PortList", "IPRangeList", "IPNetList":
+		return fmt.Sprintf("!%s.Equal(%s)", a, b)
+	}
+	if p.enum != nil && p.enum.List {
+		return fmt.Sprintf("!%s.Equal(%s)", a, b)
+	}
+	return fmt.Sprintf("%s != %s", a, b)
+}
+
+// isSet returns a Go expression which is true if a value of this property's
+// type is set, or an empty string if any value (including the zero value) is
+// valid in ROS. Unset values of the other types, eg. an empty gateway or port
+// list, would be rejected by ROS when written back.
+func (p *property) isSet(v string) string {
+	switch p.gotype {
+	case "IP", "MAC", "GatewayList", "IPRangeList", "IPNetList":
+		return fmt.Sprintf("len(%s) != 0", v)
+	case "IPNet":
+		return fmt.Sprintf("%s.Address != nil", v)
+	case "NumberList", "PortList":
+		return fmt.Sprintf("!%s.Equal(%s{})", v, p.gotype)
+	}
+	if p.enum != nil {
+		if p.enum.List {
+			return fmt.Sprintf("%s.Not || len(%s.Values) != 0", v, v)
+		}
+		return fmt.Sprintf("%s != \"\"", v)
+	}
+	return ""
+}
+
+// goVersion returns a Go expression of a ros.Version parsed from a version
+// string like 7.15 or 7.15.2.
+func goVersion(s string) string {
+	parts := strings.Split(s, ".")
+	if len(parts) < 2 || len(parts) > 3 {
+		panic(fmt.Sprintf("invalid version %q", s))
+	}
+	var nums []int
+	for _, p := range parts {
+		n, err := strconv.Atoi(p)
+		if err != nil {
+			panic(fmt.Sprintf("invalid version %q", s))
+		}
+		nums = append(nums, n)
+	}
+	for len(nums) < 3 {
+		nums = append(nums, 0)
+	}
+	return fmt.Sprintf("Version{%d, %d, %d}", nums[0], nums[1], nums[2])
 }
 
 // printf writes a line to the menu's code generation buffer.
@@ -100,54 +254,280 @@ func goify(s string) string {
 // corresponds to a single Go source file.
 func (m *menu) generate() error {
 	m.buf.Reset()
-	m.printf("package ros\n\n")
-	m.printf("import (\n")
-	m.printf("\t\"context\"\n")
-	m.printf("\t\"encoding/json\"\n")
-	m.printf("\t\"fmt\"\n")
-	m.printf(")\n\n")
-	m.printf("// Automatically generated by github.com/q3k/ros7api/gen, do not edit.\n")
-	m.printf("\n")
+	m.imports = map[string]bool{
+		"context": true,
+		"fmt":     true,
+	}
 
 	// Turn path into record struct name (eg. interface/bridge/vlan into
-	// InterfaceBridgeVlan).
+	// InterfaceBridgeVlan, ip/firewall/address-list into
+	// IpFirewallAddressList).
 	nameParts := strings.Split(m.path, "/")
 	for i, p := range nameParts {
-		nameParts[i] = strings.Title(p)
+		nameParts[i] = goify(p)
 	}
 	sname := strings.Join(nameParts, "")
 
-	// Parse properties.
-	var properties []*property
-	for _, p := range m.m.Record.Property {
-		prop := propertyFromProto(p, sname)
-		properties = append(properties, prop)
+	if m.m.Record != nil {
+		if err := m.generateRecord(sname); err != nil {
+			return err
+		}
+	}
+	for _, c := range m.m.Command {
+		if err := m.generateCommand(sname, c); err != nil {
+			return fmt.Errorf("command %s: %w", c.Name, err)
+		}
+	}
+	if err := m.generateSchema(sname); err != nil {
+		return err
 	}
+	m.prependHeader()
+	return nil
+}
+
+// prependHeader prepends the package clause and imports to the generated
+// code, once it's known what imports the code needs.
+func (m *menu) prependHeader() {
+	var imports []string
+	for i := range m.imports {
+		imports = append(imports, i)
+	}
+	sort.Strings(imports)
+	var header bytes.Buffer
+	fmt.Fprintf(&header, "package ros\n\n")
+	fmt.Fprintf(&header, "import (\n")
+	for _, i := range imports {
+		fmt.Fprintf(&header, "\t%q\n", i)
+	}
+	fmt.Fprintf(&header, ")\n\n")
+	fmt.Fprintf(&header, "// Automatically generated by github.com/q3k/ros7api/gen, do not edit.\n")
+	fmt.Fprintf(&header, "\n")
+	body := m.buf.Bytes()
+	m.buf = header
+	m.buf.Write(body)
+}
 
-	// Emit enums.
+// emitEnums emits enum types for all given properties that are enums.
+func (m *menu) emitEnums(properties []*property) {
 	for _, p := range properties {
 		if p.enum == nil {
 			continue
 		}
-		m.printf("type %s string\n\n", p.gotype)
+		m.imports["encoding/json"] = true
+		typ := p.enumType
+
+		if p.enum.Open {
+			m.printf("// %s is the type of the `%s` property. Besides the values below, any\n", typ, p.name)
+			m.printf("// other value is accepted.\n")
+		} else {
+			m.printf("// %s is the type of the `%s` property. Values not known to this library\n", typ, p.name)
+			m.printf("// (eg. introduced in newer ROS versions) are kept as-is, but reported\n")
+			m.printf("// through UnknownEnumHandler.\n")
+		}
+		m.printf("type %s string\n\n", typ)
 		m.printf("const (\n")
 		for _, variant := range p.enum.Variant {
 			if variant.Description != "" {
 				m.printf("\t// %s\n", variant.Description)
 			}
-			m.printf("\t%s%s = %q\n", p.gotype, goify(variant.Value), variant.Value)
+			m.printf("\t%s%s %s = %q\n", typ, goify(variant.Value), typ, variant.Value)
+		}
+		m.printf(")\n\n")
+
+		m.printf("// Values returns all values of %s known to this library.\n", typ)
+		m.printf("func (%s) Values() []%s {\n", typ, typ)
+		m.printf("\treturn []%s{\n", typ)
+		for _, variant := range p.enum.Variant {
+			m.printf("\t\t%s%s,\n", typ, goify(variant.Value))
+		}
+		m.printf("\t}\n")
+		m.printf("}\n\n")
+
+		m.printf("// IsKnown returns whether the value is known to this library.\n")
+		m.printf("func (e %s) IsKnown() bool {\n", typ)
+		m.printf("\tswitch e {\n")
+		m.printf("\tcase ")
+		for i, variant := range p.enum.Variant {
+			if i != 0 {
+				m.printf(", ")
+			}
+			m.printf("%s%s", typ, goify(variant.Value))
+		}
+		m.printf(":\n")
+		m.printf("\t\treturn true\n")
+		m.printf("\t}\n")
+		m.printf("\treturn false\n")
+		m.printf("}\n\n")
+
+		m.printf("func (e %s) String() string {\n", typ)
+		m.printf("\treturn string(e)\n")
+		m.printf("}\n\n")
+
+		m.printf("func (e *%s) UnmarshalJSON(b []byte) error {\n", typ)
+		m.printf("\tvar s string\n")
+		m.printf("\tif err := json.Unmarshal(b, &s); err != nil {\n")
+		m.printf("\t\treturn err\n")
+		m.printf("\t}\n")
+		m.printf("\t*e = %s(s)\n", typ)
+		if !p.enum.Open {
+			m.printf("\tif s != \"\" && !e.IsKnown() {\n")
+			m.printf("\t\treportUnknownEnum(%q, s)\n", typ)
+			m.printf("\t}\n")
+		}
+		m.printf("\treturn nil\n")
+		m.printf("}\n\n")
+
+		m.printf("func (e %s) MarshalJSON() ([]byte, error) {\n", typ)
+		if !p.enum.Open {
+			m.printf("\tif e != \"\" && !e.IsKnown() {\n")
+			m.printf("\t\treportUnknownEnum(%q, string(e))\n", typ)
+			m.printf("\t}\n")
+		}
+		m.printf("\treturn json.Marshal(string(e))\n")
+		m.printf("}\n\n")
+
+		if p.enum.List {
+			m.emitEnumList(p)
 		}
-		m.printf(")\n")
 	}
+}
+
+// emitEnumList emits the list type of an enum list property.
+func (m *menu) emitEnumList(p *property) {
+	typ := p.enumType
+	m.printf("// %s is the type of the `%s` property, a list of\n", p.gotype, p.name)
+	m.printf("// %s values. If Not is set, the property matches all values not in\n", typ)
+	m.printf("// the list.\n")
+	m.printf("type %s struct {\n", p.gotype)
+	m.printf("\tNot bool\n")
+	m.printf("\tValues []%s\n", typ)
+	m.printf("}\n\n")
+
+	m.printf("// %sPtr returns a pointer to %s, for use in _Update structs.\n", p.gotype, p.gotype)
+	m.printf("func %sPtr(not bool, values ...%s) *%s {\n", p.gotype, typ, p.gotype)
+	m.printf("\treturn &%s{Not: not, Values: values}\n", p.gotype)
+	m.printf("}\n\n")
+
+	m.printf("// Equal returns whether both lists contain the same values in the same order,\n")
+	m.printf("// and are negated alike.\n")
+	m.printf("func (l %s) Equal(o %s) bool {\n", p.gotype, p.gotype)
+	m.printf("\tif l.Not != o.Not || len(l.Values) != len(o.Values) {\n")
+	m.printf("\t\treturn false\n")
+	m.printf("\t}\n")
+	m.printf("\tfor i := range l.Values {\n")
+	m.printf("\t\tif l.Values[i] != o.Values[i] {\n")
+	m.printf("\t\t\treturn false\n")
+	m.printf("\t\t}\n")
+	m.printf("\t}\n")
+	m.printf("\treturn true\n")
+	m.printf("}\n\n")
+
+	m.printf("func (l %s) String() string {\n", p.gotype)
+	m.printf("\tvalues := make([]string, len(l.Values))\n")
+	m.printf("\tfor i, v := range l.Values {\n")
+	m.printf("\t\tvalues[i] = string(v)\n")
+	m.printf("\t}\n")
+	m.printf("\treturn formatEnumList(l.Not, values)\n")
+	m.printf("}\n\n")
+
+	m.printf("func (l *%s) UnmarshalJSON(b []byte) error {\n", p.gotype)
+	m.printf("\tvar s string\n")
+	m.printf("\tif err := json.Unmarshal(b, &s); err != nil {\n")
+	m.printf("\t\treturn err\n")
+	m.printf("\t}\n")
+	m.printf("\tnot, values := parseEnumList(s)\n")
+	m.printf("\t*l = %s{Not: not}\n", p.gotype)
+	m.printf("\tfor _, v := range values {\n")
+	m.printf("\t\te := %s(v)\n", typ)
+	if !p.enum.Open {
+		m.printf("\t\tif !e.IsKnown() {\n")
+		m.printf("\t\t\treportUnknownEnum(%q, v)\n", typ)
+		m.printf("\t\t}\n")
+	}
+	m.printf("\t\tl.Values = append(l.Values, e)\n")
+	m.printf("\t}\n")
+	m.printf("\treturn nil\n")
+	m.printf("}\n\n")
+
+	m.printf("func (l *%s) MarshalJSON() ([]byte, error) {\n", p.gotype)
+	if !p.enum.Open {
+		m.printf("\tfor _, v := range l.Values {\n")
+		m.printf("\t\tif !v.IsKnown() {\n")
+		m.printf("\t\t\treportUnknownEnum(%q, string(v))\n", typ)
+		m.printf("\t\t}\n")
+		m.printf("\t}\n")
+	}
+	m.printf("\treturn json.Marshal(l.String())\n")
+	m.printf("}\n\n")
+}
+
+// writeSets writes the enum types of all property sets, one file per set.
+func writeSets(root string) error {
+	var names []string
+	for name := range propertySets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		set := propertySets[name]
+		m := &menu{imports: make(map[string]bool)}
+		var properties []*property
+		for _, p := range set.Property {
+			properties = append(properties, propertyFromProto(p, setGoName(set)))
+		}
+		m.emitEnums(properties)
+		if m.buf.Len() == 0 {
+			continue
+		}
+		m.prependHeader()
+		path := path.Join(root, fmt.Sprintf("zz_set_%s.go", strings.ReplaceAll(name, "-", "_")))
+		log.Printf("Writing %s...", path)
+		src, err := format.Source(m.buf.Bytes())
+		if err != nil {
+			return fmt.Errorf("could not format set %s: %w", name, err)
+		}
+		if err := ioutil.WriteFile(path, src, 0644); err != nil {
+			return fmt.Errorf("could not write set %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// generateRecord emits the record types and CRUD methods for this menu
+// element.
+func (m *menu) generateRecord(sname string) error {
+	// Parse properties.
+	properties, err := recordProperties(m.m.Record, sname)
+	if err != nil {
+		return err
+	}
+
+	m.imports["encoding/json"] = true
+
+	// Emit enums, other than those of property sets.
+	var own []*property
+	for _, p := range properties {
+		if p.set == "" {
+			own = append(own, p)
+		}
+	}
+	m.emitEnums(own)
 
 	// Emit record type.
-	m.printf("// %s represents a ROS `%s` record, including read-only fields.\n", sname, m.path)
+	singleton := m.m.Record.Singleton
+	if singleton {
+		m.printf("// %s represents the ROS `%s` singleton record, including read-only fields.\n", sname, m.path)
+	} else {
+		m.printf("// %s represents a ROS `%s` record, including read-only fields.\n", sname, m.path)
+	}
 	if m.m.Record.Description != "" {
 		m.printf("//\n")
 		m.printf("// %s\n", m.m.Record.Description)
 	}
 	m.printf("type %s struct {\n", sname)
-	m.printf("\tRecord\n\n")
+	if !singleton {
+		m.printf("\tRecord\n\n")
+	}
 	for _, p := range properties {
 		if p.p.Description != "" {
 			m.printf("\t// %s\n", p.p.Description)
@@ -170,9 +550,68 @@ func (m *menu) generate() error {
 	}
 	m.printf("}\n\n")
 
+	m.generateUpdateHelpers(sname, properties)
+
+	if singleton {
+		settable := false
+		for _, p := range properties {
+			if !p.p.ReadOnly {
+				settable = true
+			}
+		}
+		m.generateSingleton(sname, settable)
+		return nil
+	}
+
+	// Emit field names, filter and list options.
+	m.printf("// %s_Field is the name of a `%s` record property, for use in .proplist\n", sname, m.path)
+	m.printf("// projections.\n")
+	m.printf("type %s_Field string\n\n", sname)
+	m.printf("const (\n")
+	m.printf("\t%s_FieldID %s_Field = \".id\"\n", sname, sname)
+	for _, p := range properties {
+		m.printf("\t%s_Field%s %s_Field = %q\n", sname, p.goname, sname, p.name)
+	}
+	m.printf(")\n\n")
+
+	m.printf("// %s_Filter is an equality filter on `%s` records, evaluated by ROS.\n", sname, m.path)
+	m.printf("// Any unset field will not be filtered on.\n")
+	m.printf("type %s_Filter struct {\n", sname)
+	m.printf("\tID\t*RecordID\t`json:\".id,omitempty\"`\n")
+	for _, p := range properties {
+		m.printf("\t%s\t*%s\t`json:\"%s,omitempty\"`\n", p.goname, p.gotype, p.name)
+	}
+	m.printf("}\n\n")
+
+	m.printf("// %s_ListOptions limits the records and fields returned by %sListWith.\n", sname, sname)
+	m.printf("type %s_ListOptions struct {\n", sname)
+	m.printf("\t// Filter, if set, only returns records matching all set fields.\n")
+	m.printf("\tFilter *%s_Filter\n", sname)
+	m.printf("\t// Proplist, if set, only returns the given fields of every record.\n")
+	m.printf("\tProplist []%s_Field\n", sname)
+	m.printf("}\n\n")
+
 	m.printf("// %sList returns a list of all `%s` records.\n", sname, m.path)
 	m.printf("func (c *Client) %sList(ctx context.Context) ([]%s, error) {\n", sname, sname)
-	m.printf("\tbody, err := c.doGET(ctx, %q)\n", m.path)
+	m.printf("\treturn c.%sListWith(ctx, nil)\n", sname)
+	m.printf("}\n\n")
+
+	m.printf("// %sListWith returns a list of all `%s` records, optionally filtered\n", sname, m.path)
+	m.printf("// and projected by ROS according to the given options, which may be nil.\n")
+	m.printf("func (c *Client) %sListWith(ctx context.Context, opts *%s_ListOptions) ([]%s, error) {\n", sname, sname, sname)
+	m.printf("\tvar filter *%s_Filter\n", sname)
+	m.printf("\tvar proplist []string\n")
+	m.printf("\tif opts != nil {\n")
+	m.printf("\t\tfilter = opts.Filter\n")
+	m.printf("\t\tfor _, f := range opts.Proplist {\n")
+	m.printf("\t\t\tproplist = append(proplist, string(f))\n")
+	m.printf("\t\t}\n")
+	m.printf("\t}\n")
+	m.printf("\tquery, err := listQuery(filter, proplist)\n")
+	m.printf("\tif err != nil {\n")
+	m.printf("\t\treturn nil, fmt.Errorf(\"invalid options: %%w\", err)\n")
+	m.printf("\t}\n")
+	m.printf("\tbody, err := c.doGET(ctx, %q, \"\", query)\n", m.path)
 	m.printf("\tif err != nil {\n")
 	m.printf("\t\treturn nil, fmt.Errorf(\"could not GET: %%w\", err)\n")
 	m.printf("\t}\n")
@@ -184,41 +623,503 @@ func (m *menu) generate() error {
 	m.printf("\treturn target, nil\n")
 	m.printf("}\n\n")
 
+	m.printf("// %sFind returns all `%s` records matching the given filter, which may\n", sname, m.path)
+	m.printf("// be nil. The filter is sent as a print .query and evaluated by ROS. If\n")
+	m.printf("// proplist is given, only the given fields of every record are returned.\n")
+	m.printf("func (c *Client) %sFind(ctx context.Context, filter *%s_Filter, proplist ...%s_Field) ([]%s, error) {\n", sname, sname, sname, sname)
+	m.printf("\tvar props []string\n")
+	m.printf("\tfor _, f := range proplist {\n")
+	m.printf("\t\tprops = append(props, string(f))\n")
+	m.printf("\t}\n")
+	m.printf("\trdata, err := printQuery(filter, props)\n")
+	m.printf("\tif err != nil {\n")
+	m.printf("\t\treturn nil, fmt.Errorf(\"invalid filter: %%w\", err)\n")
+	m.printf("\t}\n")
+	m.printf("\tbody, err := c.doPOST(ctx, %q, \"print\", rdata)\n", m.path)
+	m.printf("\tif err != nil {\n")
+	m.printf("\t\treturn nil, fmt.Errorf(\"could not POST: %%w\", err)\n")
+	m.printf("\t}\n")
+	m.printf("\tdefer body.Close()\n\n")
+	m.printf("\tvar target []%s\n", sname)
+	m.printf("\tif err := json.NewDecoder(body).Decode(&target); err != nil {\n")
+	m.printf("\t\treturn nil, fmt.Errorf(\"could not decode JSON: %%w\", err)\n")
+	m.printf("\t}\n")
+	m.printf("\treturn target, nil\n")
+	m.printf("}\n\n")
+
 	m.printf("// %sPatch updates the given fields of a `%s` record by ID.\n", sname, m.path)
 	m.printf("func (c *Client) %sPatch(ctx context.Context, id RecordID, u *%s_Update) (*%s, error) {\n", sname, sname, sname)
 	m.printf("\trdata, err := json.Marshal(u)\n")
 	m.printf("\tif err != nil {\n")
 	m.printf("\t\treturn nil, fmt.Errorf(\"could not marshal update: %%w\", err)\n")
 	m.printf("\t}\n")
-	m.printf("\tbody, err := c.doPATCH(ctx, %q+string(id), rdata)\n", m.path+"/")
+	m.printf("\tbody, err := c.doPATCH(ctx, %q, id, rdata)\n", m.path)
 	m.printf("\tif err != nil {\n")
 	m.printf("\t\treturn nil, fmt.Errorf(\"could not PATCH: %%w\", err)\n")
 	m.printf("\t}\n")
 	m.printf("\tdefer body.Close()\n\n")
-	m.printf("\tvar target struct {\n")
-	m.printf("\t\t%s\n", sname)
-	m.printf("\t\tError int64 `json:\"error\"`\n")
-	m.printf("\t\tMessage string `json:\"message\"`\n")
-	m.printf("\t\tDetail string `json:\"detail\"`\n")
+	m.printf("\tvar target %s\n", sname)
+	m.printf("\tif err := json.NewDecoder(body).Decode(&target); err != nil {\n")
+	m.printf("\t\treturn nil, fmt.Errorf(\"could not decode JSON: %%w\", err)\n")
 	m.printf("\t}\n")
+	m.printf("\treturn &target, nil\n")
+	m.printf("}\n\n")
+
+	m.printf("// %sGet returns a single `%s` record by ID.\n", sname, m.path)
+	m.printf("func (c *Client) %sGet(ctx context.Context, id RecordID) (*%s, error) {\n", sname, sname)
+	m.printf("\tbody, err := c.doGET(ctx, %q, id, nil)\n", m.path)
+	m.printf("\tif err != nil {\n")
+	m.printf("\t\treturn nil, fmt.Errorf(\"could not GET: %%w\", err)\n")
+	m.printf("\t}\n")
+	m.printf("\tdefer body.Close()\n\n")
+	m.printf("\tvar target %s\n", sname)
 	m.printf("\tif err := json.NewDecoder(body).Decode(&target); err != nil {\n")
 	m.printf("\t\treturn nil, fmt.Errorf(\"could not decode JSON: %%w\", err)\n")
 	m.printf("\t}\n")
-	m.printf("\tif target.Error != 0 {\n")
-	m.printf("\t\treturn nil, fmt.Errorf(\"server error: %%s: %%s\", target.Message, target.Detail)\n")
+	m.printf("\treturn &target, nil\n")
+	m.printf("}\n\n")
+
+	m.printf("// %sAdd creates a new `%s` record with the given fields set. Any unset\n", sname, m.path)
+	m.printf("// field will be populated by ROS with its default value.\n")
+	m.printf("func (c *Client) %sAdd(ctx context.Context, u *%s_Update) (*%s, error) {\n", sname, sname, sname)
+	m.printf("\trdata, err := json.Marshal(u)\n")
+	m.printf("\tif err != nil {\n")
+	m.printf("\t\treturn nil, fmt.Errorf(\"could not marshal update: %%w\", err)\n")
 	m.printf("\t}\n")
-	m.printf("\treturn &target.%s, nil\n", sname)
+	m.printf("\tbody, err := c.doPUT(ctx, %q, rdata)\n", m.path)
+	m.printf("\tif err != nil {\n")
+	m.printf("\t\treturn nil, fmt.Errorf(\"could not PUT: %%w\", err)\n")
+	m.printf("\t}\n")
+	m.printf("\tdefer body.Close()\n\n")
+	m.printf("\tvar target %s\n", sname)
+	m.printf("\tif err := json.NewDecoder(body).Decode(&target); err != nil {\n")
+	m.printf("\t\treturn nil, fmt.Errorf(\"could not decode JSON: %%w\", err)\n")
+	m.printf("\t}\n")
+	m.printf("\treturn &target, nil\n")
+	m.printf("}\n\n")
+
+	m.printf("// %sRemove deletes a `%s` record by ID.\n", sname, m.path)
+	m.printf("func (c *Client) %sRemove(ctx context.Context, id RecordID) error {\n", sname)
+	m.printf("\tbody, err := c.doDELETE(ctx, %q, id)\n", m.path)
+	m.printf("\tif err != nil {\n")
+	m.printf("\t\treturn fmt.Errorf(\"could not DELETE: %%w\", err)\n")
+	m.printf("\t}\n")
+	m.printf("\tbody.Close()\n")
+	m.printf("\treturn nil\n")
 	m.printf("}\n\n")
+
+	if m.m.Record.Ordered {
+		m.generateOrdered(sname)
+	}
+	m.generateWatch(sname)
 	return nil
 }
 
-func (m *menu) writeGo(root string) error {
+// generateOrdered emits the AddBefore and Move methods for an ordered table
+// record.
+func (m *menu) generateOrdered(sname string) {
+	m.printf("// %sAddBefore creates a new `%s` record with the given fields set,\n", sname, m.path)
+	m.printf("// placed before the record with the given ID. Any unset field will be\n")
+	m.printf("// populated by ROS with its default value.\n")
+	m.printf("func (c *Client) %sAddBefore(ctx context.Context, u *%s_Update, before RecordID) (*%s, error) {\n", sname, sname, sname)
+	m.printf("\trdata, err := json.Marshal(struct {\n")
+	m.printf("\t\t*%s_Update\n", sname)
+	m.printf("\t\tPlaceBefore RecordID `json:\"place-before\"`\n")
+	m.printf("\t}{u, before})\n")
+	m.printf("\tif err != nil {\n")
+	m.printf("\t\treturn nil, fmt.Errorf(\"could not marshal update: %%w\", err)\n")
+	m.printf("\t}\n")
+	m.printf("\tbody, err := c.doPUT(ctx, %q, rdata)\n", m.path)
+	m.printf("\tif err != nil {\n")
+	m.printf("\t\treturn nil, fmt.Errorf(\"could not PUT: %%w\", err)\n")
+	m.printf("\t}\n")
+	m.printf("\tdefer body.Close()\n\n")
+	m.printf("\tvar target %s\n", sname)
+	m.printf("\tif err := json.NewDecoder(body).Decode(&target); err != nil {\n")
+	m.printf("\t\treturn nil, fmt.Errorf(\"could not decode JSON: %%w\", err)\n")
+	m.printf("\t}\n")
+	m.printf("\treturn &target, nil\n")
+	m.printf("}\n\n")
+
+	m.printf("// %sMove moves the `%s` records with the given IDs, in the given\n", sname, m.path)
+	m.printf("// order, before the record with ID before, or to the end if before is empty.\n")
+	m.printf("func (c *Client) %sMove(ctx context.Context, ids []RecordID, before RecordID) error {\n", sname)
+	m.printf("\treturn c.MoveRows(ctx, %q, ids, before)\n", m.path)
+	m.printf("}\n\n")
+}
+
+// generateWatch emits the event type and Watch method for a table record.
+func (m *menu) generateWatch(sname string) {
+	m.imports["time"] = true
+	m.printf("// %s_Event is a change to a `%s` record observed by %sWatch.\n", sname, m.path, sname)
+	m.printf("type %s_Event struct {\n", sname)
+	m.printf("\tType EventType\n")
+	m.printf("\t// Before is the record before the change, nil if Type is EventAdded.\n")
+	m.printf("\tBefore *%s\n", sname)
+	m.printf("\t// After is the record after the change, nil if Type is EventRemoved.\n")
+	m.printf("\tAfter *%s\n", sname)
+	m.printf("\t// Err is the polling error if Type is EventError.\n")
+	m.printf("\tErr error\n")
+	m.printf("}\n\n")
+
+	m.printf("// %sWatch polls the `%s` records every interval and sends an event for\n", sname, m.path)
+	m.printf("// every record added, modified or removed since the previous poll, starting\n")
+	m.printf("// with an EventAdded for every existing record. Polling errors are sent as\n")
+	m.printf("// EventError, and retried with backoff. The channel is closed once ctx is\n")
+	m.printf("// canceled, or after a single EventError if interval is not positive.\n")
+	m.printf("func (c *Client) %sWatch(ctx context.Context, interval time.Duration) <-chan %s_Event {\n", sname, sname)
+	m.printf("\tch := make(chan %s_Event)\n", sname)
+	m.printf("\tlist := func(ctx context.Context) ([]watchedRecord, error) {\n")
+	m.printf("\t\trecords, err := c.%sList(ctx)\n", sname)
+	m.printf("\t\tif err != nil {\n")
+	m.printf("\t\t\treturn nil, err\n")
+	m.printf("\t\t}\n")
+	m.printf("\t\tres := make([]watchedRecord, len(records))\n")
+	m.printf("\t\tfor i := range records {\n")
+	m.printf("\t\t\tres[i] = watchedRecord{id: records[i].ID, record: &records[i]}\n")
+	m.printf("\t\t}\n")
+	m.printf("\t\treturn res, nil\n")
+	m.printf("\t}\n")
+	m.printf("\temit := func(t EventType, before, after interface{}, err error) bool {\n")
+	m.printf("\t\tev := %s_Event{Type: t, Err: err}\n", sname)
+	m.printf("\t\tif before != nil {\n")
+	m.printf("\t\t\tev.Before = before.(*%s)\n", sname)
+	m.printf("\t\t}\n")
+	m.printf("\t\tif after != nil {\n")
+	m.printf("\t\t\tev.After = after.(*%s)\n", sname)
+	m.printf("\t\t}\n")
+	m.printf("\t\tselect {\n")
+	m.printf("\t\tcase ch <- ev:\n")
+	m.printf("\t\t\treturn true\n")
+	m.printf("\t\tcase <-ctx.Done():\n")
+	m.printf("\t\t\treturn false\n")
+	m.printf("\t\t}\n")
+	m.printf("\t}\n")
+	m.printf("\tgo watch(ctx, interval, list, emit, func() { close(ch) })\n")
+	m.printf("\treturn ch\n")
+	m.printf("}\n\n")
+}
+
+// generateUpdateHelpers emits ToUpdate, Diff and IsEmpty for a record type.
+func (m *menu) generateUpdateHelpers(sname string, properties []*property) {
+	var settable []*property
+	for _, p := range properties {
+		if !p.p.ReadOnly {
+			settable = append(settable, p)
+		}
+	}
+
+	m.printf("// ToUpdate returns an update setting all settable fields of a `%s` record to\n", m.path)
+	m.printf("// their values in r. Fields unset in r which ROS doesn't accept empty (eg.\n")
+	m.printf("// addresses, port lists or enums) are left out, so that listed records can be\n")
+	m.printf("// written back. List values are shared between r and the update.\n")
+	m.printf("func (r *%s) ToUpdate() *%s_Update {\n", sname, sname)
+	m.printf("\tu := &%s_Update{}\n", sname)
+	for _, p := range settable {
+		indent := "\t"
+		if set := p.isSet("r." + p.goname); set != "" {
+			m.printf("\tif %s {\n", set)
+			indent = "\t\t"
+		}
+		m.printf("%su.%s = new(%s)\n", indent, p.goname, p.gotype)
+		m.printf("%s*u.%s = r.%s\n", indent, p.goname, p.goname)
+		if indent != "\t" {
+			m.printf("\t}\n")
+		}
+	}
+	m.printf("\treturn u\n")
+	m.printf("}\n\n")
+
+	m.printf("// Diff%s returns an update which changes current into desired, setting only\n", sname)
+	m.printf("// the settable fields that differ between them. Read-only fields, and fields\n")
+	m.printf("// left out by ToUpdate when unset in desired, are ignored. The update IsEmpty if\n")
+	m.printf("// there is nothing to change.\n")
+	m.printf("func Diff%s(current, desired *%s) *%s_Update {\n", sname, sname, sname)
+	m.printf("\tu := &%s_Update{}\n", sname)
+	for _, p := range settable {
+		cond := p.differ("current."+p.goname, "desired."+p.goname)
+		if set := p.isSet("desired." + p.goname); set != "" {
+			cond = fmt.Sprintf("(%s) && %s", set, cond)
+		}
+		m.printf("\tif %s {\n", cond)
+		m.printf("\t\tu.%s = new(%s)\n", p.goname, p.gotype)
+		m.printf("\t\t*u.%s = desired.%s\n", p.goname, p.goname)
+		m.printf("\t}\n")
+	}
+	m.printf("\treturn u\n")
+	m.printf("}\n\n")
+
+	m.printf("// IsEmpty returns whether the update does not set any field, ie. applying it\n")
+	m.printf("// would be a no-op.\n")
+	m.printf("func (u *%s_Update) IsEmpty() bool {\n", sname)
+	if len(settable) == 0 {
+		m.printf("\treturn true\n")
+	} else {
+		var conds []string
+		for _, p := range settable {
+			conds = append(conds, fmt.Sprintf("u.%s == nil", p.goname))
+		}
+		m.printf("\treturn %s\n", strings.Join(conds, " &&\n\t\t"))
+	}
+	m.printf("}\n\n")
+}
+
+// generateSingleton emits the methods for a singleton record (ie. one without
+// IDs, like system/identity) within this menu element. Set is only emitted if
+// the record has any settable properties.
+func (m *menu) generateSingleton(sname string, settable bool) {
+	m.printf("// %sGet returns the `%s` singleton record.\n", sname, m.path)
+	m.printf("func (c *Client) %sGet(ctx context.Context) (*%s, error) {\n", sname, sname)
+	m.printf("\tbody, err := c.doGET(ctx, %q, \"\", nil)\n", m.path)
+	m.printf("\tif err != nil {\n")
+	m.printf("\t\treturn nil, fmt.Errorf(\"could not GET: %%w\", err)\n")
+	m.printf("\t}\n")
+	m.printf("\tdefer body.Close()\n\n")
+	m.printf("\tvar target %s\n", sname)
+	m.printf("\tif err := json.NewDecoder(body).Decode(&target); err != nil {\n")
+	m.printf("\t\treturn nil, fmt.Errorf(\"could not decode JSON: %%w\", err)\n")
+	m.printf("\t}\n")
+	m.printf("\treturn &target, nil\n")
+	m.printf("}\n\n")
+
+	if !settable {
+		return
+	}
+	m.printf("// %sSet updates the given fields of the `%s` singleton record.\n", sname, m.path)
+	m.printf("func (c *Client) %sSet(ctx context.Context, u *%s_Update) error {\n", sname, sname)
+	m.printf("\trdata, err := json.Marshal(u)\n")
+	m.printf("\tif err != nil {\n")
+	m.printf("\t\treturn fmt.Errorf(\"could not marshal update: %%w\", err)\n")
+	m.printf("\t}\n")
+	m.printf("\tbody, err := c.doPOST(ctx, %q, \"set\", rdata)\n", m.path)
+	m.printf("\tif err != nil {\n")
+	m.printf("\t\treturn fmt.Errorf(\"could not POST: %%w\", err)\n")
+	m.printf("\t}\n")
+	m.printf("\tbody.Close()\n")
+	m.printf("\treturn nil\n")
+	m.printf("}\n\n")
+}
+
+// emitPropertySchemas emits a []*PropertySchema literal for the given
+// properties.
+func (m *menu) emitPropertySchemas(properties []*property) {
+	m.printf("[]*PropertySchema{\n")
+	for _, p := range properties {
+		m.printf("{Name: %q, Kind: %s", p.name, p.kind)
+		if p.p.ReadOnly {
+			m.printf(", ReadOnly: true")
+		}
+		if v := p.p.Since; v != "" {
+			m.printf(", Since: %s", goVersion(v))
+		}
+		if v := p.p.Until; v != "" {
+			m.printf(", Until: %s", goVersion(v))
+		}
+		if p.enum != nil && p.enum.Open {
+			m.printf(", Open: true")
+		}
+		if p.enum != nil {
+			m.printf(", Variants: []string{")
+			for i, v := range p.enum.Variant {
+				if i != 0 {
+					m.printf(", ")
+				}
+				m.printf("%q", v.Value)
+			}
+			m.printf("}")
+		}
+		m.printf("},\n")
+	}
+	m.printf("}")
+}
+
+// generateSchema emits the MenuSchema describing this menu element, and
+// registers it.
+func (m *menu) generateSchema(sname string) error {
+	if sname == "" {
+		sname = "Root"
+	}
+	if m.path == "" {
+		m.printf("// %s_Schema describes the root menu.\n", sname)
+	} else {
+		m.printf("// %s_Schema describes the `%s` menu.\n", sname, m.path)
+	}
+	m.printf("var %s_Schema = &MenuSchema{\n", sname)
+	m.printf("\tPath: %q,\n", m.path)
+	since, until := m.m.Since, m.m.Until
 	if r := m.m.Record; r != nil {
+		if since == "" {
+			since = r.Since
+		}
+		if until == "" {
+			until = r.Until
+		}
+	}
+	if since != "" {
+		m.printf("\tSince: %s,\n", goVersion(since))
+	}
+	if until != "" {
+		m.printf("\tUntil: %s,\n", goVersion(until))
+	}
+	if r := m.m.Record; r != nil {
+		properties, err := recordProperties(r, sname)
+		if err != nil {
+			return err
+		}
+		if r.Singleton {
+			m.printf("\tSingleton: true,\n")
+		} else {
+			m.printf("\tTable: true,\n")
+		}
+		if len(r.Key) > 0 {
+			m.printf("\tKey: %#v,\n", r.Key)
+		}
+		if r.Ordered {
+			m.printf("\tOrdered: true,\n")
+		}
+		m.printf("\tProperties: ")
+		m.emitPropertySchemas(properties)
+		m.printf(",\n")
+	}
+	if len(m.m.Command) > 0 {
+		m.printf("\tCommands: []*CommandSchema{\n")
+		for _, c := range m.m.Command {
+			var args, reply []*property
+			for _, p := range c.Argument {
+				args = append(args, propertyFromProto(p, sname))
+			}
+			for _, p := range c.Reply {
+				reply = append(reply, propertyFromProto(p, sname))
+			}
+			m.printf("{\n")
+			m.printf("Name: %q,\n", c.Name)
+			if len(args) > 0 {
+				m.printf("Arguments: ")
+				m.emitPropertySchemas(args)
+				m.printf(",\n")
+			}
+			if len(reply) > 0 {
+				m.printf("Reply: ")
+				m.emitPropertySchemas(reply)
+				m.printf(",\n")
+			}
+			m.printf("},\n")
+		}
+		m.printf("\t},\n")
+	}
+	m.printf("}\n\n")
+	m.printf("func init() {\n")
+	m.printf("\tregisterMenu(%s_Schema)\n", sname)
+	m.printf("}\n")
+	return nil
+}
+
+// generateCommand emits the argument and reply types, and the method for a
+// command within this menu element.
+func (m *menu) generateCommand(sname string, c *kpb.Command) error {
+	goname := c.GoName
+	if goname == "" {
+		goname = goify(c.Name)
+	}
+	cname := sname + goname
+	cpath := c.Name
+	if m.path != "" {
+		cpath = m.path + "/" + c.Name
+	}
+
+	var args, reply []*property
+	for _, p := range c.Argument {
+		args = append(args, propertyFromProto(p, cname))
+	}
+	for _, p := range c.Reply {
+		reply = append(reply, propertyFromProto(p, cname))
+	}
+	m.emitEnums(args)
+	m.emitEnums(reply)
+
+	if len(args) > 0 {
+		m.printf("// %s_Args are the arguments to the ROS `%s` command. Any unset argument will\n", cname, cpath)
+		m.printf("// not be passed.\n")
+		m.printf("type %s_Args struct {\n", cname)
+		for _, p := range args {
+			if p.p.Description != "" {
+				m.printf("\t// %s\n", p.p.Description)
+			}
+			m.printf("\t%s\t*%s\t`json:\"%s,omitempty\"`\n", p.goname, p.gotype, p.name)
+		}
+		m.printf("}\n\n")
+	}
+	if len(reply) > 0 {
+		m.printf("// %s_Reply is a row returned by the ROS `%s` command.\n", cname, cpath)
+		m.printf("type %s_Reply struct {\n", cname)
+		for _, p := range reply {
+			if p.p.Description != "" {
+				m.printf("\t// %s\n", p.p.Description)
+			}
+			m.printf("\t%s\t%s\t`json:\"%s\"`\n", p.goname, p.gotype, p.name)
+		}
+		m.printf("}\n\n")
+	}
+
+	m.printf("// %s runs the ROS `%s` command.\n", cname, cpath)
+	if c.Description != "" {
+		m.printf("//\n")
+		m.printf("// %s\n", c.Description)
+	}
+	params := "ctx context.Context"
+	if len(args) > 0 {
+		params += fmt.Sprintf(", args *%s_Args", cname)
+	}
+	if len(reply) > 0 {
+		m.printf("func (c *Client) %s(%s) ([]%s_Reply, error) {\n", cname, params, cname)
+	} else {
+		m.printf("func (c *Client) %s(%s) error {\n", cname, params)
+	}
+	// errRet is the return statement prefix for errors.
+	errRet := "return "
+	if len(reply) > 0 {
+		errRet = "return nil, "
+	}
+	m.printf("\trdata := []byte(\"{}\")\n")
+	if len(args) > 0 {
+		m.imports["encoding/json"] = true
+		m.printf("\tif args != nil {\n")
+		m.printf("\t\tvar err error\n")
+		m.printf("\t\trdata, err = json.Marshal(args)\n")
+		m.printf("\t\tif err != nil {\n")
+		m.printf("\t\t\t%sfmt.Errorf(\"could not marshal arguments: %%w\", err)\n", errRet)
+		m.printf("\t\t}\n")
+		m.printf("\t}\n")
+	}
+	m.printf("\tbody, err := c.doPOST(ctx, %q, %q, rdata)\n", m.path, c.Name)
+	m.printf("\tif err != nil {\n")
+	m.printf("\t\t%sfmt.Errorf(\"could not POST: %%w\", err)\n", errRet)
+	m.printf("\t}\n")
+	m.printf("\tdefer body.Close()\n\n")
+	if len(reply) > 0 {
+		m.printf("\tvar target []%s_Reply\n", cname)
+		m.printf("\tif err := decodeRows(body, &target); err != nil {\n")
+		m.printf("\t\treturn nil, fmt.Errorf(\"could not decode JSON: %%w\", err)\n")
+		m.printf("\t}\n")
+		m.printf("\treturn target, nil\n")
+	} else {
+		m.printf("\treturn nil\n")
+	}
+	m.printf("}\n\n")
+	return nil
+}
+
+func (m *menu) writeGo(root string) error {
+	if m.m.Record != nil || len(m.m.Command) > 0 {
 		if err := m.generate(); err != nil {
 			return fmt.Errorf("could not generate %s: %w", m.path, err)
 		}
-		pathParts := strings.Split(m.path, "/")
-		path := path.Join(root, fmt.Sprintf("zz_%s.go", strings.Join(pathParts, "_")))
+		name := strings.NewReplacer("/", "_", "-", "_").Replace(m.path)
+		if m.path == "" {
+			// Commands at the root of the menu tree, eg. ping.
+			name = "root"
+		}
+		path := path.Join(root, fmt.Sprintf("zz_%s.go", name))
 		log.Printf("Writing %s...", path)
 		src, err := format.Source(m.buf.Bytes())
 		if err != nil {
@@ -239,6 +1140,10 @@ func (m *menu) writeGo(root string) error {
 func main() {
 	flag.StringVar(&flagTypesPath, "types_path", "gen/types.text.pb", "Path to types prototext")
 	flag.Parse()
+	if flag.Arg(0) == "inspect-import" {
+		inspectImport(flag.Args()[1:])
+		return
+	}
 	data, err := ioutil.ReadFile(flagTypesPath)
 	if err != nil {
 		log.Fatalf("Could not load types prototext: %v", err)
@@ -248,11 +1153,17 @@ func main() {
 		log.Fatalf("Could not unmarshal types prototext: %v", err)
 	}
 
+	for _, set := range m.PropertySet {
+		propertySets[set.Name] = set
+	}
 	tree := recurse(&m, "")
 	err = tree.writeGo("ros")
 	if err != nil {
 		panic(err)
 	}
+	if err := writeSets("ros"); err != nil {
+		panic(err)
+	}
 }
 
 func recurse(m *kpb.Menu, path string) *menu {
@@ -7,6 +7,8 @@ sub {
       # /interface bridge vlan
       name: "vlan"
       record {
+        key: "bridge"
+        key: "vlan-ids"
         description: "Bridge VLAN table represents per-VLAN port mapping with an egress VLAN tag action. The tagged ports send out frames with a corresponding VLAN ID tag. The untagged ports remove a VLAN tag before sending out frames. Bridge ports with frame-types set to admit-all or admit-only-untagged-and-priority-tagged will be automatically added as untagged ports for the pvid VLAN."
         property {
           name: "bridge" type_string { }
@@ -44,6 +46,7 @@ sub {
       # /interface bridge port
       name: "port"
       record {
+        key: "interface"
         description: "Port submenu is used to add interfaces in a particular bridge."
         property {
           name: "auto-isolate" type_boolean { }
@@ -193,7 +196,1581 @@ sub {
           name: "unknown-unicast-flood" type_boolean { }
           description: "Changes the unknown unicast flood option on bridge port, only controls the egress traffic. When enabled, the bridge allows flooding unknown unicast packets to the specified bridge port, but when disabled, the bridge restricts unknown unicast traffic from being flooded to the specified bridge port. If a MAC address is not learned in the host table, then the traffic is considered as unknown unicast traffic and will be flooded to all ports. MAC address is learned as soon as a packet on a bridge port is received and the source MAC address is added to the bridge host table. Since it is required for the bridge to receive at least one packet on the bridge port to learn the MAC address, it is recommended to use static bridge host entries to avoid packets being dropped until the MAC address has been learned."
         }
+        property {
+          name: "mvrp-applicant-state" go_name: "MVRPApplicantState" since: "7.15" type_enum {
+            variant {
+              value: "non-participant"
+              description: "the port does not send any MRP messages."
+            }
+            variant {
+              value: "normal-participant"
+              description: "the port participates normally in MRP exchanges."
+            }
+          }
+          description: "MVRP applicant options. This property only has an effect when mvrp is set to yes on the bridge."
+        }
+        property {
+          name: "mvrp-registrar-state" go_name: "MVRPRegistrarState" since: "7.15" type_enum {
+            variant {
+              value: "fixed"
+              description: "the port ignores all MRP messages, and remains registered in all configured VLANs."
+            }
+            variant {
+              value: "normal"
+              description: "the port receives and processes MRP messages."
+            }
+          }
+          description: "MVRP registrar options. This property only has an effect when mvrp is set to yes on the bridge."
+        }
+      }
+    }
+  }
+}
+sub {
+  name: "system"
+  command {
+    # https://help.mikrotik.com/docs/display/ROS/Reboot
+    # /system reboot
+    name: "reboot"
+    description: "Reboots the device."
+  }
+  sub {
+    # https://help.mikrotik.com/docs/display/ROS/Resource
+    # /system resource
+    name: "resource"
+    record {
+      singleton: true
+      description: "Basic information about the device and its resource usage."
+      property {
+        name: "version" read_only: true type_string { }
+        description: "Version of RouterOS running on the device, eg. 7.15.2 (stable)."
+      }
+      property {
+        name: "uptime" read_only: true type_duration { }
+        description: "Time since the device was booted."
+      }
+      property {
+        name: "build-time" read_only: true type_string { }
+        description: "Build time of the running RouterOS version."
+      }
+      property {
+        name: "cpu-load" go_name: "CPULoad" read_only: true type_number { }
+        description: "CPU usage in percent."
+      }
+      property {
+        name: "free-memory" read_only: true type_bytes { }
+      }
+      property {
+        name: "total-memory" read_only: true type_bytes { }
+      }
+      property {
+        name: "architecture-name" read_only: true type_string { }
+        description: "CPU architecture, eg. arm64."
+      }
+      property {
+        name: "board-name" read_only: true type_string { }
+        description: "Model of the device, eg. CRS326-24G-2S+."
+      }
+      property {
+        name: "platform" read_only: true type_string { }
+      }
+    }
+  }
+  sub {
+    # https://help.mikrotik.com/docs/display/ROS/Identity
+    # /system identity
+    name: "identity"
+    record {
+      singleton: true
+      description: "Identity is the name of the device, used eg. in the CLI prompt and announced by discovery protocols."
+      property {
+        name: "name" type_string { }
+        description: "Name of the device."
+      }
+    }
+  }
+  sub {
+    # https://help.mikrotik.com/docs/display/ROS/Clock
+    # /system clock
+    name: "clock"
+    record {
+      singleton: true
+      description: "System date and time settings."
+      property {
+        name: "time-zone-autodetect" type_boolean { }
+        description: "Whether the time zone should be detected automatically based on the public IP address of the device."
+      }
+      property {
+        name: "time-zone-name" type_string { }
+        description: "Name of the time zone, eg. Europe/Warsaw, or 'manual'."
+      }
+      property {
+        name: "date" read_only: true type_string { }
+        description: "Current date, eg. jan/02/2022."
+      }
+      property {
+        name: "time" read_only: true type_string { }
+        description: "Current time, eg. 13:37:00."
+      }
+      property {
+        name: "gmt-offset" go_name: "GMTOffset" read_only: true type_string { }
+        description: "Current offset from GMT, eg. +01:00."
+      }
+      property {
+        name: "dst-active" go_name: "DSTActive" read_only: true type_boolean { }
+        description: "Whether daylight saving time is currently in effect."
+      }
+    }
+  }
+  sub {
+    name: "package"
+    sub {
+      name: "update"
+      record {
+        singleton: true
+        description: "Package update settings and status."
+        property {
+          name: "channel" type_enum {
+            variant { value: "stable" }
+            variant { value: "long-term" }
+            variant { value: "testing" }
+            variant { value: "development" }
+          }
+          description: "Release channel used when checking for updates."
+        }
+        property {
+          name: "installed-version" read_only: true type_string { }
+          description: "Currently installed RouterOS version."
+        }
+        property {
+          name: "latest-version" read_only: true type_string { }
+          description: "Latest RouterOS version available in the channel, as of the last check."
+        }
+        property {
+          name: "status" read_only: true type_string { }
+          description: "Human readable status of the last check or update."
+        }
+      }
+      command {
+        # https://help.mikrotik.com/docs/display/ROS/Packages#Packages-Updatingpackages
+        # /system package update check-for-updates
+        name: "check-for-updates"
+        description: "Checks the upgrade server for a newer RouterOS version in the configured channel."
+        reply {
+          name: "channel" type_string { }
+          description: "Update channel that was checked, eg. stable."
+        }
+        reply {
+          name: "installed-version" type_string { }
+          description: "Currently installed RouterOS version."
+        }
+        reply {
+          name: "latest-version" type_string { }
+          description: "Latest RouterOS version available in the channel."
+        }
+        reply {
+          name: "status" type_string { }
+          description: "Human readable status of the check, eg. 'System is already up to date'."
+        }
+      }
+    }
+  }
+}
+# Properties shared by the firewall rule tables (filter, nat, mangle and raw)
+# of both ip/firewall and ipv6/firewall.
+property_set {
+  name: "firewall-rule"
+  property {
+    name: "src-address" type_string { }
+    description: "Source address, prefix or range to match, optionally negated with a ! prefix, eg. 10.0.0.0/8."
+  }
+  property {
+    name: "dst-address" type_string { }
+    description: "Destination address, prefix or range to match, optionally negated with a ! prefix, eg. 10.0.0.0/8."
+  }
+  property {
+    name: "src-address-list" type_string { }
+    description: "Address list the source address must be on, optionally negated with a ! prefix."
+  }
+  property {
+    name: "dst-address-list" type_string { }
+    description: "Address list the destination address must be on, optionally negated with a ! prefix."
+  }
+  property {
+    name: "protocol" type_enum {
+      variant { value: "tcp" }
+      variant { value: "udp" }
+      variant { value: "icmp" }
+      variant { value: "icmpv6" }
+      variant { value: "gre" }
+      variant { value: "ipsec-esp" }
+      variant { value: "ipsec-ah" }
+      variant { value: "ospf" }
+      variant { value: "vrrp" }
+      variant { value: "sctp" }
+      variant { value: "udp-lite" }
+      variant { value: "igmp" }
+      open: true
+    }
+    description: "IP protocol to match. Protocols not listed can be given by name or number."
+  }
+  property {
+    name: "src-port" type_port_list { }
+    description: "Source ports and port ranges to match, eg. 1024-65535. Requires protocol to be tcp, udp, udp-lite or sctp."
+  }
+  property {
+    name: "dst-port" type_port_list { }
+    description: "Destination ports and port ranges to match, eg. 80,443. Requires protocol to be tcp, udp, udp-lite or sctp."
+  }
+  property {
+    name: "port" type_port_list { }
+    description: "Ports and port ranges to match against either the source or the destination port."
+  }
+  property {
+    name: "in-interface" type_string { }
+    description: "Interface the packet entered the router through, optionally negated with a ! prefix."
+  }
+  property {
+    name: "out-interface" type_string { }
+    description: "Interface the packet leaves the router through, optionally negated with a ! prefix."
+  }
+  property {
+    name: "in-interface-list" type_string { }
+    description: "Interface list the input interface must be on, optionally negated with a ! prefix."
+  }
+  property {
+    name: "out-interface-list" type_string { }
+    description: "Interface list the output interface must be on, optionally negated with a ! prefix."
+  }
+  property {
+    name: "packet-mark" type_string { }
+    description: "Packet mark to match, as set by mangle rules."
+  }
+  property {
+    name: "routing-mark" type_string { }
+    description: "Routing mark to match, as set by mangle rules."
+  }
+  property {
+    name: "tcp-flags" type_string { }
+    description: "TCP flags to match, each optionally negated with a ! prefix, eg. syn,!ack."
+  }
+  property {
+    name: "icmp-options" go_name: "ICMPOptions" type_string { }
+    description: "ICMP type and code to match, eg. 8:0."
+  }
+  property {
+    name: "limit" type_string { }
+    description: "Rate limit above which packets stop matching, as count,time,burst, eg. 10,1s,5."
+  }
+  property {
+    name: "log" type_boolean { }
+    description: "Whether to log matched packets."
+  }
+  property {
+    name: "log-prefix" type_string { }
+    description: "Prefix of the log messages of matched packets."
+  }
+  property {
+    name: "jump-target" type_string { }
+    description: "Chain to continue in, for the jump action."
+  }
+  property {
+    name: "address-list" type_string { }
+    description: "Address list to add the address to, for the add-src-to-address-list and add-dst-to-address-list actions."
+  }
+  property {
+    name: "address-list-timeout" type_string { }
+    description: "How long the address is kept on the address list: a duration, none-dynamic or none-static."
+  }
+  property {
+    name: "comment" type_string { }
+    description: "Short description of the rule."
+  }
+  property {
+    name: "disabled" type_boolean { }
+    description: "Whether the rule is disabled."
+  }
+  property {
+    name: "bytes" read_only: true type_number { }
+    description: "Number of bytes matched by the rule."
+  }
+  property {
+    name: "packets" read_only: true type_number { }
+    description: "Number of packets matched by the rule."
+  }
+  property {
+    name: "dynamic" read_only: true type_boolean { }
+    description: "Whether the rule was added dynamically, eg. by a service."
+  }
+  property {
+    name: "invalid" read_only: true type_boolean { }
+    description: "Whether the rule is invalid, eg. because it refers to an interface that doesn't exist."
+  }
+}
+# Connection tracking matchers, shared by the firewall rule tables which see
+# tracked connections (all but raw).
+property_set {
+  name: "firewall-conntrack"
+  property {
+    name: "connection-state" type_enum {
+      variant { value: "established" description: "The packet belongs to a connection which has seen packets in both directions." }
+      variant { value: "related" description: "The packet starts a new connection related to an existing one, eg. an FTP data connection." }
+      variant { value: "new" description: "The packet starts a new connection." }
+      variant { value: "invalid" description: "The packet doesn't belong to any known connection, and doesn't start a new one." }
+      variant { value: "untracked" description: "The packet was excluded from connection tracking by a raw rule." }
+      list: true
+    }
+    description: "Connection tracking states to match, eg. established,related."
+  }
+  property {
+    name: "connection-nat-state" type_enum {
+      variant { value: "srcnat" description: "The connection is source NATed." }
+      variant { value: "dstnat" description: "The connection is destination NATed." }
+      list: true
+    }
+    description: "NAT states of the connection to match."
+  }
+  property {
+    name: "connection-mark" type_string { }
+    description: "Connection mark to match, as set by mangle rules."
+  }
+}
+sub {
+  name: "ip"
+  sub {
+    # https://help.mikrotik.com/docs/display/ROS/DNS
+    # /ip dns
+    name: "dns"
+    record {
+      singleton: true
+      description: "DNS client and cache settings."
+      property {
+        name: "allow-remote-requests" type_boolean { }
+        description: "Specifies whether to allow network requests."
+      }
+      property {
+        name: "cache-max-ttl" go_name: "CacheMaxTTL" type_duration { }
+        description: "Maximum time-to-live for cache records. In other words, cache records will expire unconditionally after cache-max-ttl time. Shorter TTL received from DNS servers are respected."
+      }
+      property {
+        name: "cache-size" type_number { }
+        description: "Specifies the size of DNS cache, in KiB."
+      }
+      property {
+        name: "max-concurrent-queries" type_number { }
+        description: "Specifies how much concurrent queries are allowed."
+      }
+      property {
+        name: "max-udp-packet-size" go_name: "MaxUDPPacketSize" type_number { }
+        description: "Maximum size of allowed UDP packet."
+      }
+      property {
+        name: "servers" type_string_list { }
+        description: "List of DNS server IPv4/IPv6 addresses."
+      }
+      property {
+        name: "use-doh-server" go_name: "UseDoHServer" type_string { }
+        description: "DNS over HTTPS (DoH) server URL."
+      }
+      property {
+        name: "verify-doh-cert" go_name: "VerifyDoHCert" type_boolean { }
+        description: "Specifies whether to validate the DoH server, when one is being used. Will use the /certificate list in order to verify server validity."
+      }
+      property {
+        name: "cache-used" read_only: true type_number { }
+        description: "Shows the currently used cache size, in KiB."
+      }
+      property {
+        name: "dynamic-servers" read_only: true type_string_list { }
+        description: "List of dynamically added DNS server from different services, for example, DHCP."
+      }
+    }
+  }
+  sub {
+    # https://help.mikrotik.com/docs/display/ROS/IP+Addressing
+    # /ip address
+    name: "address"
+    record {
+      key: "address"
+      key: "interface"
+      description: "IPv4 addresses assigned to interfaces. The same address can be assigned to multiple interfaces."
+      property {
+        name: "address" type_ip_prefix { }
+        description: "IP address with prefix length, eg. 10.0.0.1/24."
+      }
+      property {
+        name: "network" type_ip { }
+        description: "IP address of the network. If not set, it is calculated from the address and its prefix length."
+      }
+      property {
+        name: "interface" type_string { }
+        description: "Name of the interface the IP address is assigned to."
+      }
+      property {
+        name: "comment" type_string { }
+        description: "Short description of the address."
+      }
+      property {
+        name: "disabled" type_boolean { }
+        description: "Whether the address is disabled."
+      }
+      property {
+        name: "actual-interface" read_only: true type_string { }
+        description: "Name of the interface the address is actually bound to, eg. the bridge when interface is one of its ports."
+      }
+      property {
+        name: "dynamic" read_only: true type_boolean { }
+        description: "Whether the address was added dynamically, eg. by a DHCP client."
+      }
+      property {
+        name: "invalid" read_only: true type_boolean { }
+        description: "Whether the address is invalid, eg. because its interface does not exist."
+      }
+    }
+  }
+  sub {
+    # https://help.mikrotik.com/docs/display/ROS/IP+Routing
+    # /ip route
+    name: "route"
+    record {
+      key: "dst-address"
+      key: "gateway"
+      key: "routing-table"
+      description: "IPv4 routes, both static and added dynamically by routing protocols."
+      property {
+        name: "dst-address" type_ip_prefix { }
+        description: "Destination prefix of the route, eg. 10.0.0.0/8."
+      }
+      property {
+        name: "gateway" type_gateway { }
+        description: "Gateways of the route, each an IP address, an interface, or an IP address reachable through an interface, eg. 10.0.0.1. ECMP routes have more than one. Empty for routes without a gateway, eg. blackholes."
+      }
+      property {
+        name: "distance" type_number { }
+        description: "Administrative distance of the route, used to pick between routes to the same destination. Lower is preferred."
+      }
+      property {
+        name: "scope" type_number { }
+        description: "Scope of the route, used when resolving recursive gateways of other routes."
+      }
+      property {
+        name: "target-scope" type_number { }
+        description: "Maximum scope of the routes through which the gateway of this route can be resolved."
+      }
+      property {
+        name: "routing-table" type_string { }
+        description: "Routing table the route belongs to, eg. main."
+      }
+      property {
+        name: "vrf-interface" go_name: "VRFInterface" type_string { }
+        description: "VRF interface through which the gateway is resolved, for routes leaking between VRFs."
+      }
+      property {
+        name: "blackhole" type_boolean { }
+        description: "Whether packets matching the route are silently dropped."
+      }
+      property {
+        name: "check-gateway" type_enum {
+          variant { value: "none" description: "Gateway reachability is not checked." }
+          variant { value: "arp" description: "Gateway reachability is checked with ARP requests." }
+          variant { value: "ping" description: "Gateway reachability is checked with ICMP echo requests." }
+          variant { value: "bfd" description: "Gateway reachability is checked with BFD." }
+          variant { value: "bfd-multihop" description: "Gateway reachability is checked with multihop BFD." }
+        }
+        description: "How to check that the gateway is reachable. The route is made inactive while it isn't."
+      }
+      property {
+        name: "pref-src" type_ip { }
+        description: "Source address preferred for packets originating from the router and sent through the route."
+      }
+      property {
+        name: "comment" type_string { }
+        description: "Short description of the route."
+      }
+      property {
+        name: "disabled" type_boolean { }
+        description: "Whether the route is disabled."
+      }
+      property {
+        name: "active" read_only: true type_boolean { }
+        description: "Whether the route is active, ie. used for forwarding."
+      }
+      property {
+        name: "dynamic" read_only: true type_boolean { }
+        description: "Whether the route was added dynamically, eg. by a routing protocol or for a connected network."
+      }
+      property {
+        name: "ecmp" go_name: "ECMP" read_only: true type_boolean { }
+        description: "Whether the route is one of several equal cost routes to the same destination."
+      }
+      property {
+        name: "immediate-gw" go_name: "ImmediateGW" read_only: true type_gateway { }
+        description: "Gateways the route is resolved to, ie. the directly connected next hops and their interfaces. Empty if unresolved."
+      }
+    }
+  }
+  sub {
+    # https://help.mikrotik.com/docs/display/ROS/IP+Pools
+    # /ip pool
+    name: "pool"
+    record {
+      key: "name"
+      description: "IP address pools, from which addresses are handed out by eg. DHCP servers."
+      property {
+        name: "name" type_string { }
+        description: "Name of the pool."
+      }
+      property {
+        name: "ranges" type_ip_range_list { }
+        description: "Address ranges and single addresses in the pool, eg. 10.0.0.10-10.0.0.200."
+      }
+      property {
+        name: "next-pool" type_string { }
+        description: "Pool to take addresses from once this one is exhausted."
+      }
+      property {
+        name: "comment" type_string { }
+        description: "Short description of the pool."
+      }
+    }
+  }
+  sub {
+    # https://help.mikrotik.com/docs/display/ROS/DHCP#DHCP-DHCPServer
+    # /ip dhcp-server
+    name: "dhcp-server"
+    record {
+      key: "name"
+      description: "DHCP servers, each handing out addresses on an interface."
+      property {
+        name: "name" type_string { }
+        description: "Name of the server."
+      }
+      property {
+        name: "interface" type_string { }
+        description: "Interface the server listens on."
+      }
+      property {
+        name: "address-pool" type_string { }
+        description: "Pool dynamic leases are taken from, or static-only to only hand out static leases."
+      }
+      property {
+        name: "lease-time" type_duration { }
+        description: "Time for which leases are granted, unless overridden by a static lease."
+      }
+      property {
+        name: "authoritative" type_enum {
+          variant { value: "yes" description: "Requests for unknown leases are refused immediately." }
+          variant { value: "no" description: "Requests for unknown leases are ignored." }
+          variant { value: "after-2sec-delay" description: "Requests for unknown leases are refused if the client keeps asking for 2 seconds." }
+          variant { value: "after-10sec-delay" description: "Requests for unknown leases are refused if the client keeps asking for 10 seconds." }
+        }
+        description: "How the server responds to requests for leases it doesn't know about, eg. after a server change."
+      }
+      property {
+        name: "add-arp" go_name: "AddARP" type_boolean { }
+        description: "Whether to add static ARP entries for leases."
+      }
+      property {
+        name: "always-broadcast" type_boolean { }
+        description: "Whether to always broadcast replies, even to clients which accept unicast."
+      }
+      property {
+        name: "conflict-detection" type_boolean { }
+        description: "Whether to check that an address is unused before offering it."
+      }
+      property {
+        name: "relay" type_ip { }
+        description: "Address of the DHCP relay the server serves requests from, or 0.0.0.0 for direct requests."
+      }
+      property {
+        name: "lease-script" type_string { }
+        description: "Script run when a lease is granted or released."
+      }
+      property {
+        name: "comment" type_string { }
+        description: "Short description of the server."
+      }
+      property {
+        name: "disabled" type_boolean { }
+        description: "Whether the server is disabled."
+      }
+      property {
+        name: "dynamic" read_only: true type_boolean { }
+        description: "Whether the server was added dynamically."
+      }
+      property {
+        name: "invalid" read_only: true type_boolean { }
+        description: "Whether the server is invalid, eg. because its interface doesn't exist."
+      }
+    }
+    sub {
+      # https://help.mikrotik.com/docs/display/ROS/DHCP#DHCP-Networks
+      # /ip dhcp-server network
+      name: "network"
+      record {
+        key: "address"
+        description: "Networks served by DHCP servers, with the settings handed out to their clients."
+        property {
+          name: "address" type_ip_prefix { }
+          description: "The network, eg. 10.0.0.0/24. Clients with addresses in it get the settings below."
+        }
+        property {
+          name: "gateway" type_string_list { }
+          description: "Default gateways handed out to clients."
+        }
+        property {
+          name: "netmask" type_number { }
+          description: "Prefix length handed out to clients, or 0 to use the one of address."
+        }
+        property {
+          name: "dns-server" go_name: "DNSServer" type_string_list { }
+          description: "DNS servers handed out to clients."
+        }
+        property {
+          name: "ntp-server" go_name: "NTPServer" type_string_list { }
+          description: "NTP servers handed out to clients."
+        }
+        property {
+          name: "domain" type_string { }
+          description: "DNS domain handed out to clients."
+        }
+        property {
+          name: "dhcp-option" go_name: "DHCPOption" type_string_list { }
+          description: "Names of additional options, from ip/dhcp-server/option, handed out to clients."
+        }
+        property {
+          name: "comment" type_string { }
+          description: "Short description of the network."
+        }
+        property {
+          name: "dynamic" read_only: true type_boolean { }
+          description: "Whether the network was added dynamically."
+        }
+      }
+    }
+    sub {
+      # https://help.mikrotik.com/docs/display/ROS/DHCP#DHCP-Leases
+      # /ip dhcp-server lease
+      name: "lease"
+      record {
+        key: "server"
+        key: "mac-address"
+        description: "DHCP leases: static leases configured by the user, and dynamic leases handed out by servers."
+        property {
+          name: "address" type_ip { }
+          description: "Address of the lease."
+        }
+        property {
+          name: "mac-address" go_name: "MACAddress" type_mac { }
+          description: "MAC address of the client the lease is for."
+        }
+        property {
+          name: "client-id" go_name: "ClientID" type_string { }
+          description: "DHCP client identifier of the client the lease is for. If set, it's matched instead of mac-address."
+        }
+        property {
+          name: "server" type_string { }
+          description: "Name of the server the lease belongs to, or all."
+        }
+        property {
+          name: "lease-time" type_duration { }
+          description: "Time for which the lease is granted, or 0s to use the lease time of the server."
+        }
+        property {
+          name: "address-lists" type_string_list { }
+          description: "Firewall address lists the address is added to while the lease is bound."
+        }
+        property {
+          name: "always-broadcast" type_boolean { }
+          description: "Whether to always broadcast replies to the client."
+        }
+        property {
+          name: "block-access" type_boolean { }
+          description: "Whether to refuse requests of the client."
+        }
+        property {
+          name: "use-src-mac" go_name: "UseSrcMAC" type_boolean { }
+          description: "Whether to use the source MAC address of requests instead of the one within the DHCP request."
+        }
+        property {
+          name: "comment" type_string { }
+          description: "Short description of the lease."
+        }
+        property {
+          name: "disabled" type_boolean { }
+          description: "Whether the lease is disabled."
+        }
+        property {
+          name: "status" read_only: true type_enum {
+            variant { value: "waiting" description: "The lease is not in use." }
+            variant { value: "testing" description: "The address is being checked for conflicts." }
+            variant { value: "authorizing" description: "The client is being authorized with RADIUS." }
+            variant { value: "busy" description: "The address is used by another host." }
+            variant { value: "offered" description: "The address was offered to the client." }
+            variant { value: "bound" description: "The address is in use by the client." }
+          }
+          description: "State of the lease."
+        }
+        property {
+          name: "active-address" read_only: true type_ip { }
+          description: "Address the client is actually using, while bound."
+        }
+        property {
+          name: "active-mac-address" go_name: "ActiveMACAddress" read_only: true type_mac { }
+          description: "MAC address of the client actually using the lease, while bound."
+        }
+        property {
+          name: "active-client-id" go_name: "ActiveClientID" read_only: true type_string { }
+          description: "DHCP client identifier of the client actually using the lease, while bound."
+        }
+        property {
+          name: "active-server" read_only: true type_string { }
+          description: "Server which granted the lease, while bound."
+        }
+        property {
+          name: "host-name" read_only: true type_string { }
+          description: "Host name sent by the client."
+        }
+        property {
+          name: "expires-after" read_only: true type_duration { }
+          description: "Time until the lease expires, while bound."
+        }
+        property {
+          name: "last-seen" read_only: true type_string { }
+          description: "Time since the client was last seen, eg. 5m, or never."
+        }
+        property {
+          name: "blocked" read_only: true type_boolean { }
+          description: "Whether the lease is blocked, eg. by block-access."
+        }
+        property {
+          name: "dynamic" read_only: true type_boolean { }
+          description: "Whether the lease was handed out dynamically. Dynamic leases can be made static with make-static."
+        }
+      }
+      command {
+        # https://help.mikrotik.com/docs/display/ROS/DHCP#DHCP-Leases
+        # /ip dhcp-server lease make-static
+        name: "make-static"
+        description: "Converts dynamic leases into static ones, keeping their address."
+        argument {
+          name: "numbers" type_string_list { }
+          description: "IDs of the leases to convert."
+        }
+      }
+    }
+    sub {
+      # https://help.mikrotik.com/docs/display/ROS/DHCP#DHCP-DHCPOptions
+      # /ip dhcp-server option
+      name: "option"
+      record {
+        key: "name"
+        description: "Custom DHCP options, which can be handed out by networks."
+        property {
+          name: "name" type_string { }
+          description: "Name of the option, as referred to by networks."
+        }
+        property {
+          name: "code" type_number { }
+          description: "DHCP option code, eg. 66 for the TFTP server name."
+        }
+        property {
+          name: "value" type_string { }
+          description: "Value of the option, as a RouterOS DHCP option expression, eg. 0x0a000001."
+        }
+        property {
+          name: "force" type_boolean { }
+          description: "Whether to send the option even if the client didn't request it."
+        }
+        property {
+          name: "comment" type_string { }
+          description: "Short description of the option."
+        }
+        property {
+          name: "raw-value" read_only: true type_string { }
+          description: "Value of the option as sent, in hexadecimal."
+        }
+      }
+    }
+  }
+  sub {
+    name: "firewall"
+    sub {
+      # https://help.mikrotik.com/docs/display/ROS/Filter
+      # /ip firewall filter
+      name: "filter"
+      record {
+        ordered: true
+        description: "IPv4 firewall filter rules, which decide whether packets are accepted or dropped. Rules are evaluated in order."
+        include: "firewall-rule"
+        include: "firewall-conntrack"
+        property {
+          name: "chain" type_enum {
+            variant { value: "input" description: "Packets addressed to the router." }
+            variant { value: "forward" description: "Packets routed through the router." }
+            variant { value: "output" description: "Packets originating from the router." }
+            open: true
+          }
+          description: "Chain the rule belongs to: one of the built-in chains, or a user-defined one which is jumped to."
+        }
+        property {
+          name: "action" type_enum {
+            variant { value: "accept" description: "Stop processing the table and accept the packet." }
+            variant { value: "add-dst-to-address-list" description: "Add the destination address to the address list given by address-list." }
+            variant { value: "add-src-to-address-list" description: "Add the source address to the address list given by address-list." }
+            variant { value: "drop" description: "Silently drop the packet." }
+            variant { value: "fasttrack-connection" description: "Bypass the firewall for further packets of the connection." }
+            variant { value: "jump" description: "Continue in the chain given by jump-target." }
+            variant { value: "log" description: "Log the packet and continue with the next rule." }
+            variant { value: "passthrough" description: "Only count the packet and continue with the next rule." }
+            variant { value: "reject" description: "Drop the packet and reply with an ICMP or TCP reset packet, as given by reject-with." }
+            variant { value: "return" description: "Return to the rule after the jump which led to this chain." }
+            variant { value: "tarpit" description: "Capture and hold TCP connections, replying to SYN packets with SYN/ACK." }
+          }
+          description: "Action taken on matched packets."
+        }
+        property {
+          name: "reject-with" type_string { }
+          description: "Reply sent for the reject action, eg. icmp-network-unreachable or tcp-reset."
+        }
+      }
+    }
+    sub {
+      # https://help.mikrotik.com/docs/display/ROS/NAT
+      # /ip firewall nat
+      name: "nat"
+      record {
+        ordered: true
+        description: "IPv4 firewall NAT rules, which rewrite the addresses and ports of connections. Rules are evaluated in order."
+        include: "firewall-rule"
+        include: "firewall-conntrack"
+        property {
+          name: "chain" type_enum {
+            variant { value: "srcnat" description: "Packets leaving the router, after routing." }
+            variant { value: "dstnat" description: "Packets entering the router, before routing." }
+            open: true
+          }
+          description: "Chain the rule belongs to: one of the built-in chains, or a user-defined one which is jumped to."
+        }
+        property {
+          name: "action" type_enum {
+            variant { value: "accept" description: "Stop processing the table and accept the packet." }
+            variant { value: "add-dst-to-address-list" description: "Add the destination address to the address list given by address-list." }
+            variant { value: "add-src-to-address-list" description: "Add the source address to the address list given by address-list." }
+            variant { value: "dst-nat" description: "Replace the destination address and port with to-addresses and to-ports." }
+            variant { value: "endpoint-independent-nat" description: "Source NAT UDP connections such that any host can reach the internal host through the mapped port." }
+            variant { value: "jump" description: "Continue in the chain given by jump-target." }
+            variant { value: "log" description: "Log the packet and continue with the next rule." }
+            variant { value: "masquerade" description: "Replace the source address with the address of the output interface." }
+            variant { value: "netmap" description: "Map a whole address range to another one of the same size." }
+            variant { value: "passthrough" description: "Only count the packet and continue with the next rule." }
+            variant { value: "redirect" description: "Replace the destination address with the router's own, and the destination port with to-ports." }
+            variant { value: "return" description: "Return to the rule after the jump which led to this chain." }
+            variant { value: "same" description: "Map the connections of each client to the same address of to-addresses." }
+            variant { value: "src-nat" description: "Replace the source address and port with to-addresses and to-ports." }
+          }
+          description: "Action taken on matched packets."
+        }
+        property {
+          name: "to-addresses" type_string { }
+          description: "Address or address range to translate to, for NAT actions."
+        }
+        property {
+          name: "to-ports" type_port_list { }
+          description: "Port or port range to translate to, for NAT actions."
+        }
+      }
+    }
+    sub {
+      # https://help.mikrotik.com/docs/display/ROS/Mangle
+      # /ip firewall mangle
+      name: "mangle"
+      record {
+        ordered: true
+        description: "IPv4 firewall mangle rules, which mark packets, connections and routing decisions, and change header fields. Rules are evaluated in order."
+        include: "firewall-rule"
+        include: "firewall-conntrack"
+        property {
+          name: "chain" type_enum {
+            variant { value: "prerouting" description: "Packets entering the router, before routing." }
+            variant { value: "input" description: "Packets addressed to the router." }
+            variant { value: "forward" description: "Packets routed through the router." }
+            variant { value: "output" description: "Packets originating from the router." }
+            variant { value: "postrouting" description: "Packets leaving the router, after routing." }
+            open: true
+          }
+          description: "Chain the rule belongs to: one of the built-in chains, or a user-defined one which is jumped to."
+        }
+        property {
+          name: "action" type_enum {
+            variant { value: "accept" description: "Stop processing the table and accept the packet." }
+            variant { value: "add-dst-to-address-list" description: "Add the destination address to the address list given by address-list." }
+            variant { value: "add-src-to-address-list" description: "Add the source address to the address list given by address-list." }
+            variant { value: "change-dscp" description: "Change the DSCP field of the packet." }
+            variant { value: "change-mss" description: "Change the MSS option of TCP SYN packets." }
+            variant { value: "change-ttl" description: "Change the TTL field of the packet." }
+            variant { value: "clear-df" description: "Clear the don't fragment flag of the packet." }
+            variant { value: "fasttrack-connection" description: "Bypass the firewall for further packets of the connection." }
+            variant { value: "jump" description: "Continue in the chain given by jump-target." }
+            variant { value: "log" description: "Log the packet and continue with the next rule." }
+            variant { value: "mark-connection" description: "Set the connection mark to new-connection-mark." }
+            variant { value: "mark-packet" description: "Set the packet mark to new-packet-mark." }
+            variant { value: "mark-routing" description: "Set the routing mark to new-routing-mark." }
+            variant { value: "passthrough" description: "Only count the packet and continue with the next rule." }
+            variant { value: "return" description: "Return to the rule after the jump which led to this chain." }
+            variant { value: "route" description: "Route the packet through a given gateway." }
+            variant { value: "set-priority" description: "Set the priority of the packet, eg. for queues." }
+            variant { value: "sniff-pc" description: "Send a copy of the packet to a PacketCable receiver." }
+            variant { value: "sniff-tzsp" description: "Send a copy of the packet to a TZSP receiver." }
+            variant { value: "strip-ipv4-options" description: "Remove the IPv4 options of the packet." }
+          }
+          description: "Action taken on matched packets."
+        }
+        property {
+          name: "new-connection-mark" type_string { }
+          description: "Connection mark to set, for the mark-connection action."
+        }
+        property {
+          name: "new-packet-mark" type_string { }
+          description: "Packet mark to set, for the mark-packet action."
+        }
+        property {
+          name: "new-routing-mark" type_string { }
+          description: "Routing mark to set, for the mark-routing action."
+        }
+        property {
+          name: "passthrough" type_boolean { }
+          description: "Whether to continue with the next rule after a marking action."
+        }
+      }
+    }
+    sub {
+      # https://help.mikrotik.com/docs/display/ROS/Raw
+      # /ip firewall raw
+      name: "raw"
+      record {
+        ordered: true
+        description: "IPv4 firewall raw rules, which process packets before connection tracking. Rules are evaluated in order."
+        include: "firewall-rule"
+        property {
+          name: "chain" type_enum {
+            variant { value: "prerouting" description: "Packets entering the router, before routing." }
+            variant { value: "output" description: "Packets originating from the router." }
+            open: true
+          }
+          description: "Chain the rule belongs to: one of the built-in chains, or a user-defined one which is jumped to."
+        }
+        property {
+          name: "action" type_enum {
+            variant { value: "accept" description: "Stop processing the table and accept the packet." }
+            variant { value: "add-dst-to-address-list" description: "Add the destination address to the address list given by address-list." }
+            variant { value: "add-src-to-address-list" description: "Add the source address to the address list given by address-list." }
+            variant { value: "drop" description: "Silently drop the packet." }
+            variant { value: "jump" description: "Continue in the chain given by jump-target." }
+            variant { value: "log" description: "Log the packet and continue with the next rule." }
+            variant { value: "notrack" description: "Exclude the packet from connection tracking." }
+            variant { value: "passthrough" description: "Only count the packet and continue with the next rule." }
+            variant { value: "return" description: "Return to the rule after the jump which led to this chain." }
+          }
+          description: "Action taken on matched packets."
+        }
+      }
+    }
+    sub {
+      # https://help.mikrotik.com/docs/display/ROS/Address-lists
+      # /ip firewall address-list
+      name: "address-list"
+      record {
+        key: "list"
+        key: "address"
+        description: "IPv4 firewall address lists, named sets of addresses which can be matched by firewall rules."
+        property {
+          name: "list" type_string { }
+          description: "Name of the address list."
+        }
+        property {
+          name: "address" type_string { }
+          description: "Address, prefix, range or DNS name on the list, eg. 10.0.0.0/8."
+        }
+        property {
+          name: "timeout" type_duration { }
+          description: "How long the address is kept on the list. Addresses without a timeout are kept forever."
+        }
+        property {
+          name: "comment" type_string { }
+          description: "Short description of the entry."
+        }
+        property {
+          name: "disabled" type_boolean { }
+          description: "Whether the entry is disabled."
+        }
+        property {
+          name: "creation-time" read_only: true type_string { }
+          description: "When the entry was added."
+        }
+        property {
+          name: "dynamic" read_only: true type_boolean { }
+          description: "Whether the entry was added dynamically, eg. by a firewall rule or with a timeout."
+        }
       }
     }
   }
 }
+sub {
+  name: "ipv6"
+  sub {
+    # https://help.mikrotik.com/docs/display/ROS/IPv6+Addressing
+    # /ipv6 address
+    name: "address"
+    record {
+      key: "address"
+      key: "interface"
+      description: "IPv6 addresses assigned to interfaces. Link-local addresses are added dynamically to every interface."
+      property {
+        name: "address" type_ip_prefix { }
+        description: "IPv6 address with prefix length, eg. 2001:db8::1/64. With eui-64 or from-pool, only the prefix part is used."
+      }
+      property {
+        name: "interface" type_string { }
+        description: "Name of the interface the IPv6 address is assigned to."
+      }
+      property {
+        name: "advertise" type_boolean { }
+        description: "Whether to advertise the prefix of the address in router advertisements, for stateless address autoconfiguration of hosts on the interface."
+      }
+      property {
+        name: "eui-64" go_name: "EUI64" type_boolean { }
+        description: "Whether to generate the host part of the address from the interface MAC address, as per EUI-64."
+      }
+      property {
+        name: "from-pool" type_string { }
+        description: "Name of the IPv6 pool the prefix of the address is taken from."
+      }
+      property {
+        name: "no-dad" go_name: "NoDAD" type_boolean { }
+        description: "Whether to skip duplicate address detection for this address."
+      }
+      property {
+        name: "comment" type_string { }
+        description: "Short description of the address."
+      }
+      property {
+        name: "disabled" type_boolean { }
+        description: "Whether the address is disabled."
+      }
+      property {
+        name: "actual-interface" read_only: true type_string { }
+        description: "Name of the interface the address is actually bound to, eg. the bridge when interface is one of its ports."
+      }
+      property {
+        name: "dynamic" read_only: true type_boolean { }
+        description: "Whether the address was added dynamically, eg. a link-local address or one obtained by a DHCPv6 client."
+      }
+      property {
+        name: "invalid" read_only: true type_boolean { }
+        description: "Whether the address is invalid, eg. because its interface does not exist."
+      }
+      property {
+        name: "link-local" read_only: true type_boolean { }
+        description: "Whether the address is a link-local address (fe80::/10)."
+      }
+    }
+  }
+  sub {
+    # https://help.mikrotik.com/docs/display/ROS/IPv6+Routing
+    # /ipv6 route
+    name: "route"
+    record {
+      key: "dst-address"
+      key: "gateway"
+      key: "routing-table"
+      description: "IPv6 routes, both static and added dynamically by routing protocols."
+      property {
+        name: "dst-address" type_ip_prefix { }
+        description: "Destination prefix of the route, eg. 2001:db8::/32."
+      }
+      property {
+        name: "gateway" type_gateway { }
+        description: "Gateways of the route, each an IP address, an interface, or an IP address reachable through an interface, eg. fe80::1%ether1. ECMP routes have more than one. Empty for routes without a gateway, eg. blackholes."
+      }
+      property {
+        name: "distance" type_number { }
+        description: "Administrative distance of the route, used to pick between routes to the same destination. Lower is preferred."
+      }
+      property {
+        name: "scope" type_number { }
+        description: "Scope of the route, used when resolving recursive gateways of other routes."
+      }
+      property {
+        name: "target-scope" type_number { }
+        description: "Maximum scope of the routes through which the gateway of this route can be resolved."
+      }
+      property {
+        name: "routing-table" type_string { }
+        description: "Routing table the route belongs to, eg. main."
+      }
+      property {
+        name: "vrf-interface" go_name: "VRFInterface" type_string { }
+        description: "VRF interface through which the gateway is resolved, for routes leaking between VRFs."
+      }
+      property {
+        name: "blackhole" type_boolean { }
+        description: "Whether packets matching the route are silently dropped."
+      }
+      property {
+        name: "check-gateway" type_enum {
+          variant { value: "none" description: "Gateway reachability is not checked." }
+          variant { value: "arp" description: "Gateway reachability is checked with ARP requests." }
+          variant { value: "ping" description: "Gateway reachability is checked with ICMP echo requests." }
+          variant { value: "bfd" description: "Gateway reachability is checked with BFD." }
+          variant { value: "bfd-multihop" description: "Gateway reachability is checked with multihop BFD." }
+        }
+        description: "How to check that the gateway is reachable. The route is made inactive while it isn't."
+      }
+      property {
+        name: "comment" type_string { }
+        description: "Short description of the route."
+      }
+      property {
+        name: "disabled" type_boolean { }
+        description: "Whether the route is disabled."
+      }
+      property {
+        name: "active" read_only: true type_boolean { }
+        description: "Whether the route is active, ie. used for forwarding."
+      }
+      property {
+        name: "dynamic" read_only: true type_boolean { }
+        description: "Whether the route was added dynamically, eg. by a routing protocol or for a connected network."
+      }
+      property {
+        name: "ecmp" go_name: "ECMP" read_only: true type_boolean { }
+        description: "Whether the route is one of several equal cost routes to the same destination."
+      }
+      property {
+        name: "immediate-gw" go_name: "ImmediateGW" read_only: true type_gateway { }
+        description: "Gateways the route is resolved to, ie. the directly connected next hops and their interfaces. Empty if unresolved."
+      }
+    }
+  }
+  sub {
+    name: "firewall"
+    sub {
+      # https://help.mikrotik.com/docs/display/ROS/Filter
+      # /ipv6 firewall filter
+      name: "filter"
+      record {
+        ordered: true
+        description: "IPv6 firewall filter rules, which decide whether packets are accepted or dropped. Rules are evaluated in order."
+        include: "firewall-rule"
+        include: "firewall-conntrack"
+        property {
+          name: "chain" type_enum {
+            variant { value: "input" description: "Packets addressed to the router." }
+            variant { value: "forward" description: "Packets routed through the router." }
+            variant { value: "output" description: "Packets originating from the router." }
+            open: true
+          }
+          description: "Chain the rule belongs to: one of the built-in chains, or a user-defined one which is jumped to."
+        }
+        property {
+          name: "action" type_enum {
+            variant { value: "accept" description: "Stop processing the table and accept the packet." }
+            variant { value: "add-dst-to-address-list" description: "Add the destination address to the address list given by address-list." }
+            variant { value: "add-src-to-address-list" description: "Add the source address to the address list given by address-list." }
+            variant { value: "drop" description: "Silently drop the packet." }
+            variant { value: "fasttrack-connection" description: "Bypass the firewall for further packets of the connection." }
+            variant { value: "jump" description: "Continue in the chain given by jump-target." }
+            variant { value: "log" description: "Log the packet and continue with the next rule." }
+            variant { value: "passthrough" description: "Only count the packet and continue with the next rule." }
+            variant { value: "reject" description: "Drop the packet and reply with an ICMP or TCP reset packet, as given by reject-with." }
+            variant { value: "return" description: "Return to the rule after the jump which led to this chain." }
+          }
+          description: "Action taken on matched packets."
+        }
+        property {
+          name: "reject-with" type_string { }
+          description: "Reply sent for the reject action, eg. icmp-network-unreachable or tcp-reset."
+        }
+      }
+    }
+    sub {
+      # https://help.mikrotik.com/docs/display/ROS/NAT
+      # /ipv6 firewall nat
+      name: "nat"
+      record {
+        ordered: true
+        description: "IPv6 firewall NAT rules, which rewrite the addresses and ports of connections. Rules are evaluated in order."
+        include: "firewall-rule"
+        include: "firewall-conntrack"
+        property {
+          name: "chain" type_enum {
+            variant { value: "srcnat" description: "Packets leaving the router, after routing." }
+            variant { value: "dstnat" description: "Packets entering the router, before routing." }
+            open: true
+          }
+          description: "Chain the rule belongs to: one of the built-in chains, or a user-defined one which is jumped to."
+        }
+        property {
+          name: "action" type_enum {
+            variant { value: "accept" description: "Stop processing the table and accept the packet." }
+            variant { value: "add-dst-to-address-list" description: "Add the destination address to the address list given by address-list." }
+            variant { value: "add-src-to-address-list" description: "Add the source address to the address list given by address-list." }
+            variant { value: "dst-nat" description: "Replace the destination address and port with to-addresses and to-ports." }
+            variant { value: "jump" description: "Continue in the chain given by jump-target." }
+            variant { value: "log" description: "Log the packet and continue with the next rule." }
+            variant { value: "masquerade" description: "Replace the source address with the address of the output interface." }
+            variant { value: "netmap" description: "Map a whole address range to another one of the same size." }
+            variant { value: "passthrough" description: "Only count the packet and continue with the next rule." }
+            variant { value: "redirect" description: "Replace the destination address with the router's own, and the destination port with to-ports." }
+            variant { value: "return" description: "Return to the rule after the jump which led to this chain." }
+            variant { value: "src-nat" description: "Replace the source address and port with to-addresses and to-ports." }
+          }
+          description: "Action taken on matched packets."
+        }
+        property {
+          name: "to-addresses" type_string { }
+          description: "Address or address range to translate to, for NAT actions."
+        }
+        property {
+          name: "to-ports" type_port_list { }
+          description: "Port or port range to translate to, for NAT actions."
+        }
+      }
+    }
+    sub {
+      # https://help.mikrotik.com/docs/display/ROS/Mangle
+      # /ipv6 firewall mangle
+      name: "mangle"
+      record {
+        ordered: true
+        description: "IPv6 firewall mangle rules, which mark packets, connections and routing decisions, and change header fields. Rules are evaluated in order."
+        include: "firewall-rule"
+        include: "firewall-conntrack"
+        property {
+          name: "chain" type_enum {
+            variant { value: "prerouting" description: "Packets entering the router, before routing." }
+            variant { value: "input" description: "Packets addressed to the router." }
+            variant { value: "forward" description: "Packets routed through the router." }
+            variant { value: "output" description: "Packets originating from the router." }
+            variant { value: "postrouting" description: "Packets leaving the router, after routing." }
+            open: true
+          }
+          description: "Chain the rule belongs to: one of the built-in chains, or a user-defined one which is jumped to."
+        }
+        property {
+          name: "action" type_enum {
+            variant { value: "accept" description: "Stop processing the table and accept the packet." }
+            variant { value: "add-dst-to-address-list" description: "Add the destination address to the address list given by address-list." }
+            variant { value: "add-src-to-address-list" description: "Add the source address to the address list given by address-list." }
+            variant { value: "change-dscp" description: "Change the DSCP field of the packet." }
+            variant { value: "change-hop-limit" description: "Change the hop limit field of the packet." }
+            variant { value: "change-mss" description: "Change the MSS option of TCP SYN packets." }
+            variant { value: "jump" description: "Continue in the chain given by jump-target." }
+            variant { value: "log" description: "Log the packet and continue with the next rule." }
+            variant { value: "mark-connection" description: "Set the connection mark to new-connection-mark." }
+            variant { value: "mark-packet" description: "Set the packet mark to new-packet-mark." }
+            variant { value: "mark-routing" description: "Set the routing mark to new-routing-mark." }
+            variant { value: "passthrough" description: "Only count the packet and continue with the next rule." }
+            variant { value: "return" description: "Return to the rule after the jump which led to this chain." }
+            variant { value: "set-priority" description: "Set the priority of the packet, eg. for queues." }
+            variant { value: "sniff-pc" description: "Send a copy of the packet to a PacketCable receiver." }
+            variant { value: "sniff-tzsp" description: "Send a copy of the packet to a TZSP receiver." }
+          }
+          description: "Action taken on matched packets."
+        }
+        property {
+          name: "new-connection-mark" type_string { }
+          description: "Connection mark to set, for the mark-connection action."
+        }
+        property {
+          name: "new-packet-mark" type_string { }
+          description: "Packet mark to set, for the mark-packet action."
+        }
+        property {
+          name: "new-routing-mark" type_string { }
+          description: "Routing mark to set, for the mark-routing action."
+        }
+        property {
+          name: "passthrough" type_boolean { }
+          description: "Whether to continue with the next rule after a marking action."
+        }
+      }
+    }
+    sub {
+      # https://help.mikrotik.com/docs/display/ROS/Raw
+      # /ipv6 firewall raw
+      name: "raw"
+      record {
+        ordered: true
+        description: "IPv6 firewall raw rules, which process packets before connection tracking. Rules are evaluated in order."
+        include: "firewall-rule"
+        property {
+          name: "chain" type_enum {
+            variant { value: "prerouting" description: "Packets entering the router, before routing." }
+            variant { value: "output" description: "Packets originating from the router." }
+            open: true
+          }
+          description: "Chain the rule belongs to: one of the built-in chains, or a user-defined one which is jumped to."
+        }
+        property {
+          name: "action" type_enum {
+            variant { value: "accept" description: "Stop processing the table and accept the packet." }
+            variant { value: "add-dst-to-address-list" description: "Add the destination address to the address list given by address-list." }
+            variant { value: "add-src-to-address-list" description: "Add the source address to the address list given by address-list." }
+            variant { value: "drop" description: "Silently drop the packet." }
+            variant { value: "jump" description: "Continue in the chain given by jump-target." }
+            variant { value: "log" description: "Log the packet and continue with the next rule." }
+            variant { value: "notrack" description: "Exclude the packet from connection tracking." }
+            variant { value: "passthrough" description: "Only count the packet and continue with the next rule." }
+            variant { value: "return" description: "Return to the rule after the jump which led to this chain." }
+          }
+          description: "Action taken on matched packets."
+        }
+      }
+    }
+    sub {
+      # https://help.mikrotik.com/docs/display/ROS/Address-lists
+      # /ipv6 firewall address-list
+      name: "address-list"
+      record {
+        key: "list"
+        key: "address"
+        description: "IPv6 firewall address lists, named sets of addresses which can be matched by firewall rules."
+        property {
+          name: "list" type_string { }
+          description: "Name of the address list."
+        }
+        property {
+          name: "address" type_string { }
+          description: "Address, prefix, range or DNS name on the list, eg. 2001:db8::/32."
+        }
+        property {
+          name: "timeout" type_duration { }
+          description: "How long the address is kept on the list. Addresses without a timeout are kept forever."
+        }
+        property {
+          name: "comment" type_string { }
+          description: "Short description of the entry."
+        }
+        property {
+          name: "disabled" type_boolean { }
+          description: "Whether the entry is disabled."
+        }
+        property {
+          name: "creation-time" read_only: true type_string { }
+          description: "When the entry was added."
+        }
+        property {
+          name: "dynamic" read_only: true type_boolean { }
+          description: "Whether the entry was added dynamically, eg. by a firewall rule or with a timeout."
+        }
+      }
+    }
+  }
+}
+sub {
+  name: "queue"
+  sub {
+    # https://help.mikrotik.com/docs/display/ROS/Queues#Queues-QueueTypes
+    # /queue type
+    name: "type"
+    record {
+      key: "name"
+      description: "Queue types, referenced by simple and tree queues and by interface queues."
+      property {
+        name: "name" type_string { }
+        description: "Name of the queue type."
+      }
+      property {
+        name: "kind" type_enum {
+          variant { value: "bfifo" description: "First in, first out queue limited by size in bytes." }
+          variant { value: "pfifo" description: "First in, first out queue limited by number of packets." }
+          variant { value: "mq-pfifo" description: "pfifo with support for multiple transmit queues." }
+          variant { value: "red" description: "Random Early Detection." }
+          variant { value: "sfq" description: "Stochastic Fairness Queuing." }
+          variant { value: "pcq" description: "Per Connection Queuing, limiting each sub-stream to pcq-rate." }
+          variant { value: "codel" description: "Controlled Delay." }
+          variant { value: "fq-codel" description: "Fair Queuing Controlled Delay." }
+          variant { value: "cake" description: "Common Applications Kept Enhanced." }
+          variant { value: "none" description: "No queuing, only for interface queues of hardware with its own." }
+        }
+        description: "Queuing discipline of the queue type."
+      }
+      property {
+        name: "pcq-rate" go_name: "PCQRate" type_rate { }
+        description: "Maximum data rate of each sub-stream of a pcq queue, eg. 2M. 0 means no limit."
+      }
+      property {
+        name: "pcq-limit" go_name: "PCQLimit" type_number { }
+        description: "Queue size of a single sub-stream of a pcq queue, in KiB."
+      }
+      property {
+        name: "pcq-total-limit" go_name: "PCQTotalLimit" type_number { }
+        description: "Maximum amount of data queued in all sub-streams of a pcq queue, in KiB."
+      }
+      property {
+        name: "pcq-classifier" go_name: "PCQClassifier" type_string_list { }
+        description: "Properties identifying the sub-streams of a pcq queue, eg. src-address,dst-port."
+      }
+      property {
+        name: "default" read_only: true type_boolean { }
+        description: "Whether the queue type is built into ROS."
+      }
+    }
+  }
+  sub {
+    # https://help.mikrotik.com/docs/display/ROS/Queues#Queues-SimpleQueue
+    # /queue simple
+    name: "simple"
+    record {
+      key: "name"
+      ordered: true
+      description: "Simple queues, which limit the traffic of targets. Queues are evaluated in order, the first one matching a packet is used."
+      property {
+        name: "name" type_string { }
+        description: "Name of the queue."
+      }
+      property {
+        name: "target" type_ip_prefix { list: true }
+        description: "Addresses or networks whose traffic is limited, eg. 192.168.88.0/24,10.0.0.1/32."
+      }
+      property {
+        name: "parent" type_string { }
+        description: "Parent queue whose limits also apply, or none."
+      }
+      property {
+        name: "max-limit" type_rate { pair: true }
+        description: "Maximum upload/download data rate of the target, eg. 10M/20M. 0 means no limit."
+      }
+      property {
+        name: "limit-at" type_rate { pair: true }
+        description: "Upload/download data rate guaranteed to the target, eg. 1M/2M. 0 means none."
+      }
+      property {
+        name: "burst-limit" type_rate { pair: true }
+        description: "Maximum upload/download data rate of the target while bursting. 0 means no bursts."
+      }
+      property {
+        name: "burst-threshold" type_rate { pair: true }
+        description: "Average upload/download data rate below which the target may burst."
+      }
+      property {
+        name: "comment" type_string { }
+        description: "Short description of the queue."
+      }
+      property {
+        name: "disabled" type_boolean { }
+        description: "Whether the queue is disabled."
+      }
+    }
+  }
+}
+sub {
+  name: "routing"
+  sub {
+    # https://help.mikrotik.com/docs/display/ROS/Policy+Routing
+    # /routing table
+    name: "table"
+    record {
+      key: "name"
+      description: "Routing tables. The main table always exists, others need to be added before routes can be put in them."
+      property {
+        name: "name" type_string { }
+        description: "Name of the routing table."
+      }
+      property {
+        name: "fib" go_name: "FIB" type_boolean { }
+        description: "Whether the routing table is pushed to the FIB, ie. used for forwarding. Tables without it are only used by routing protocols."
+      }
+      property {
+        name: "comment" type_string { }
+        description: "Short description of the routing table."
+      }
+      property {
+        name: "disabled" type_boolean { }
+        description: "Whether the routing table is disabled."
+      }
+      property {
+        name: "dynamic" read_only: true type_boolean { }
+        description: "Whether the routing table was added dynamically, eg. main, or one for a VRF."
+      }
+      property {
+        name: "invalid" read_only: true type_boolean { }
+      }
+    }
+  }
+}
+command {
+  # https://help.mikrotik.com/docs/display/ROS/Ping
+  # /ping
+  name: "ping"
+  description: "Sends ICMP echo requests to a host and returns a row per sent request. The count argument must be set over REST, as ping otherwise runs until the request times out."
+  argument {
+    name: "address" type_string { }
+    description: "IP address or DNS name of the host to ping."
+  }
+  argument {
+    name: "count" type_number { }
+    description: "How many echo requests to send. Required over REST, as ping otherwise never finishes."
+  }
+  argument {
+    name: "interface" type_string { }
+    description: "Interface through which echo requests are sent."
+  }
+  argument {
+    name: "routing-table" type_string { }
+    description: "Routing table used to look up the route to the host."
+  }
+  argument {
+    name: "size" type_number { }
+    description: "Size of the sent IP packets in bytes."
+  }
+  argument {
+    name: "src-address" type_ip { }
+    description: "Source address of the sent packets."
+  }
+  reply {
+    name: "seq" type_number { }
+    description: "Sequence number of the echo request."
+  }
+  reply {
+    name: "host" type_string { }
+    description: "Host that responded, or the pinged host if there was no response."
+  }
+  reply {
+    name: "size" type_number { }
+  }
+  reply {
+    name: "ttl" go_name: "TTL" type_number { }
+  }
+  reply {
+    name: "time" type_duration { }
+    description: "Round trip time of this echo request, eg. 1ms."
+  }
+  reply {
+    name: "status" type_string { }
+    description: "Error status of this echo request, eg. timeout."
+  }
+  reply {
+    name: "sent" type_number { }
+    description: "Number of echo requests sent so far."
+  }
+  reply {
+    name: "received" type_number { }
+    description: "Number of echo replies received so far."
+  }
+  reply {
+    name: "packet-loss" type_number { }
+    description: "Percentage of echo requests without a reply so far."
+  }
+  reply {
+    name: "min-rtt" go_name: "MinRTT" type_duration { }
+  }
+  reply {
+    name: "avg-rtt" go_name: "AvgRTT" type_duration { }
+  }
+  reply {
+    name: "max-rtt" go_name: "MaxRTT" type_duration { }
+  }
+}
@@ -0,0 +1,45 @@
+package reconcile_test
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/q3k/ros7api/reconcile"
+	"github.com/q3k/ros7api/ros"
+	"github.com/q3k/ros7api/rostest"
+)
+
+func Example() {
+	ctx := context.Background()
+	s := rostest.NewServer()
+	defer s.Close()
+	s.Add("interface/bridge/vlan", rostest.Row{"bridge": "bridge1", "vlan-ids": "3005", "tagged": "ether1"})
+	s.Add("interface/bridge/vlan", rostest.Row{"bridge": "bridge1", "vlan-ids": "3006"})
+	c := s.Client()
+
+	// Make sure VLAN 3005 is tagged on ether1 and ether8, and that no other
+	// VLANs are configured.
+	plan, err := reconcile.Compute(ctx, c, "interface/bridge/vlan", []ros.InterfaceBridgeVlan_Update{
+		{
+			Bridge:  ros.StringPtr("bridge1"),
+			VlanIDs: ros.NumberPtr(3005),
+			Tagged:  ros.StringListPtr("ether1", "ether8"),
+		},
+	})
+	if err != nil {
+		log.Fatalf("Could not plan: %v", err)
+	}
+	fmt.Print(plan)
+
+	if err := plan.Apply(ctx, c); err != nil {
+		log.Fatalf("Could not apply: %v", err)
+	}
+
+	// Output:
+	// - interface/bridge/vlan bridge=bridge1,vlan-ids=3006 (*2)
+	//     bridge: "bridge1" -> ""
+	//     vlan-ids: "3006" -> ""
+	// ~ interface/bridge/vlan bridge=bridge1,vlan-ids=3005 (*1)
+	//     tagged: "ether1" -> "ether1,ether8"
+}
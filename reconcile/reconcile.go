@@ -0,0 +1,599 @@
+// Package reconcile implements declarative configuration of ROS menus. Given
+// the desired set of records for a menu, it computes a Plan of changes which
+// bring the live records in line with it, and can Apply that plan.
+//
+// Desired records are matched to live records by the natural key declared for
+// the menu in gen/types.text.pb (see ros.MenuSchema.Key). Live records which
+// don't match any desired record are removed, desired records which don't
+// match any live record are added, and matched records are patched if any of
+// their desired properties differ. Properties not set in a desired record are
+// not touched, and dynamic records (created by ROS itself) are ignored.
+//
+// In ordered menus (see ros.MenuSchema.Ordered), eg. firewall rules, the
+// desired records are also brought into the given order, using as few moves
+// as possible. These menus need not declare a key: records without one are
+// matched if all desired properties are equal.
+package reconcile
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/q3k/ros7api/ros"
+)
+
+// Op is the kind of a Change.
+type Op int
+
+const (
+	// OpRemove removes a live record which is not desired.
+	OpRemove Op = iota
+	// OpPatch updates some properties of a live record.
+	OpPatch
+	// OpAdd creates a desired record which is not live.
+	OpAdd
+	// OpMove moves a live record of an ordered menu.
+	OpMove
+)
+
+func (o Op) String() string {
+	switch o {
+	case OpRemove:
+		return "remove"
+	case OpPatch:
+		return "patch"
+	case OpAdd:
+		return "add"
+	case OpMove:
+		return "move"
+	}
+	return fmt.Sprintf("Op(%d)", int(o))
+}
+
+// FieldDiff is a difference in a single property of a record.
+type FieldDiff struct {
+	// Name is the ROS name of the property, eg. tagged.
+	Name string
+	// From is the live value of the property, or empty for added records.
+	From string
+	// To is the desired value of the property, or empty for removed
+	// records.
+	To string
+}
+
+// Change is a single operation on a record.
+type Change struct {
+	Op Op
+	// Key describes the natural key of the record, eg.
+	// bridge=bridge1,vlan-ids=3005.
+	Key string
+	// ID is the ID of the live record, for OpRemove, OpPatch and OpMove.
+	ID ros.RecordID
+	// Before is the ID of the live record before which the record is placed,
+	// for OpAdd and OpMove in ordered menus. If empty, the record is placed at
+	// the end.
+	Before ros.RecordID
+	// Row contains the properties to send to ROS, for OpAdd and OpPatch.
+	Row ros.Row
+	// Fields are the differing properties, sorted by name.
+	Fields []FieldDiff
+}
+
+// Plan is a list of changes to a menu. Changes are ordered by Op (removes,
+// then patches, then adds, so that removed records free up anything they
+// conflict with) and then by Key. In ordered menus, adds and moves are instead
+// interleaved in the order in which they must be applied.
+type Plan struct {
+	// Menu is the path of the menu, eg. interface/bridge/vlan.
+	Menu    string
+	Changes []Change
+}
+
+// Empty returns whether the plan contains no changes, ie. the menu is already
+// in the desired state.
+func (p *Plan) Empty() bool {
+	return len(p.Changes) == 0
+}
+
+// String returns a human-readable description of the plan, one line per
+// change followed by one line per changed field.
+func (p *Plan) String() string {
+	var sb strings.Builder
+	for _, c := range p.Changes {
+		switch c.Op {
+		case OpRemove:
+			fmt.Fprintf(&sb, "- %s %s (%s)\n", p.Menu, c.Key, c.ID)
+		case OpPatch:
+			fmt.Fprintf(&sb, "~ %s %s (%s)\n", p.Menu, c.Key, c.ID)
+		case OpAdd:
+			fmt.Fprintf(&sb, "+ %s %s%s\n", p.Menu, c.Key, before(c.Before))
+		case OpMove:
+			fmt.Fprintf(&sb, "> %s %s (%s)%s\n", p.Menu, c.Key, c.ID, before(c.Before))
+		}
+		for _, f := range c.Fields {
+			fmt.Fprintf(&sb, "    %s: %q -> %q\n", f.Name, f.From, f.To)
+		}
+	}
+	return sb.String()
+}
+
+// before describes the placement of an added or moved record.
+func before(id ros.RecordID) string {
+	if id == "" {
+		return ""
+	}
+	return fmt.Sprintf(" before %s", id)
+}
+
+// Compute lists the live records of a menu and returns the plan to bring them
+// to the desired state. Desired records can be given as a slice of the menu's
+// generated record or _Update type, or as a slice of ros.Row. Generated records
+// are converted with their ToUpdate method, so fields left unset in them (eg.
+// an empty port list) are not touched.
+func Compute(ctx context.Context, c *ros.Client, menu string, desired interface{}) (*Plan, error) {
+	schema := ros.LookupMenu(menu)
+	if schema == nil {
+		return nil, fmt.Errorf("unknown menu %q", menu)
+	}
+	rows, ok := desired.([]ros.Row)
+	if !ok {
+		var err error
+		rows, err = ros.RowsOf(updatesOf(desired))
+		if err != nil {
+			return nil, fmt.Errorf("invalid desired records: %w", err)
+		}
+	}
+	live, err := c.ListRows(ctx, menu)
+	if err != nil {
+		return nil, fmt.Errorf("could not list %s: %w", menu, err)
+	}
+	return Diff(schema, live, rows)
+}
+
+// updatesOf returns the _Update structs of a slice of generated records (or
+// pointers to them), or v as is if it isn't one.
+func updatesOf(v interface{}) interface{} {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Slice {
+		return v
+	}
+	typ := rv.Type().Elem()
+	addr := typ.Kind() != reflect.Ptr
+	if addr {
+		typ = reflect.PtrTo(typ)
+	}
+	method, ok := typ.MethodByName("ToUpdate")
+	if !ok {
+		return v
+	}
+	res := make([]interface{}, rv.Len())
+	for i := range res {
+		elem := rv.Index(i)
+		if addr {
+			elem = elem.Addr()
+		}
+		res[i] = method.Func.Call([]reflect.Value{elem})[0].Interface()
+	}
+	return res
+}
+
+// Diff returns the plan to bring live records of a menu to the desired state.
+// Read-only properties and IDs in desired records are ignored, so full records
+// as returned by ROS can be used as desired state.
+func Diff(schema *ros.MenuSchema, live, desired []ros.Row) (*Plan, error) {
+	if !schema.Table {
+		return nil, fmt.Errorf("%s is not a table menu", schema.Path)
+	}
+	if schema.Ordered {
+		return diffOrdered(schema, live, desired)
+	}
+	if len(schema.Key) == 0 {
+		return nil, fmt.Errorf("%s has no key declared", schema.Path)
+	}
+	plan := &Plan{
+		Menu: schema.Path,
+	}
+
+	liveByKey := make(map[string]ros.Row)
+	for _, row := range live {
+		if row["dynamic"] == "true" {
+			continue
+		}
+		key, err := rowKey(schema, row)
+		if err != nil {
+			return nil, fmt.Errorf("live record %s: %w", row.ID(), err)
+		}
+		// Duplicates of a key are not desired, whatever the desired state.
+		if _, ok := liveByKey[key]; ok {
+			plan.Changes = append(plan.Changes, Change{
+				Op:     OpRemove,
+				Key:    key,
+				ID:     row.ID(),
+				Fields: removedFields(schema, row),
+			})
+			continue
+		}
+		liveByKey[key] = row
+	}
+
+	seen := make(map[string]bool)
+	for i, row := range desired {
+		want, err := desiredRow(schema, row)
+		if err != nil {
+			return nil, fmt.Errorf("desired record %d: %w", i, err)
+		}
+		key, err := rowKey(schema, want)
+		if err != nil {
+			return nil, fmt.Errorf("desired record %d: %w", i, err)
+		}
+		if seen[key] {
+			return nil, fmt.Errorf("desired record %d: duplicate key %s", i, key)
+		}
+		seen[key] = true
+
+		cur, ok := liveByKey[key]
+		if !ok {
+			plan.Changes = append(plan.Changes, addChange(key, want))
+			continue
+		}
+		delete(liveByKey, key)
+
+		if ch := patchChange(schema, key, cur, want); ch != nil {
+			plan.Changes = append(plan.Changes, *ch)
+		}
+	}
+
+	for key, row := range liveByKey {
+		plan.Changes = append(plan.Changes, Change{
+			Op:     OpRemove,
+			Key:    key,
+			ID:     row.ID(),
+			Fields: removedFields(schema, row),
+		})
+	}
+
+	sort.SliceStable(plan.Changes, func(i, j int) bool {
+		a, b := plan.Changes[i], plan.Changes[j]
+		if a.Op != b.Op {
+			return a.Op < b.Op
+		}
+		if a.Key != b.Key {
+			return a.Key < b.Key
+		}
+		return a.ID < b.ID
+	})
+	return plan, nil
+}
+
+// diffOrdered implements Diff for ordered menus. Desired records are matched
+// to the first unmatched live record with the same key or, if the menu has no
+// key, with all desired properties equal. The longest run of matched records
+// which are already in the desired order stays in place, and every other
+// desired record is moved or added before the next record of that run.
+func diffOrdered(schema *ros.MenuSchema, live, desired []ros.Row) (*Plan, error) {
+	plan := &Plan{
+		Menu: schema.Path,
+	}
+
+	var static []ros.Row
+	for _, row := range live {
+		if row["dynamic"] != "true" {
+			static = append(static, row)
+		}
+	}
+
+	wants := make([]ros.Row, len(desired))
+	keys := make([]string, len(desired))
+	seen := make(map[string]bool)
+	for i, row := range desired {
+		want, err := desiredRow(schema, row)
+		if err != nil {
+			return nil, fmt.Errorf("desired record %d: %w", i, err)
+		}
+		key, err := describe(schema, want)
+		if err != nil {
+			return nil, fmt.Errorf("desired record %d: %w", i, err)
+		}
+		if len(schema.Key) > 0 && seen[key] {
+			return nil, fmt.Errorf("desired record %d: duplicate key %s", i, key)
+		}
+		seen[key] = true
+		wants[i], keys[i] = want, key
+	}
+
+	// matched[i] is the index within static of the live record matched to
+	// desired record i, or -1. desiredOf is the reverse.
+	matched := make([]int, len(wants))
+	desiredOf := make([]int, len(static))
+	for j := range desiredOf {
+		desiredOf[j] = -1
+	}
+	for i, want := range wants {
+		matched[i] = -1
+		for j, row := range static {
+			if desiredOf[j] != -1 || !matches(schema, want, row) {
+				continue
+			}
+			matched[i], desiredOf[j] = j, i
+			break
+		}
+	}
+
+	var removes []Change
+	var order []int
+	for j, row := range static {
+		if desiredOf[j] != -1 {
+			order = append(order, desiredOf[j])
+			continue
+		}
+		key, err := describe(schema, row)
+		if err != nil {
+			return nil, fmt.Errorf("live record %s: %w", row.ID(), err)
+		}
+		removes = append(removes, Change{
+			Op:     OpRemove,
+			Key:    key,
+			ID:     row.ID(),
+			Fields: removedFields(schema, row),
+		})
+	}
+	sort.SliceStable(removes, func(i, j int) bool {
+		return removes[i].Key < removes[j].Key
+	})
+	plan.Changes = append(plan.Changes, removes...)
+
+	for i, want := range wants {
+		if matched[i] == -1 {
+			continue
+		}
+		if ch := patchChange(schema, keys[i], static[matched[i]], want); ch != nil {
+			plan.Changes = append(plan.Changes, *ch)
+		}
+	}
+
+	stay := make(map[int]bool)
+	for _, i := range longestIncreasing(order) {
+		stay[i] = true
+	}
+	// anchor[i] is the ID of the first record staying in place after desired
+	// record i, or empty if there is none.
+	anchor := make([]ros.RecordID, len(wants))
+	var next ros.RecordID
+	for i := len(wants) - 1; i >= 0; i-- {
+		anchor[i] = next
+		if stay[i] {
+			next = static[matched[i]].ID()
+		}
+	}
+	// Placing records before their anchor in desired order leaves each run
+	// of them in order, directly before the anchor.
+	for i, want := range wants {
+		switch {
+		case stay[i]:
+		case matched[i] == -1:
+			ch := addChange(keys[i], want)
+			ch.Before = anchor[i]
+			plan.Changes = append(plan.Changes, ch)
+		default:
+			plan.Changes = append(plan.Changes, Change{
+				Op:     OpMove,
+				Key:    keys[i],
+				ID:     static[matched[i]].ID(),
+				Before: anchor[i],
+			})
+		}
+	}
+	return plan, nil
+}
+
+// longestIncreasing returns a longest strictly increasing subsequence of seq.
+func longestIncreasing(seq []int) []int {
+	// tails[k] is the index within seq of the smallest last element of an
+	// increasing subsequence of length k+1 found so far, and prev links each
+	// element to its predecessor within such a subsequence.
+	var tails []int
+	prev := make([]int, len(seq))
+	for i, v := range seq {
+		k := sort.Search(len(tails), func(k int) bool {
+			return seq[tails[k]] >= v
+		})
+		prev[i] = -1
+		if k > 0 {
+			prev[i] = tails[k-1]
+		}
+		if k == len(tails) {
+			tails = append(tails, i)
+		} else {
+			tails[k] = i
+		}
+	}
+	res := make([]int, len(tails))
+	if len(tails) == 0 {
+		return res
+	}
+	for i, k := tails[len(tails)-1], len(tails)-1; k >= 0; i, k = prev[i], k-1 {
+		res[k] = seq[i]
+	}
+	return res
+}
+
+// matches returns whether a live record matches a desired one (in canonical
+// form), by key if the menu declares one, or otherwise by all desired
+// properties.
+func matches(schema *ros.MenuSchema, want, cur ros.Row) bool {
+	names := schema.Key
+	if len(names) == 0 {
+		names = sortedNames(want)
+	}
+	for _, name := range names {
+		if canonical(schema, name, cur[name]) != want[name] {
+			return false
+		}
+	}
+	return true
+}
+
+// canonical returns a live value of a property in canonical form, or as is if
+// it can't be parsed.
+func canonical(schema *ros.MenuSchema, name, value string) string {
+	p := schema.Property(name)
+	if p == nil {
+		return value
+	}
+	v, err := p.Canonical(value)
+	if err != nil {
+		return value
+	}
+	return v
+}
+
+// addChange returns the change adding a desired record.
+func addChange(key string, want ros.Row) Change {
+	var fields []FieldDiff
+	for _, name := range sortedNames(want) {
+		fields = append(fields, FieldDiff{Name: name, To: want[name]})
+	}
+	return Change{
+		Op:     OpAdd,
+		Key:    key,
+		Row:    want,
+		Fields: fields,
+	}
+}
+
+// patchChange returns the change patching a live record to a desired one, or
+// nil if all desired properties are already equal.
+func patchChange(schema *ros.MenuSchema, key string, cur, want ros.Row) *Change {
+	patch := make(ros.Row)
+	var fields []FieldDiff
+	for _, name := range sortedNames(want) {
+		// If ROS returned something we can't parse, we can't tell whether
+		// it's what we want. canonical then returns it as is, and we assume
+		// it isn't.
+		if canonical(schema, name, cur[name]) == want[name] {
+			continue
+		}
+		patch[name] = want[name]
+		fields = append(fields, FieldDiff{Name: name, From: cur[name], To: want[name]})
+	}
+	if len(fields) == 0 {
+		return nil
+	}
+	return &Change{
+		Op:     OpPatch,
+		Key:    key,
+		ID:     cur.ID(),
+		Row:    patch,
+		Fields: fields,
+	}
+}
+
+// Apply runs all changes of the plan in order, stopping at the first error.
+func (p *Plan) Apply(ctx context.Context, c *ros.Client) error {
+	for _, ch := range p.Changes {
+		var err error
+		switch ch.Op {
+		case OpRemove:
+			err = c.RemoveRow(ctx, p.Menu, ch.ID)
+		case OpPatch:
+			_, err = c.PatchRow(ctx, p.Menu, ch.ID, ch.Row)
+		case OpAdd:
+			if ch.Before != "" {
+				_, err = c.AddRowBefore(ctx, p.Menu, ch.Row, ch.Before)
+			} else {
+				_, err = c.AddRow(ctx, p.Menu, ch.Row)
+			}
+		case OpMove:
+			err = c.MoveRows(ctx, p.Menu, []ros.RecordID{ch.ID}, ch.Before)
+		}
+		if err != nil {
+			return fmt.Errorf("could not %s %s %s: %w", ch.Op, p.Menu, ch.Key, err)
+		}
+	}
+	return nil
+}
+
+// desiredRow returns the settable properties of a desired row in canonical
+// form.
+func desiredRow(schema *ros.MenuSchema, row ros.Row) (ros.Row, error) {
+	res := make(ros.Row)
+	for name, value := range row {
+		if name == ".id" {
+			continue
+		}
+		p := schema.Property(name)
+		if p == nil {
+			return nil, fmt.Errorf("unknown property %q", name)
+		}
+		if p.ReadOnly {
+			continue
+		}
+		v, err := p.Canonical(value)
+		if err != nil {
+			return nil, err
+		}
+		res[name] = v
+	}
+	return res, nil
+}
+
+// rowKey returns a description of the natural key of a row, with values in
+// canonical form.
+func rowKey(schema *ros.MenuSchema, row ros.Row) (string, error) {
+	var parts []string
+	for _, name := range schema.Key {
+		value, ok := row[name]
+		if !ok {
+			return "", fmt.Errorf("key property %q not set", name)
+		}
+		if p := schema.Property(name); p != nil {
+			if v, err := p.Canonical(value); err == nil {
+				value = v
+			}
+		}
+		parts = append(parts, name+"="+value)
+	}
+	return strings.Join(parts, ","), nil
+}
+
+// describe returns a description of a row: its natural key if the menu
+// declares one, or otherwise all of its settable properties.
+func describe(schema *ros.MenuSchema, row ros.Row) (string, error) {
+	if len(schema.Key) > 0 {
+		return rowKey(schema, row)
+	}
+	var parts []string
+	for _, name := range sortedNames(row) {
+		p := schema.Property(name)
+		if p == nil || p.ReadOnly {
+			continue
+		}
+		parts = append(parts, name+"="+canonical(schema, name, row[name]))
+	}
+	return strings.Join(parts, ","), nil
+}
+
+// removedFields returns the settable properties of a removed row.
+func removedFields(schema *ros.MenuSchema, row ros.Row) []FieldDiff {
+	var fields []FieldDiff
+	for _, name := range sortedNames(row) {
+		p := schema.Property(name)
+		if p == nil || p.ReadOnly {
+			continue
+		}
+		fields = append(fields, FieldDiff{Name: name, From: row[name]})
+	}
+	return fields
+}
+
+func sortedNames(row ros.Row) []string {
+	var names []string
+	for name := range row {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
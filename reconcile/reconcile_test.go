@@ -0,0 +1,274 @@
+package reconcile
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/q3k/ros7api/ros"
+	"github.com/q3k/ros7api/rostest"
+)
+
+// TestDiff ensures records are matched by key, and that only differing
+// settable properties end up in the plan.
+func TestDiff(t *testing.T) {
+	live := []ros.Row{
+		{".id": "*1", "bridge": "bridge1", "vlan-ids": "10", "tagged": "ether1", "disabled": "false"},
+		{".id": "*2", "bridge": "bridge1", "vlan-ids": "20", "tagged": "ether1,ether2"},
+		{".id": "*3", "bridge": "bridge1", "vlan-ids": "30"},
+		{".id": "*4", "bridge": "bridge1", "vlan-ids": "1", "dynamic": "true"},
+		{".id": "*5", "bridge": "bridge1", "vlan-ids": "10"},
+	}
+	desired := []ros.Row{
+		{"bridge": "bridge1", "vlan-ids": "0xa", "tagged": "ether1"},
+		{"bridge": "bridge1", "vlan-ids": "20", "tagged": "ether1,ether8", "current-tagged": "ether1"},
+		{"bridge": "bridge1", "vlan-ids": "40", "untagged": "ether3"},
+	}
+	plan, err := Diff(ros.InterfaceBridgeVlan_Schema, live, desired)
+	if err != nil {
+		t.Fatalf("Diff: %v", err)
+	}
+	want := []Change{
+		{Op: OpRemove, Key: "bridge=bridge1,vlan-ids=10", ID: "*5", Fields: []FieldDiff{
+			{Name: "bridge", From: "bridge1"},
+			{Name: "vlan-ids", From: "10"},
+		}},
+		{Op: OpRemove, Key: "bridge=bridge1,vlan-ids=30", ID: "*3", Fields: []FieldDiff{
+			{Name: "bridge", From: "bridge1"},
+			{Name: "vlan-ids", From: "30"},
+		}},
+		{Op: OpPatch, Key: "bridge=bridge1,vlan-ids=20", ID: "*2", Row: ros.Row{"tagged": "ether1,ether8"}, Fields: []FieldDiff{
+			{Name: "tagged", From: "ether1,ether2", To: "ether1,ether8"},
+		}},
+		{Op: OpAdd, Key: "bridge=bridge1,vlan-ids=40", Row: ros.Row{"bridge": "bridge1", "vlan-ids": "40", "untagged": "ether3"}, Fields: []FieldDiff{
+			{Name: "bridge", To: "bridge1"},
+			{Name: "untagged", To: "ether3"},
+			{Name: "vlan-ids", To: "40"},
+		}},
+	}
+	if diff := cmp.Diff(want, plan.Changes); diff != "" {
+		t.Errorf("unexpected plan (-want +got):\n%s", diff)
+	}
+
+	if _, err := Diff(ros.InterfaceBridgeVlan_Schema, nil, []ros.Row{{"bridge": "bridge1"}}); err == nil {
+		t.Errorf("Diff should have failed on missing key property")
+	}
+	if _, err := Diff(ros.InterfaceBridgeVlan_Schema, nil, []ros.Row{{"bridge": "bridge1", "vlan-ids": "x"}}); err == nil {
+		t.Errorf("Diff should have failed on invalid value")
+	}
+	if _, err := Diff(ros.InterfaceBridgeVlan_Schema, nil, []ros.Row{
+		{"bridge": "bridge1", "vlan-ids": "10"},
+		{"bridge": "bridge1", "vlan-ids": "10"},
+	}); err == nil {
+		t.Errorf("Diff should have failed on duplicate key")
+	}
+}
+
+// TestApply ensures applying a plan brings a menu to the desired state.
+func TestApply(t *testing.T) {
+	ctx := context.Background()
+	s := rostest.NewServer()
+	defer s.Close()
+	c := s.Client()
+
+	s.Add("interface/bridge/vlan", rostest.Row{"bridge": "bridge1", "vlan-ids": "10", "tagged": "ether1"})
+	s.Add("interface/bridge/vlan", rostest.Row{"bridge": "bridge1", "vlan-ids": "30"})
+
+	desired := []ros.InterfaceBridgeVlan_Update{
+		{Bridge: ros.StringPtr("bridge1"), VlanIDs: ros.NumberPtr(10), Tagged: ros.StringListPtr("ether1", "ether8")},
+		{Bridge: ros.StringPtr("bridge1"), VlanIDs: ros.NumberPtr(20), Untagged: ros.StringListPtr("ether3")},
+	}
+	plan, err := Compute(ctx, c, "interface/bridge/vlan", desired)
+	if err != nil {
+		t.Fatalf("Compute: %v", err)
+	}
+	if want, got := 3, len(plan.Changes); want != got {
+		t.Fatalf("wanted %d changes, got %d:\n%s", want, got, plan)
+	}
+	if err := plan.Apply(ctx, c); err != nil {
+		t.Fatalf("Apply: %v", err)
+	}
+
+	vlans, err := c.InterfaceBridgeVlanList(ctx)
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	got := make(map[ros.Number]string)
+	for _, v := range vlans {
+		got[v.VlanIDs] = strings.Join(v.Tagged, ",") + "/" + strings.Join(v.Untagged, ",")
+	}
+	want := map[ros.Number]string{
+		10: "ether1,ether8/",
+		20: "/ether3",
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("unexpected vlans (-want +got):\n%s", diff)
+	}
+
+	plan, err = Compute(ctx, c, "interface/bridge/vlan", desired)
+	if err != nil {
+		t.Fatalf("Compute: %v", err)
+	}
+	if !plan.Empty() {
+		t.Errorf("wanted empty plan after Apply, got:\n%s", plan)
+	}
+}
+
+// TestComputeRecords ensures listed records can be fed back into Compute,
+// including ones with unset properties which ROS doesn't accept empty.
+func TestComputeRecords(t *testing.T) {
+	ctx := context.Background()
+	s := rostest.NewServer()
+	defer s.Close()
+	c := s.Client()
+
+	for _, iface := range []string{"ether1", "ether2"} {
+		port := ros.InterfaceBridgePort{Interface: iface, Bridge: "bridge1", PVID: 1}
+		if _, err := c.InterfaceBridgePortAdd(ctx, port.ToUpdate()); err != nil {
+			t.Fatalf("InterfaceBridgePortAdd: %v", err)
+		}
+	}
+	ports, err := c.InterfaceBridgePortList(ctx)
+	if err != nil {
+		t.Fatalf("InterfaceBridgePortList: %v", err)
+	}
+	plan, err := Compute(ctx, c, "interface/bridge/port", ports)
+	if err != nil {
+		t.Fatalf("Compute: %v", err)
+	}
+	if !plan.Empty() {
+		t.Errorf("wanted empty plan for listed ports, got:\n%s", plan)
+	}
+
+	rule := ros.IpFirewallFilter{Chain: ros.IpFirewallFilter_ChainInput, Action: ros.IpFirewallFilter_ActionAccept}
+	if _, err := c.IpFirewallFilterAdd(ctx, rule.ToUpdate()); err != nil {
+		t.Fatalf("IpFirewallFilterAdd: %v", err)
+	}
+	rules, err := c.IpFirewallFilterList(ctx)
+	if err != nil {
+		t.Fatalf("IpFirewallFilterList: %v", err)
+	}
+	plan, err = Compute(ctx, c, "ip/firewall/filter", rules)
+	if err != nil {
+		t.Fatalf("Compute: %v", err)
+	}
+	if !plan.Empty() {
+		t.Errorf("wanted empty plan for listed rules, got:\n%s", plan)
+	}
+}
+
+// TestLongestIncreasing ensures a longest strictly increasing subsequence is
+// found.
+func TestLongestIncreasing(t *testing.T) {
+	for _, te := range []struct {
+		seq  []int
+		want []int
+	}{
+		{nil, []int{}},
+		{[]int{0, 1, 2}, []int{0, 1, 2}},
+		{[]int{2, 1, 0}, []int{0}},
+		{[]int{1, 2, 4, 0}, []int{1, 2, 4}},
+		{[]int{3, 0, 4, 1, 2, 5}, []int{0, 1, 2, 5}},
+	} {
+		if diff := cmp.Diff(te.want, longestIncreasing(te.seq)); diff != "" {
+			t.Errorf("%v: diff (-want +got):\n%s", te.seq, diff)
+		}
+	}
+}
+
+// TestDiffOrdered ensures records of ordered menus without a key are matched
+// by their properties, and brought into order with as few moves as possible.
+func TestDiffOrdered(t *testing.T) {
+	rule := func(id, comment string) ros.Row {
+		row := ros.Row{"chain": "forward", "action": "accept", "comment": comment}
+		if id != "" {
+			row[".id"] = id
+			row["disabled"] = "false"
+		}
+		return row
+	}
+	live := []ros.Row{
+		{".id": "*6", "chain": "forward", "action": "passthrough", "dynamic": "true"},
+		rule("*1", "a"),
+		rule("*2", "b"),
+		rule("*3", "c"),
+		rule("*4", "d"),
+		rule("*5", "e"),
+	}
+	desired := []ros.Row{rule("", "d"), rule("", "a"), rule("", "b"), rule("", "x"), rule("", "c")}
+	plan, err := Diff(ros.IpFirewallFilter_Schema, live, desired)
+	if err != nil {
+		t.Fatalf("Diff: %v", err)
+	}
+	want := []Change{
+		{Op: OpRemove, Key: "action=accept,chain=forward,comment=e,disabled=false", ID: "*5", Fields: []FieldDiff{
+			{Name: "action", From: "accept"},
+			{Name: "chain", From: "forward"},
+			{Name: "comment", From: "e"},
+			{Name: "disabled", From: "false"},
+		}},
+		{Op: OpMove, Key: "action=accept,chain=forward,comment=d", ID: "*4", Before: "*1"},
+		{Op: OpAdd, Key: "action=accept,chain=forward,comment=x", Row: rule("", "x"), Before: "*3", Fields: []FieldDiff{
+			{Name: "action", To: "accept"},
+			{Name: "chain", To: "forward"},
+			{Name: "comment", To: "x"},
+		}},
+	}
+	if diff := cmp.Diff(want, plan.Changes); diff != "" {
+		t.Errorf("unexpected plan (-want +got):\n%s", diff)
+	}
+}
+
+// TestApplyOrdered ensures applying a plan brings an ordered menu into the
+// desired order, leaving dynamic records alone.
+func TestApplyOrdered(t *testing.T) {
+	ctx := context.Background()
+	s := rostest.NewServer()
+	defer s.Close()
+	c := s.Client()
+
+	for _, comment := range []string{"a", "b", "c", "d", "e"} {
+		s.Add("ip/firewall/filter", rostest.Row{"chain": "input", "action": "accept", "comment": comment})
+	}
+	s.Add("ip/firewall/filter", rostest.Row{"chain": "forward", "action": "passthrough", "dynamic": "true"})
+
+	chain := ros.IpFirewallFilter_ChainInput
+	action := ros.IpFirewallFilter_ActionAccept
+	var desired []ros.IpFirewallFilter_Update
+	for _, comment := range []string{"e", "c", "x", "a", "b"} {
+		desired = append(desired, ros.IpFirewallFilter_Update{
+			Chain:   &chain,
+			Action:  &action,
+			Comment: ros.StringPtr(comment),
+		})
+	}
+	plan, err := Compute(ctx, c, "ip/firewall/filter", desired)
+	if err != nil {
+		t.Fatalf("Compute: %v", err)
+	}
+	// d is removed, c and e are moved before a, and x is added before a.
+	if want, got := 4, len(plan.Changes); want != got {
+		t.Fatalf("wanted %d changes, got %d:\n%s", want, got, plan)
+	}
+	if err := plan.Apply(ctx, c); err != nil {
+		t.Fatalf("Apply: %v", err)
+	}
+
+	var got []string
+	for _, r := range s.Records("ip/firewall/filter") {
+		got = append(got, r["comment"])
+	}
+	if diff := cmp.Diff([]string{"e", "c", "x", "a", "b", ""}, got); diff != "" {
+		t.Errorf("unexpected rules (-want +got):\n%s", diff)
+	}
+
+	plan, err = Compute(ctx, c, "ip/firewall/filter", desired)
+	if err != nil {
+		t.Fatalf("Compute: %v", err)
+	}
+	if !plan.Empty() {
+		t.Errorf("wanted empty plan after Apply, got:\n%s", plan)
+	}
+}
@@ -0,0 +1,520 @@
+package ros
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// APITransport is a Transport which talks to the ROS binary API (the api and
+// api-ssl services, by default on port 8728 and 8729). Requests are mapped
+// onto API sentences, and their replies turned into REST-like responses, so
+// that generated Client methods work the same as with the REST API.
+//
+// A single connection is kept open and used for all requests, one at a time.
+// It is established on first use, and re-established after any error.
+type APITransport struct {
+	// Address (like foo.example.com or 1.2.3.4:8728 or [2a0d:eb01::1]:8729)
+	// of the ROS api or api-ssl service. If the port is not given, 8728 is
+	// used, or 8729 if TLS is set.
+	Address string
+	// Credentials used to log into the ROS API.
+	Credentials Credentials
+	// TLS configuration used to connect to the api-ssl service. If not set,
+	// the plaintext api service is used.
+	TLS *tls.Config
+	// Timeout for establishing a connection and logging in. If not set, 30
+	// seconds is used.
+	Timeout time.Duration
+
+	mu   sync.Mutex
+	conn net.Conn
+	r    *bufio.Reader
+}
+
+func (a *APITransport) address() string {
+	if _, _, err := net.SplitHostPort(a.Address); err == nil {
+		return a.Address
+	}
+	if a.TLS != nil {
+		return net.JoinHostPort(a.Address, "8729")
+	}
+	return net.JoinHostPort(a.Address, "8728")
+}
+
+// Close closes the connection to ROS, if any. The transport can still be used
+// afterwards, and will reconnect as needed.
+func (a *APITransport) Close() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.closeLocked()
+}
+
+func (a *APITransport) closeLocked() error {
+	if a.conn == nil {
+		return nil
+	}
+	err := a.conn.Close()
+	a.conn = nil
+	a.r = nil
+	return err
+}
+
+// connect establishes a connection and logs in, if not yet connected.
+func (a *APITransport) connect(ctx context.Context) error {
+	if a.conn != nil {
+		return nil
+	}
+	timeout := a.Timeout
+	if timeout == 0 {
+		timeout = 30 * time.Second
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var conn net.Conn
+	var err error
+	if a.TLS != nil {
+		d := &tls.Dialer{Config: a.TLS}
+		conn, err = d.DialContext(ctx, "tcp", a.address())
+	} else {
+		var d net.Dialer
+		conn, err = d.DialContext(ctx, "tcp", a.address())
+	}
+	if err != nil {
+		return fmt.Errorf("could not connect: %w", err)
+	}
+	a.conn = conn
+	a.r = bufio.NewReader(conn)
+
+	creds := a.Credentials
+	if creds == nil {
+		creds = &StaticCredentials{Username: "admin"}
+	}
+	username, password, err := creds.Credentials(ctx, a.Address)
+	if err != nil {
+		a.closeLocked()
+		return fmt.Errorf("could not get credentials: %w", err)
+	}
+	// This is the post-6.43 login method, which is the only one supported by
+	// ROS7.
+	_, _, err = a.run(ctx, []string{"/login", "=name=" + username, "=password=" + password})
+	if err != nil {
+		a.closeLocked()
+		var trap *apiTrap
+		if errors.As(err, &trap) {
+			return &APIError{
+				Status:  http.StatusUnauthorized,
+				Message: http.StatusText(http.StatusUnauthorized),
+				Detail:  trap.message,
+				Method:  "POST",
+				Path:    "login",
+			}
+		}
+		return fmt.Errorf("could not log in: %w", err)
+	}
+	return nil
+}
+
+// apiTrap is a !trap reply from ROS.
+type apiTrap struct {
+	message string
+}
+
+func (t *apiTrap) Error() string {
+	return t.message
+}
+
+// run sends a sentence and reads the reply up to and including !done,
+// returning all !re rows and the attributes of !done (eg. ret). A !trap reply
+// is returned as *apiTrap, after the rest of the reply has been read. Any
+// other error leaves the connection in an unknown state, and the caller must
+// close it.
+func (a *APITransport) run(ctx context.Context, sentence []string) ([]map[string]string, map[string]string, error) {
+	if deadline, ok := ctx.Deadline(); ok {
+		a.conn.SetDeadline(deadline)
+	} else {
+		a.conn.SetDeadline(time.Time{})
+	}
+	// Unblock any pending reads or writes if the context gets canceled. The
+	// goroutine must have exited before returning, so that it can't change
+	// the deadline during the next command.
+	stop := make(chan struct{})
+	exited := make(chan struct{})
+	defer func() {
+		close(stop)
+		<-exited
+	}()
+	conn := a.conn
+	go func() {
+		defer close(exited)
+		select {
+		case <-ctx.Done():
+			conn.SetDeadline(time.Now())
+		case <-stop:
+		}
+	}()
+
+	if err := writeSentence(a.conn, sentence); err != nil {
+		return nil, nil, err
+	}
+	var rows []map[string]string
+	var trap *apiTrap
+	for {
+		words, err := readSentence(a.r)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, nil, ctx.Err()
+			}
+			return nil, nil, err
+		}
+		if len(words) == 0 {
+			continue
+		}
+		attrs := make(map[string]string)
+		for _, w := range words[1:] {
+			if !strings.HasPrefix(w, "=") {
+				continue
+			}
+			parts := strings.SplitN(w[1:], "=", 2)
+			if len(parts) != 2 {
+				continue
+			}
+			attrs[parts[0]] = parts[1]
+		}
+		switch words[0] {
+		case "!re":
+			rows = append(rows, attrs)
+		case "!empty":
+			// Sent by ROS 7.18+ before !done if there are no rows.
+		case "!trap":
+			// Only the first trap is kept, as any following ones are
+			// usually less specific.
+			if trap == nil {
+				trap = &apiTrap{message: attrs["message"]}
+			}
+		case "!fatal":
+			msg := attrs["message"]
+			if msg == "" && len(words) > 1 {
+				msg = words[1]
+			}
+			return nil, nil, fmt.Errorf("fatal error: %s", msg)
+		case "!done":
+			if trap != nil {
+				return nil, nil, trap
+			}
+			return rows, attrs, nil
+		default:
+			return nil, nil, fmt.Errorf("unexpected reply %q", words[0])
+		}
+	}
+}
+
+func (a *APITransport) Do(ctx context.Context, req *Request) (io.ReadCloser, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if err := a.connect(ctx); err != nil {
+		return nil, err
+	}
+	res, err := a.do(ctx, req)
+	if err != nil {
+		var trap *apiTrap
+		if errors.As(err, &trap) {
+			return nil, apiErrorFromTrap(req, trap)
+		}
+		var aerr *APIError
+		if !errors.As(err, &aerr) {
+			a.closeLocked()
+			return nil, fmt.Errorf("when running API request: %w", err)
+		}
+		return nil, err
+	}
+	data, err := json.Marshal(res)
+	if err != nil {
+		return nil, err
+	}
+	return ioutil.NopCloser(bytes.NewReader(data)), nil
+}
+
+// apiErrorFromTrap builds an APIError from a !trap reply, as the REST API
+// would have returned it.
+func apiErrorFromTrap(req *Request, trap *apiTrap) *APIError {
+	status := http.StatusBadRequest
+	if trap.message == "no such item" {
+		status = http.StatusNotFound
+	}
+	return &APIError{
+		Status:  status,
+		Message: http.StatusText(status),
+		Detail:  trap.message,
+		Method:  req.Method,
+		Path:    req.Path(),
+	}
+}
+
+// do maps a request onto API sentences and runs them, returning what the REST
+// API would have returned for this request, ie. a row, a list of rows, or
+// nothing.
+func (a *APITransport) do(ctx context.Context, req *Request) (interface{}, error) {
+	menu := "/" + req.Menu
+	if req.Menu != "" {
+		menu += "/"
+	}
+
+	switch req.Method {
+	case "GET":
+		sentence := []string{menu + "print"}
+		var keys []string
+		for k := range req.Query {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			v := req.Query.Get(k)
+			if k == ".proplist" {
+				sentence = append(sentence, "=.proplist="+v)
+				continue
+			}
+			sentence = append(sentence, "?"+k+"="+v)
+		}
+		if req.ID != "" {
+			return a.get(ctx, req, sentence)
+		}
+		rows, _, err := a.run(ctx, sentence)
+		if err != nil {
+			return nil, err
+		}
+		if m := LookupMenu(req.Menu); m != nil && m.Singleton {
+			if len(rows) == 0 {
+				return map[string]string{}, nil
+			}
+			return rows[0], nil
+		}
+		return apiRows(rows), nil
+
+	case "PUT":
+		sentence, err := apiAttributes(menu+"add", req.Body)
+		if err != nil {
+			return nil, err
+		}
+		_, done, err := a.run(ctx, sentence)
+		if err != nil {
+			return nil, err
+		}
+		req = &Request{Method: req.Method, Menu: req.Menu, ID: RecordID(done["ret"])}
+		return a.get(ctx, req, []string{menu + "print"})
+
+	case "PATCH":
+		sentence, err := apiAttributes(menu+"set", req.Body)
+		if err != nil {
+			return nil, err
+		}
+		sentence = append(sentence, "=.id="+string(req.ID))
+		if _, _, err := a.run(ctx, sentence); err != nil {
+			return nil, err
+		}
+		return a.get(ctx, req, []string{menu + "print"})
+
+	case "DELETE":
+		if _, _, err := a.run(ctx, []string{menu + "remove", "=.id=" + string(req.ID)}); err != nil {
+			return nil, err
+		}
+		return nil, nil
+
+	case "POST":
+		var sentence []string
+		if req.Command == "print" {
+			var body struct {
+				Proplist []string `json:".proplist"`
+				Query    []string `json:".query"`
+			}
+			if err := json.Unmarshal(req.Body, &body); err != nil {
+				return nil, fmt.Errorf("invalid print request: %w", err)
+			}
+			sentence = []string{menu + "print"}
+			if len(body.Proplist) > 0 {
+				sentence = append(sentence, "=.proplist="+strings.Join(body.Proplist, ","))
+			}
+			for _, q := range body.Query {
+				sentence = append(sentence, "?"+q)
+			}
+		} else {
+			var err error
+			sentence, err = apiAttributes(menu+req.Command, req.Body)
+			if err != nil {
+				return nil, err
+			}
+		}
+		rows, done, err := a.run(ctx, sentence)
+		if err != nil {
+			return nil, err
+		}
+		if len(rows) == 0 && done["ret"] != "" {
+			return map[string]string{"ret": done["ret"]}, nil
+		}
+		return apiRows(rows), nil
+	}
+	return nil, fmt.Errorf("unsupported method %q", req.Method)
+}
+
+// get runs a print sentence for the record with the request's ID, returning it
+// or a not found APIError.
+func (a *APITransport) get(ctx context.Context, req *Request, sentence []string) (interface{}, error) {
+	sentence = append(sentence, "?.id="+string(req.ID))
+	rows, _, err := a.run(ctx, sentence)
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, &APIError{
+			Status:  http.StatusNotFound,
+			Message: http.StatusText(http.StatusNotFound),
+			Detail:  "no such item",
+			Method:  req.Method,
+			Path:    req.Path(),
+		}
+	}
+	return rows[0], nil
+}
+
+// apiRows makes sure an empty reply is returned as an empty list, not null.
+func apiRows(rows []map[string]string) []map[string]string {
+	if rows == nil {
+		return []map[string]string{}
+	}
+	return rows
+}
+
+// apiAttributes builds a sentence from a command and a JSON request body
+// containing ROS values.
+func apiAttributes(command string, body []byte) ([]string, error) {
+	sentence := []string{command}
+	if len(body) == 0 {
+		return sentence, nil
+	}
+	var values map[string]string
+	if err := json.Unmarshal(body, &values); err != nil {
+		return nil, fmt.Errorf("request body did not serialize into strings: %w", err)
+	}
+	var keys []string
+	for k := range values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		sentence = append(sentence, "="+k+"="+values[k])
+	}
+	return sentence, nil
+}
+
+// encodeLength encodes the length of an API word.
+func encodeLength(l int) []byte {
+	switch {
+	case l < 0x80:
+		return []byte{byte(l)}
+	case l < 0x4000:
+		return []byte{byte(l>>8) | 0x80, byte(l)}
+	case l < 0x200000:
+		return []byte{byte(l>>16) | 0xc0, byte(l >> 8), byte(l)}
+	case l < 0x10000000:
+		return []byte{byte(l>>24) | 0xe0, byte(l >> 16), byte(l >> 8), byte(l)}
+	default:
+		return []byte{0xf0, byte(l >> 24), byte(l >> 16), byte(l >> 8), byte(l)}
+	}
+}
+
+const (
+	// maxWordLength is the maximum length of a word read from ROS, so that a
+	// broken or malicious peer can't make us allocate arbitrary amounts of
+	// memory.
+	maxWordLength = 16 << 20
+	// maxSentenceLength is the maximum total length of the words of a
+	// sentence read from ROS.
+	maxSentenceLength = 64 << 20
+)
+
+// readLength decodes the length of an API word, refusing lengths above
+// maxWordLength.
+func readLength(r io.ByteReader) (int, error) {
+	b, err := r.ReadByte()
+	if err != nil {
+		return 0, err
+	}
+	var l, extra int
+	switch {
+	case b&0x80 == 0:
+		return int(b), nil
+	case b&0xc0 == 0x80:
+		l, extra = int(b&0x3f), 1
+	case b&0xe0 == 0xc0:
+		l, extra = int(b&0x1f), 2
+	case b&0xf0 == 0xe0:
+		l, extra = int(b&0x0f), 3
+	case b == 0xf0:
+		l, extra = 0, 4
+	default:
+		return 0, fmt.Errorf("invalid word length byte %#x", b)
+	}
+	for i := 0; i < extra; i++ {
+		b, err := r.ReadByte()
+		if err != nil {
+			return 0, err
+		}
+		l = l<<8 | int(b)
+	}
+	if l > maxWordLength {
+		return 0, fmt.Errorf("word length %d exceeds maximum of %d", l, maxWordLength)
+	}
+	return l, nil
+}
+
+// writeSentence writes a sentence, ie. a list of words followed by an empty
+// word.
+func writeSentence(w io.Writer, words []string) error {
+	var buf []byte
+	for _, word := range words {
+		buf = append(buf, encodeLength(len(word))...)
+		buf = append(buf, word...)
+	}
+	buf = append(buf, 0)
+	_, err := w.Write(buf)
+	return err
+}
+
+// readSentence reads a sentence, returning its words. Sentences longer than
+// maxSentenceLength are refused.
+func readSentence(r *bufio.Reader) ([]string, error) {
+	var words []string
+	total := 0
+	for {
+		l, err := readLength(r)
+		if err != nil {
+			return nil, err
+		}
+		if l == 0 {
+			return words, nil
+		}
+		total += l
+		if total > maxSentenceLength {
+			return nil, fmt.Errorf("sentence length exceeds maximum of %d", maxSentenceLength)
+		}
+		word := make([]byte, l)
+		if _, err := io.ReadFull(r, word); err != nil {
+			return nil, err
+		}
+		words = append(words, string(word))
+	}
+}
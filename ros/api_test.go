@@ -0,0 +1,213 @@
+package ros
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"testing"
+)
+
+// TestWordLength ensures word lengths round trip through all their encodings,
+// and that lengths above maxWordLength are refused.
+func TestWordLength(t *testing.T) {
+	for _, l := range []int{0, 1, 0x7f, 0x80, 0x3fff, 0x4000, 0x1fffff, 0x200000, maxWordLength, maxWordLength + 1, 0xfffffff, 0x10000000, 0x7fffffff} {
+		enc := encodeLength(l)
+		got, err := readLength(bytes.NewReader(enc))
+		if l > maxWordLength {
+			if err == nil {
+				t.Errorf("%#x: should have been refused", l)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%#x: %v", l, err)
+			continue
+		}
+		if got != l {
+			t.Errorf("%#x: encoded as %x, decoded as %#x", l, enc, got)
+		}
+	}
+	if want, got := []byte{0x80, 0x80}, encodeLength(0x80); !bytes.Equal(want, got) {
+		t.Errorf("wanted 0x80 encoded as %x, got %x", want, got)
+	}
+}
+
+// testAPIServer is a fake ROS API service, serving a single connection. The
+// handler is called with every sentence after login, and returns the reply
+// sentences.
+func testAPIServer(t *testing.T, password string, h func(words []string) [][]string) string {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Listen: %v", err)
+	}
+	t.Cleanup(func() { l.Close() })
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		r := bufio.NewReader(conn)
+		for {
+			words, err := readSentence(r)
+			if err != nil {
+				return
+			}
+			var reply [][]string
+			if words[0] == "/login" {
+				if words[2] == "=password="+password {
+					reply = [][]string{{"!done"}}
+				} else {
+					reply = [][]string{{"!trap", "=message=invalid user name or password (6)"}, {"!done"}}
+				}
+			} else {
+				reply = h(words)
+			}
+			for _, s := range reply {
+				if err := writeSentence(conn, s); err != nil {
+					return
+				}
+			}
+		}
+	}()
+	return l.Addr().String()
+}
+
+// TestAPITransport ensures generated methods work over the binary API.
+func TestAPITransport(t *testing.T) {
+	ctx := context.Background()
+	var sentences []string
+	addr := testAPIServer(t, "hunter2", func(words []string) [][]string {
+		sentences = append(sentences, strings.Join(words, " "))
+		switch words[0] {
+		case "/interface/bridge/vlan/print":
+			if len(words) > 1 && words[len(words)-1] == "?.id=*2" {
+				return [][]string{{"!done"}}
+			}
+			return [][]string{
+				{"!re", "=.id=*1", "=bridge=bridge1", "=vlan-ids=3005"},
+				{"!done"},
+			}
+		case "/interface/bridge/vlan/add":
+			return [][]string{{"!done", "=ret=*1"}}
+		case "/interface/bridge/vlan/remove":
+			return [][]string{{"!trap", "=message=no such item"}, {"!done"}}
+		case "/ip/address/print":
+			return [][]string{{"!empty"}, {"!done"}}
+		case "/system/identity/print":
+			return [][]string{{"!re", "=name=core-sw1"}, {"!done"}}
+		case "/ping":
+			return [][]string{
+				{"!re", "=seq=0", "=host=10.0.0.1"},
+				{"!re", "=seq=1", "=host=10.0.0.1", "=sent=2", "=received=2"},
+				{"!done"},
+			}
+		}
+		return [][]string{{"!trap", "=message=no such command"}, {"!done"}}
+	})
+	c := &Client{
+		Transport: &APITransport{
+			Address:     addr,
+			Credentials: &StaticCredentials{Username: "admin", Password: "hunter2"},
+		},
+	}
+
+	res, err := c.InterfaceBridgeVlanListWith(ctx, &InterfaceBridgeVlan_ListOptions{
+		Filter: &InterfaceBridgeVlan_Filter{Bridge: StringPtr("bridge1")},
+	})
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if len(res) != 1 || res[0].ID != "*1" || res[0].VlanIDs != 3005 {
+		t.Errorf("unexpected List result %+v", res)
+	}
+
+	addrs, err := c.IpAddressList(ctx)
+	if err != nil {
+		t.Fatalf("List of empty menu: %v", err)
+	}
+	if len(addrs) != 0 {
+		t.Errorf("unexpected List result %+v", addrs)
+	}
+
+	_, err = c.InterfaceBridgeVlanFind(ctx, &InterfaceBridgeVlan_Filter{
+		VlanIDs: NumberPtr(3005),
+	}, InterfaceBridgeVlan_FieldID)
+	if err != nil {
+		t.Fatalf("Find: %v", err)
+	}
+
+	v, err := c.InterfaceBridgeVlanAdd(ctx, &InterfaceBridgeVlan_Update{
+		Bridge:  StringPtr("bridge1"),
+		VlanIDs: NumberPtr(3005),
+	})
+	if err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+	if v.ID != "*1" {
+		t.Errorf("unexpected Add result %+v", v)
+	}
+
+	if _, err := c.InterfaceBridgeVlanGet(ctx, "*2"); !IsNotFound(err) {
+		t.Errorf("Get should have returned not found, got %v", err)
+	}
+	if err := c.InterfaceBridgeVlanRemove(ctx, "*2"); !IsNotFound(err) {
+		t.Errorf("Remove should have returned not found, got %v", err)
+	}
+	if err := c.SystemReboot(ctx); !IsBadRequest(err) {
+		t.Errorf("Reboot should have returned bad request, got %v", err)
+	}
+
+	id, err := c.SystemIdentityGet(ctx)
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if want, got := "core-sw1", id.Name; want != got {
+		t.Errorf("wanted name %q, got %q", want, got)
+	}
+
+	rows, err := c.Ping(ctx, &Ping_Args{Address: StringPtr("10.0.0.1"), Count: NumberPtr(2)})
+	if err != nil {
+		t.Fatalf("Ping: %v", err)
+	}
+	if len(rows) != 2 || rows[1].Sent != 2 {
+		t.Errorf("unexpected Ping reply %+v", rows)
+	}
+
+	want := []string{
+		"/interface/bridge/vlan/print ?bridge=bridge1",
+		"/ip/address/print",
+		"/interface/bridge/vlan/print =.proplist=.id ?vlan-ids=3005",
+		"/interface/bridge/vlan/add =bridge=bridge1 =vlan-ids=3005",
+		"/interface/bridge/vlan/print ?.id=*1",
+		"/interface/bridge/vlan/print ?.id=*2",
+		"/interface/bridge/vlan/remove =.id=*2",
+		"/system/reboot",
+		"/system/identity/print",
+		"/ping =address=10.0.0.1 =count=2",
+	}
+	if got := sentences; fmt.Sprint(want) != fmt.Sprint(got) {
+		t.Errorf("wanted sentences\n%q\ngot\n%q", want, got)
+	}
+}
+
+// TestAPILogin ensures a failed login is reported as unauthorized.
+func TestAPILogin(t *testing.T) {
+	addr := testAPIServer(t, "hunter2", func(words []string) [][]string {
+		return [][]string{{"!done"}}
+	})
+	c := &Client{
+		Transport: &APITransport{
+			Address:     addr,
+			Credentials: &StaticCredentials{Username: "admin", Password: "wrong"},
+		},
+	}
+	_, err := c.InterfaceBridgeVlanList(context.Background())
+	if !IsUnauthorized(err) {
+		t.Errorf("List should have returned unauthorized, got %v", err)
+	}
+}
@@ -1,12 +1,14 @@
 package ros
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"net"
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 )
 
 // RecordID is the ID of a ROS record, eg. '*13'.
@@ -99,17 +101,19 @@ func (n *IPNet) UnmarshalJSON(b []byte) error {
 	if err := json.Unmarshal(b, &s); err != nil {
 		return err
 	}
-	ip, net, err := net.ParseCIDR(s)
+	parsed, err := ParseIPNet(s)
 	if err != nil {
-		return fmt.Errorf("invalid CIDR %q: %w", s, err)
-	}
-	*n = IPNet{
-		Address: ip,
-		Network: *net,
+		return err
 	}
+	*n = *parsed
 	return nil
 }
 
+// Equal returns whether both addresses and networks are the same.
+func (n IPNet) Equal(o IPNet) bool {
+	return n.Address.Equal(o.Address) && n.Network.IP.Equal(o.Network.IP) && bytes.Equal(n.Network.Mask, o.Network.Mask)
+}
+
 func (n *IPNet) MarshalJSON() ([]byte, error) {
 	ones, _ := n.Network.Mask.Size()
 	v := fmt.Sprintf(`"%s/%d"`, n.Address.String(), ones)
@@ -139,10 +143,605 @@ func (n *IP) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
+// Equal returns whether both addresses are the same, treating IPv4 and
+// IPv4-in-IPv6 forms as equal.
+func (n IP) Equal(o IP) bool {
+	return net.IP(n).Equal(net.IP(o))
+}
+
 func (n *IP) MarshalJSON() ([]byte, error) {
 	return []byte(fmt.Sprintf("%q", net.IP(*n).String())), nil
 }
 
+// ParseIPNet parses a ROS-style address with a prefix length, eg. 10.0.0.1/24
+// or 2a0d:eb01::1/64.
+func ParseIPNet(s string) (*IPNet, error) {
+	ip, net, err := net.ParseCIDR(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid CIDR %q: %w", s, err)
+	}
+	return &IPNet{
+		Address: ip,
+		Network: *net,
+	}, nil
+}
+
+// IPNetList is a ROS list of addresses with prefix lengths, eg.
+// 10.0.0.0/24,10.0.1.1/32, as used by simple queue targets.
+type IPNetList []IPNet
+
+// ParseIPNetList parses a ROS-style list of addresses with prefix lengths.
+func ParseIPNetList(s string) (IPNetList, error) {
+	var res IPNetList
+	if s == "" {
+		return res, nil
+	}
+	for _, part := range strings.Split(s, ",") {
+		n, err := ParseIPNet(part)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, *n)
+	}
+	return res, nil
+}
+
+// IPNetListPtr returns a pointer to IPNetList, for use in _Update structs.
+func IPNetListPtr(nets ...IPNet) *IPNetList {
+	v := IPNetList(nets)
+	return &v
+}
+
+func (n *IPNetList) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	parsed, err := ParseIPNetList(s)
+	if err != nil {
+		return err
+	}
+	*n = parsed
+	return nil
+}
+
+// Equal returns whether both lists contain the same addresses and networks in
+// the same order.
+func (n IPNetList) Equal(o IPNetList) bool {
+	if len(n) != len(o) {
+		return false
+	}
+	for i := range n {
+		if !n[i].Equal(o[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (n IPNetList) String() string {
+	parts := make([]string, len(n))
+	for i := range n {
+		ones, _ := n[i].Network.Mask.Size()
+		parts[i] = fmt.Sprintf("%s/%d", n[i].Address.String(), ones)
+	}
+	return strings.Join(parts, ",")
+}
+
+func (n *IPNetList) MarshalJSON() ([]byte, error) {
+	return json.Marshal(n.String())
+}
+
+// MAC is a ROS Ethernet MAC address, serialized in upper case colon
+// notation, eg. 4C:5E:0C:01:02:03.
+type MAC net.HardwareAddr
+
+// MACPtr returns a pointer to MAC, for use in _Update structs.
+func MACPtr(m net.HardwareAddr) *MAC {
+	v := MAC(m)
+	return &v
+}
+
+func (n *MAC) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	m, err := net.ParseMAC(s)
+	if err != nil {
+		return fmt.Errorf("invalid MAC %q: %w", s, err)
+	}
+	*n = MAC(m)
+	return nil
+}
+
+func (n *MAC) MarshalJSON() ([]byte, error) {
+	return []byte(fmt.Sprintf("%q", n.String())), nil
+}
+
+// Equal returns whether both addresses are the same.
+func (n MAC) Equal(o MAC) bool {
+	return bytes.Equal(n, o)
+}
+
+func (n MAC) String() string {
+	return strings.ToUpper(net.HardwareAddr(n).String())
+}
+
+// Gateway is a ROS route gateway: an IP address (eg. 10.0.0.1), an interface
+// (eg. ether1), or an IP address reachable through an interface (eg.
+// fe80::1%ether1, as needed for link-local IPv6 gateways).
+type Gateway struct {
+	// IP is the address of the gateway, if any.
+	IP net.IP
+	// Interface is the interface of the gateway, if any.
+	Interface string
+}
+
+// ParseGateway parses a ROS gateway, eg. 10.0.0.1, ether1 or fe80::1%ether1.
+func ParseGateway(s string) (*Gateway, error) {
+	if s == "" {
+		return nil, fmt.Errorf("empty gateway")
+	}
+	if i := strings.LastIndex(s, "%"); i != -1 {
+		ip := net.ParseIP(s[:i])
+		if ip == nil || i == len(s)-1 {
+			return nil, fmt.Errorf("invalid gateway %q", s)
+		}
+		return &Gateway{IP: ip, Interface: s[i+1:]}, nil
+	}
+	if ip := net.ParseIP(s); ip != nil {
+		return &Gateway{IP: ip}, nil
+	}
+	return &Gateway{Interface: s}, nil
+}
+
+// GatewayPtr returns a pointer to a Gateway, for use in _Update structs. Either
+// ip or iface may be empty.
+func GatewayPtr(ip net.IP, iface string) *Gateway {
+	return &Gateway{IP: ip, Interface: iface}
+}
+
+func (g *Gateway) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	// Unset, eg. the immediate-gw of an unreachable route.
+	if s == "" {
+		*g = Gateway{}
+		return nil
+	}
+	parsed, err := ParseGateway(s)
+	if err != nil {
+		return err
+	}
+	*g = *parsed
+	return nil
+}
+
+func (g *Gateway) MarshalJSON() ([]byte, error) {
+	if g.IP == nil && g.Interface == "" {
+		return nil, fmt.Errorf("empty gateway")
+	}
+	return json.Marshal(g.String())
+}
+
+// Equal returns whether both gateways are the same.
+func (g Gateway) Equal(o Gateway) bool {
+	if (g.IP == nil) != (o.IP == nil) {
+		return false
+	}
+	return g.IP.Equal(o.IP) && g.Interface == o.Interface
+}
+
+func (g Gateway) String() string {
+	switch {
+	case g.IP == nil:
+		return g.Interface
+	case g.Interface == "":
+		return g.IP.String()
+	}
+	return g.IP.String() + "%" + g.Interface
+}
+
+// GatewayList is a ROS list of route gateways, eg. 10.0.0.1,10.0.0.2 for an
+// ECMP route. It is empty for routes without a gateway, eg. blackholes.
+type GatewayList []Gateway
+
+// ParseGatewayList parses a ROS-style list of gateways.
+func ParseGatewayList(s string) (GatewayList, error) {
+	var res GatewayList
+	if s == "" {
+		return res, nil
+	}
+	for _, part := range strings.Split(s, ",") {
+		g, err := ParseGateway(part)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, *g)
+	}
+	return res, nil
+}
+
+// GatewayListPtr returns a pointer to GatewayList, for use in _Update structs.
+func GatewayListPtr(gateways ...Gateway) *GatewayList {
+	v := GatewayList(gateways)
+	return &v
+}
+
+func (n *GatewayList) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	parsed, err := ParseGatewayList(s)
+	if err != nil {
+		return err
+	}
+	*n = parsed
+	return nil
+}
+
+// Equal returns whether both lists contain the same gateways in the same
+// order.
+func (n GatewayList) Equal(o GatewayList) bool {
+	if len(n) != len(o) {
+		return false
+	}
+	for i := range n {
+		if !n[i].Equal(o[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (n GatewayList) String() string {
+	parts := make([]string, len(n))
+	for i, g := range n {
+		parts[i] = g.String()
+	}
+	return strings.Join(parts, ",")
+}
+
+func (n *GatewayList) MarshalJSON() ([]byte, error) {
+	for _, g := range *n {
+		if g.IP == nil && g.Interface == "" {
+			return nil, fmt.Errorf("empty gateway")
+		}
+	}
+	return json.Marshal(n.String())
+}
+
+// Duration is a ROS time interval, eg. 1w2d3h4m5s or 10ms. When
+// deserializing, the HH:MM:SS form (optionally prefixed by weeks and days,
+// eg. 1d00:10:00) is also accepted.
+type Duration time.Duration
+
+// DurationPtr returns a pointer to Duration, for use in _Update structs.
+func DurationPtr(d time.Duration) *Duration {
+	v := Duration(d)
+	return &v
+}
+
+// durationUnits are the units used by ROS in durations, from largest to
+// smallest.
+var durationUnits = []struct {
+	suffix string
+	d      time.Duration
+}{
+	{"w", 7 * 24 * time.Hour},
+	{"d", 24 * time.Hour},
+	{"h", time.Hour},
+	{"m", time.Minute},
+	{"s", time.Second},
+	{"ms", time.Millisecond},
+	{"us", time.Microsecond},
+	{"ns", time.Nanosecond},
+}
+
+// ParseDuration parses a ROS-style time interval, eg. 1d2h3m4s, 500ms or
+// 1d00:10:00.
+func ParseDuration(s string) (Duration, error) {
+	if s == "" {
+		return 0, fmt.Errorf("empty duration")
+	}
+	rest := s
+	var res time.Duration
+	for rest != "" {
+		i := 0
+		for i < len(rest) && rest[i] >= '0' && rest[i] <= '9' {
+			i++
+		}
+		if i == 0 {
+			return 0, fmt.Errorf("invalid duration %q", s)
+		}
+		// HH:MM:SS suffix, possibly after weeks and days.
+		if i < len(rest) && rest[i] == ':' {
+			d, err := parseClockDuration(rest)
+			if err != nil {
+				return 0, fmt.Errorf("invalid duration %q: %w", s, err)
+			}
+			res += d
+			break
+		}
+		n, err := strconv.ParseInt(rest[:i], 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration %q: %w", s, err)
+		}
+		rest = rest[i:]
+		j := 0
+		for j < len(rest) && (rest[j] < '0' || rest[j] > '9') {
+			j++
+		}
+		suffix := rest[:j]
+		rest = rest[j:]
+		if suffix == "" {
+			if rest != "" {
+				return 0, fmt.Errorf("invalid duration %q", s)
+			}
+			// Bare numbers are seconds.
+			suffix = "s"
+		}
+		found := false
+		for _, u := range durationUnits {
+			if u.suffix == suffix {
+				res += time.Duration(n) * u.d
+				found = true
+				break
+			}
+		}
+		if !found {
+			return 0, fmt.Errorf("invalid duration %q: unknown unit %q", s, suffix)
+		}
+	}
+	return Duration(res), nil
+}
+
+// parseClockDuration parses a HH:MM:SS(.fraction) duration.
+func parseClockDuration(s string) (time.Duration, error) {
+	parts := strings.Split(s, ":")
+	if len(parts) != 3 {
+		return 0, fmt.Errorf("invalid HH:MM:SS %q", s)
+	}
+	h, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	m, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	sec, err := strconv.ParseFloat(parts[2], 64)
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(h)*time.Hour + time.Duration(m)*time.Minute + time.Duration(sec*float64(time.Second)), nil
+}
+
+func (n *Duration) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	d, err := ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	*n = d
+	return nil
+}
+
+func (n *Duration) MarshalJSON() ([]byte, error) {
+	return []byte(fmt.Sprintf("%q", n.String())), nil
+}
+
+func (n Duration) String() string {
+	d := time.Duration(n)
+	if d == 0 {
+		return "0s"
+	}
+	var res string
+	if d < 0 {
+		res = "-"
+		d = -d
+	}
+	for _, u := range durationUnits {
+		if d >= u.d {
+			res += fmt.Sprintf("%d%s", d/u.d, u.suffix)
+			d %= u.d
+		}
+	}
+	return res
+}
+
+// Duration returns the ROS duration as a time.Duration.
+func (n Duration) Duration() time.Duration {
+	return time.Duration(n)
+}
+
+// unit is a multiplier suffix used by ROS in sizes and rates.
+type unit struct {
+	suffix string
+	mult   int64
+}
+
+// parseUnits parses an integer with an optional unit suffix from the given
+// list of units.
+func parseUnits(s string, units []unit) (int64, error) {
+	i := 0
+	for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+		i++
+	}
+	if i == 0 {
+		return 0, fmt.Errorf("no number")
+	}
+	n, err := strconv.ParseInt(s[:i], 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	suffix := s[i:]
+	if suffix == "" {
+		return n, nil
+	}
+	for _, u := range units {
+		if u.suffix == suffix {
+			return n * u.mult, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown unit %q", suffix)
+}
+
+// formatUnits formats an integer using the largest unit (from the given list,
+// sorted from largest to smallest) that divides it exactly.
+func formatUnits(n int64, units []unit) string {
+	if n != 0 {
+		for _, u := range units {
+			if n%u.mult == 0 {
+				return fmt.Sprintf("%d%s", n/u.mult, u.suffix)
+			}
+		}
+	}
+	return fmt.Sprintf("%d", n)
+}
+
+// bytesUnits are the units accepted in ROS sizes. The single-letter forms are
+// binary in ROS, too.
+var bytesUnits = []unit{
+	{"TiB", 1 << 40},
+	{"GiB", 1 << 30},
+	{"MiB", 1 << 20},
+	{"KiB", 1 << 10},
+	{"T", 1 << 40},
+	{"G", 1 << 30},
+	{"M", 1 << 20},
+	{"k", 1 << 10},
+	{"K", 1 << 10},
+}
+
+// Bytes is a ROS size in bytes, eg. 64KiB, serialized using the largest
+// binary unit that represents it exactly.
+type Bytes int64
+
+// BytesPtr returns a pointer to Bytes, for use in _Update structs.
+func BytesPtr(b int64) *Bytes {
+	v := Bytes(b)
+	return &v
+}
+
+func (n *Bytes) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	v, err := parseUnits(s, bytesUnits)
+	if err != nil {
+		return fmt.Errorf("invalid size %q: %w", s, err)
+	}
+	*n = Bytes(v)
+	return nil
+}
+
+func (n *Bytes) MarshalJSON() ([]byte, error) {
+	return []byte(fmt.Sprintf("%q", n.String())), nil
+}
+
+func (n Bytes) String() string {
+	// Only format using the unambiguous *iB forms.
+	return formatUnits(int64(n), bytesUnits[:4])
+}
+
+// rateUnits are the units accepted in ROS rates, which are decimal.
+var rateUnits = []unit{
+	{"T", 1000 * 1000 * 1000 * 1000},
+	{"G", 1000 * 1000 * 1000},
+	{"M", 1000 * 1000},
+	{"k", 1000},
+}
+
+// Rate is a ROS bit rate in bits per second, eg. 10M, serialized using the
+// largest decimal unit that represents it exactly.
+type Rate int64
+
+// RatePtr returns a pointer to Rate, for use in _Update structs.
+func RatePtr(r int64) *Rate {
+	v := Rate(r)
+	return &v
+}
+
+func (n *Rate) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	v, err := parseUnits(s, rateUnits)
+	if err != nil {
+		return fmt.Errorf("invalid rate %q: %w", s, err)
+	}
+	*n = Rate(v)
+	return nil
+}
+
+func (n *Rate) MarshalJSON() ([]byte, error) {
+	return []byte(fmt.Sprintf("%q", n.String())), nil
+}
+
+func (n Rate) String() string {
+	return formatUnits(int64(n), rateUnits)
+}
+
+// RatePair is a ROS upload/download pair of bit rates, eg. 10M/20M, as used by
+// simple queue limits.
+type RatePair struct {
+	Upload   Rate
+	Download Rate
+}
+
+// ParseRatePair parses a ROS-style upload/download pair of rates.
+func ParseRatePair(s string) (*RatePair, error) {
+	i := strings.Index(s, "/")
+	if i == -1 {
+		return nil, fmt.Errorf("invalid rate pair %q", s)
+	}
+	up, err := parseUnits(s[:i], rateUnits)
+	if err != nil {
+		return nil, fmt.Errorf("invalid rate pair %q: %w", s, err)
+	}
+	down, err := parseUnits(s[i+1:], rateUnits)
+	if err != nil {
+		return nil, fmt.Errorf("invalid rate pair %q: %w", s, err)
+	}
+	return &RatePair{Upload: Rate(up), Download: Rate(down)}, nil
+}
+
+// RatePairPtr returns a pointer to RatePair, for use in _Update structs.
+func RatePairPtr(upload, download int64) *RatePair {
+	return &RatePair{Upload: Rate(upload), Download: Rate(download)}
+}
+
+func (n *RatePair) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	parsed, err := ParseRatePair(s)
+	if err != nil {
+		return err
+	}
+	*n = *parsed
+	return nil
+}
+
+func (n *RatePair) MarshalJSON() ([]byte, error) {
+	return []byte(fmt.Sprintf("%q", n.String())), nil
+}
+
+func (n RatePair) String() string {
+	return n.Upload.String() + "/" + n.Download.String()
+}
+
 // StringList is a ROS7 list of strings, eg. interfaces, (de)serialized as a
 // string containing comma-delimited values.
 type StringList []string
@@ -163,6 +762,20 @@ func (n *StringList) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
+// Equal returns whether both lists contain the same elements in the same
+// order.
+func (n StringList) Equal(o StringList) bool {
+	if len(n) != len(o) {
+		return false
+	}
+	for i := range n {
+		if n[i] != o[i] {
+			return false
+		}
+	}
+	return true
+}
+
 func (n *StringList) MarshalJSON() ([]byte, error) {
 	for i, el := range *n {
 		if strings.Contains(el, ",") || strings.Contains(el, `"`) {
@@ -335,6 +948,24 @@ func (n *NumberList) Contains(v int64) bool {
 	return false
 }
 
+// Equal returns whether both lists contain the same numbers, regardless of how
+// they are split into ranges.
+func (n NumberList) Equal(o NumberList) bool {
+	a := NumberList{ranges: append([]numberListRange(nil), n.ranges...)}
+	b := NumberList{ranges: append([]numberListRange(nil), o.ranges...)}
+	a.optimize()
+	b.optimize()
+	if len(a.ranges) != len(b.ranges) {
+		return false
+	}
+	for i := range a.ranges {
+		if a.ranges[i] != b.ranges[i] {
+			return false
+		}
+	}
+	return true
+}
+
 func (n *NumberList) String() string {
 	var parts []string
 	for _, r := range n.ranges {
@@ -352,3 +983,180 @@ func (n *NumberList) String() string {
 func (n *NumberList) MarshalJSON() ([]byte, error) {
 	return []byte(fmt.Sprintf("%q", n.String())), nil
 }
+
+// PortList is a ROS list of ports and port ranges, eg. 22,80,8000-8080, as
+// used by firewall matchers. It can be negated with a ! prefix, eg. !22, to
+// match all other ports.
+type PortList struct {
+	NumberList
+	// Not negates the list, ie. it matches all ports not in it.
+	Not bool
+}
+
+// ParsePortList parses a ROS-style list of ports, eg. 22,8000-8080 or !22.
+func ParsePortList(s string) (*PortList, error) {
+	var res PortList
+	if strings.HasPrefix(s, "!") {
+		res.Not = true
+		s = s[1:]
+	}
+	nl, err := ParseNumberList(s)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range nl.ranges {
+		if r.lower < 0 || r.upper > 65535 {
+			return nil, fmt.Errorf("invalid port range %d-%d", r.lower, r.upper)
+		}
+	}
+	res.NumberList = *nl
+	return &res, nil
+}
+
+func (n *PortList) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	parsed, err := ParsePortList(s)
+	if err != nil {
+		return err
+	}
+	*n = *parsed
+	return nil
+}
+
+// Equal returns whether both lists contain the same ports and are negated
+// alike.
+func (n PortList) Equal(o PortList) bool {
+	return n.Not == o.Not && n.NumberList.Equal(o.NumberList)
+}
+
+func (n *PortList) String() string {
+	if n.Not {
+		return "!" + n.NumberList.String()
+	}
+	return n.NumberList.String()
+}
+
+func (n *PortList) MarshalJSON() ([]byte, error) {
+	return []byte(fmt.Sprintf("%q", n.String())), nil
+}
+
+// IPRange is an inclusive range of IPv4 or IPv6 addresses, eg.
+// 10.0.0.10-10.0.0.200. A single address has First equal to Last.
+type IPRange struct {
+	First net.IP
+	Last  net.IP
+}
+
+// ParseIPRange parses a ROS-style address range, eg. 10.0.0.10-10.0.0.200, or
+// a single address.
+func ParseIPRange(s string) (*IPRange, error) {
+	first, last := s, s
+	if i := strings.Index(s, "-"); i != -1 {
+		first, last = s[:i], s[i+1:]
+	}
+	r := IPRange{First: net.ParseIP(first), Last: net.ParseIP(last)}
+	if r.First == nil || r.Last == nil {
+		return nil, fmt.Errorf("invalid IP range %q", s)
+	}
+	if (r.First.To4() == nil) != (r.Last.To4() == nil) || bytes.Compare(r.First.To16(), r.Last.To16()) > 0 {
+		return nil, fmt.Errorf("invalid IP range %q", s)
+	}
+	return &r, nil
+}
+
+// Contains returns whether an address is within the range.
+func (r IPRange) Contains(ip net.IP) bool {
+	if (r.First.To4() == nil) != (ip.To4() == nil) {
+		return false
+	}
+	return bytes.Compare(r.First.To16(), ip.To16()) <= 0 && bytes.Compare(ip.To16(), r.Last.To16()) <= 0
+}
+
+// Equal returns whether both ranges are the same.
+func (r IPRange) Equal(o IPRange) bool {
+	return r.First.Equal(o.First) && r.Last.Equal(o.Last)
+}
+
+func (r IPRange) String() string {
+	if r.First.Equal(r.Last) {
+		return r.First.String()
+	}
+	return r.First.String() + "-" + r.Last.String()
+}
+
+// IPRangeList is a ROS list of address ranges and single addresses, eg.
+// 10.0.0.10-10.0.0.200,10.0.1.1, as used by IP pools.
+type IPRangeList []IPRange
+
+// ParseIPRangeList parses a ROS-style list of address ranges.
+func ParseIPRangeList(s string) (IPRangeList, error) {
+	var res IPRangeList
+	if s == "" {
+		return res, nil
+	}
+	for _, part := range strings.Split(s, ",") {
+		r, err := ParseIPRange(part)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, *r)
+	}
+	return res, nil
+}
+
+// IPRangeListPtr returns a pointer to IPRangeList, for use in _Update structs.
+func IPRangeListPtr(ranges ...IPRange) *IPRangeList {
+	v := IPRangeList(ranges)
+	return &v
+}
+
+func (n *IPRangeList) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	parsed, err := ParseIPRangeList(s)
+	if err != nil {
+		return err
+	}
+	*n = parsed
+	return nil
+}
+
+// Contains returns whether an address is within any of the ranges.
+func (n IPRangeList) Contains(ip net.IP) bool {
+	for _, r := range n {
+		if r.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// Equal returns whether both lists contain the same ranges in the same order.
+func (n IPRangeList) Equal(o IPRangeList) bool {
+	if len(n) != len(o) {
+		return false
+	}
+	for i := range n {
+		if !n[i].Equal(o[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (n IPRangeList) String() string {
+	parts := make([]string, len(n))
+	for i, r := range n {
+		parts[i] = r.String()
+	}
+	return strings.Join(parts, ",")
+}
+
+func (n *IPRangeList) MarshalJSON() ([]byte, error) {
+	return json.Marshal(n.String())
+}
@@ -3,7 +3,9 @@ package ros
 import (
 	"encoding/json"
 	"net"
+	"reflect"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 )
@@ -160,3 +162,228 @@ func TestNumberList(t *testing.T) {
 		t.Errorf("serialized range should be %q, got %q", want2, got2)
 	}
 }
+
+// TestPortList ensures port lists keep their negation, and refuse ports out
+// of range.
+func TestPortList(t *testing.T) {
+	var got PortList
+	if err := got.UnmarshalJSON([]byte(`"!22,8000-8080"`)); err != nil {
+		t.Fatalf("UnmarshalJSON: %v", err)
+	}
+	if !got.Not {
+		t.Errorf("list should be negated")
+	}
+	if !got.Contains(8080) || got.Contains(80) {
+		t.Errorf("unexpected ports in %s", &got)
+	}
+	data, err := got.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON: %v", err)
+	}
+	if want, got := `"!22,8000-8080"`, string(data); want != got {
+		t.Errorf("wanted %s, got %s", want, got)
+	}
+
+	plain, _ := ParsePortList("22,8000-8080")
+	if got.Equal(*plain) {
+		t.Errorf("%s should not equal %s", &got, plain)
+	}
+	plain.Not = true
+	if !got.Equal(*plain) {
+		t.Errorf("%s should equal %s", &got, plain)
+	}
+
+	for _, s := range []string{"65536", "!1-70000", "-1"} {
+		if pl, err := ParsePortList(s); err == nil {
+			t.Errorf("%q: wanted error, got %s", s, pl)
+		}
+	}
+}
+
+// TestRoundTrip ensures that ROS values deserialize into the expected Go
+// values, and serialize back into their canonical ROS representation.
+func TestRoundTrip(t *testing.T) {
+	for i, te := range []struct {
+		in     string
+		target interface {
+			json.Marshaler
+			json.Unmarshaler
+		}
+		want interface{}
+		// out is the expected serialized form, if different than in.
+		out string
+	}{
+		{in: `"10.0.0.1"`, target: new(IP), want: IP(net.ParseIP("10.0.0.1"))},
+		{in: `"2a0d:eb01::1"`, target: new(IP), want: IP(net.ParseIP("2a0d:eb01::1"))},
+		{in: `"10.0.0.1/24"`, target: new(IPNet), want: IPNet{
+			Address: net.ParseIP("10.0.0.1"),
+			Network: net.IPNet{IP: net.IP{10, 0, 0, 0}, Mask: net.CIDRMask(24, 32)},
+		}},
+		{in: `"2a0d:eb01::1/64"`, target: new(IPNet), want: IPNet{
+			Address: net.ParseIP("2a0d:eb01::1"),
+			Network: net.IPNet{IP: net.ParseIP("2a0d:eb01::"), Mask: net.CIDRMask(64, 128)},
+		}},
+		{in: `"10.0.0.1"`, target: new(Gateway), want: Gateway{IP: net.ParseIP("10.0.0.1")}},
+		{in: `"ether1"`, target: new(Gateway), want: Gateway{Interface: "ether1"}},
+		{in: `"fe80::1%ether1"`, target: new(Gateway), want: Gateway{IP: net.ParseIP("fe80::1"), Interface: "ether1"}},
+		{in: `"10.0.0.1,10.0.0.2"`, target: new(GatewayList), want: GatewayList{{IP: net.ParseIP("10.0.0.1")}, {IP: net.ParseIP("10.0.0.2")}}},
+		{in: `""`, target: new(GatewayList), want: GatewayList(nil)},
+		{in: `"4C:5E:0C:01:02:03"`, target: new(MAC), want: MAC{0x4c, 0x5e, 0x0c, 0x01, 0x02, 0x03}},
+		{in: `"4c:5e:0c:01:02:0a"`, target: new(MAC), want: MAC{0x4c, 0x5e, 0x0c, 0x01, 0x02, 0x0a}, out: `"4C:5E:0C:01:02:0A"`},
+		{in: `"1w2d3h4m5s"`, target: new(Duration), want: Duration(9*24*time.Hour + 3*time.Hour + 4*time.Minute + 5*time.Second)},
+		{in: `"10ms"`, target: new(Duration), want: Duration(10 * time.Millisecond)},
+		{in: `"1s352us"`, target: new(Duration), want: Duration(time.Second + 352*time.Microsecond)},
+		{in: `"0s"`, target: new(Duration), want: Duration(0)},
+		{in: `"00:10:00"`, target: new(Duration), want: Duration(10 * time.Minute), out: `"10m"`},
+		{in: `"1d00:00:30"`, target: new(Duration), want: Duration(24*time.Hour + 30*time.Second), out: `"1d30s"`},
+		{in: `"30"`, target: new(Duration), want: Duration(30 * time.Second), out: `"30s"`},
+		{in: `"64KiB"`, target: new(Bytes), want: Bytes(64 << 10)},
+		{in: `"1M"`, target: new(Bytes), want: Bytes(1 << 20), out: `"1MiB"`},
+		{in: `"1500"`, target: new(Bytes), want: Bytes(1500)},
+		{in: `"2048KiB"`, target: new(Bytes), want: Bytes(2 << 20), out: `"2MiB"`},
+		{in: `"0"`, target: new(Bytes), want: Bytes(0)},
+		{in: `"10M"`, target: new(Rate), want: Rate(10000000)},
+		{in: `"1G"`, target: new(Rate), want: Rate(1000000000)},
+		{in: `"1500k"`, target: new(Rate), want: Rate(1500000)},
+		{in: `"64000"`, target: new(Rate), want: Rate(64000), out: `"64k"`},
+		{in: `"0"`, target: new(Rate), want: Rate(0)},
+		{in: `"10M/20M"`, target: new(RatePair), want: RatePair{Upload: 10000000, Download: 20000000}},
+		{in: `"0/1500000"`, target: new(RatePair), want: RatePair{Upload: 0, Download: 1500000}, out: `"0/1500k"`},
+		{in: `"192.168.88.0/24,10.0.0.1/32"`, target: new(IPNetList), want: IPNetList{
+			{Address: net.ParseIP("192.168.88.0"), Network: net.IPNet{IP: net.IP{192, 168, 88, 0}, Mask: net.CIDRMask(24, 32)}},
+			{Address: net.ParseIP("10.0.0.1"), Network: net.IPNet{IP: net.IP{10, 0, 0, 1}, Mask: net.CIDRMask(32, 32)}},
+		}},
+		{in: `""`, target: new(IPNetList), want: IPNetList(nil)},
+		{in: `"10.0.0.10-10.0.0.200,10.0.1.1"`, target: new(IPRangeList), want: IPRangeList{
+			{First: net.ParseIP("10.0.0.10"), Last: net.ParseIP("10.0.0.200")},
+			{First: net.ParseIP("10.0.1.1"), Last: net.ParseIP("10.0.1.1")},
+		}},
+		{in: `"2001:db8::10-2001:db8::ff"`, target: new(IPRangeList), want: IPRangeList{
+			{First: net.ParseIP("2001:db8::10"), Last: net.ParseIP("2001:db8::ff")},
+		}},
+	} {
+		if err := te.target.UnmarshalJSON([]byte(te.in)); err != nil {
+			t.Errorf("%d: unmarshal %s: %v", i, te.in, err)
+			continue
+		}
+		got := reflect.ValueOf(te.target).Elem().Interface()
+		if diff := cmp.Diff(te.want, got); diff != "" {
+			t.Errorf("%d: unmarshal %s: diff: %s", i, te.in, diff)
+		}
+		gotBytes, err := te.target.MarshalJSON()
+		if err != nil {
+			t.Errorf("%d: marshal: %v", i, err)
+			continue
+		}
+		want := te.out
+		if want == "" {
+			want = te.in
+		}
+		if got := string(gotBytes); want != got {
+			t.Errorf("%d: marshal: wanted %s, got %s", i, want, got)
+		}
+	}
+}
+
+// TestInvalid ensures that invalid ROS values are rejected.
+func TestInvalid(t *testing.T) {
+	for i, te := range []struct {
+		in     string
+		target json.Unmarshaler
+	}{
+		{`"10.0.0.256"`, new(IP)},
+		{`"10.0.0.1"`, new(IPNet)},
+		{`"4C:5E:0C"`, new(MAC)},
+		{`""`, new(Duration)},
+		{`"1y"`, new(Duration)},
+		{`"10:00"`, new(Duration)},
+		{`"ms"`, new(Duration)},
+		{`"64KB"`, new(Bytes)},
+		{`"10Mbps"`, new(Rate)},
+		{`"M"`, new(Rate)},
+		{`"10.0.0.200-10.0.0.10"`, new(IPRangeList)},
+		{`"10.0.0.1-2001:db8::1"`, new(IPRangeList)},
+		{`"10.0.0.1,"`, new(IPRangeList)},
+		{`"10M"`, new(RatePair)},
+		{`"10M/20Mbps"`, new(RatePair)},
+		{`"10.0.0.1"`, new(IPNetList)},
+		{`"10.0.0.0/24,"`, new(IPNetList)},
+	} {
+		if err := te.target.UnmarshalJSON([]byte(te.in)); err == nil {
+			t.Errorf("%d: unmarshal %s should have failed", i, te.in)
+		}
+	}
+}
+
+// TestEqual ensures non-comparable types compare by value.
+func TestEqual(t *testing.T) {
+	nl1, _ := ParseNumberList("1-3,10")
+	nl2, _ := ParseNumberList("10,1,2-3")
+	nl3, _ := ParseNumberList("1-3")
+	if !nl1.Equal(*nl2) {
+		t.Errorf("%s should equal %s", nl1, nl2)
+	}
+	if nl1.Equal(*nl3) {
+		t.Errorf("%s should not equal %s", nl1, nl3)
+	}
+	if !IP(net.ParseIP("10.0.0.1")).Equal(IP(net.IPv4(10, 0, 0, 1))) {
+		t.Errorf("IPv4 forms should be equal")
+	}
+	n1, _ := ParseIPNet("10.0.0.1/24")
+	n2, _ := ParseIPNet("10.0.0.1/25")
+	if !n1.Equal(*n1) || n1.Equal(*n2) {
+		t.Errorf("IPNet comparison failed")
+	}
+	if StringList([]string{"a", "b"}).Equal(StringList{"b", "a"}) {
+		t.Errorf("StringList comparison should be ordered")
+	}
+	r1, _ := ParseIPRangeList("10.0.0.1-10.0.0.9")
+	r2 := IPRangeList{{First: net.IPv4(10, 0, 0, 1), Last: net.IPv4(10, 0, 0, 9)}}
+	if !r1.Equal(r2) || r1.Equal(IPRangeList{r2[0], r2[0]}) {
+		t.Errorf("IPRangeList comparison failed")
+	}
+	g1, _ := ParseGateway("10.0.0.1")
+	g2, _ := ParseGateway("10.0.0.1%ether1")
+	if !g1.Equal(*GatewayPtr(net.IPv4(10, 0, 0, 1), "")) || g1.Equal(*g2) {
+		t.Errorf("Gateway comparison failed")
+	}
+	l1, _ := ParseGatewayList("10.0.0.1,10.0.0.2")
+	l2, _ := ParseGatewayList("10.0.0.2,10.0.0.1")
+	if !l1.Equal(GatewayList{*g1, *GatewayPtr(net.IPv4(10, 0, 0, 2), "")}) || l1.Equal(l2) || l1.Equal(l1[:1]) {
+		t.Errorf("GatewayList comparison failed")
+	}
+}
+
+// TestIPRangeList ensures addresses are matched against ranges of their own
+// family only.
+func TestIPRangeList(t *testing.T) {
+	l, err := ParseIPRangeList("10.0.0.10-10.0.0.200,10.0.1.1,::-::ffff")
+	if err != nil {
+		t.Fatalf("ParseIPRangeList: %v", err)
+	}
+	for _, te := range []struct {
+		ip   string
+		want bool
+	}{
+		{"10.0.0.10", true},
+		{"10.0.0.200", true},
+		{"10.0.0.201", false},
+		{"10.0.1.1", true},
+		{"::1", true},
+		{"::ffff:10.0.0.100", true},
+		{"0.0.0.1", false},
+	} {
+		if got := l.Contains(net.ParseIP(te.ip)); got != te.want {
+			t.Errorf("Contains(%s): wanted %v, got %v", te.ip, te.want, got)
+		}
+	}
+}
+
+// TestParseGateway ensures invalid gateways are refused.
+func TestParseGateway(t *testing.T) {
+	for _, s := range []string{"", "%ether1", "10.0.0.1%", "foo%ether1"} {
+		if g, err := ParseGateway(s); err == nil {
+			t.Errorf("%q: wanted error, got %+v", s, g)
+		}
+	}
+}
@@ -1,6 +1,7 @@
 // Package ros implements a Mikrotik RouterOS 7 REST API client. It's a very
 // thin wrapper, delivering no high-level logic upon the basic CRUD capability
-// of the API.
+// of the API. The same client can also talk to the binary API (port 8728 and
+// 8729) using APITransport.
 //
 // For more information about the API see:
 // https://help.mikrotik.com/docs/display/ROS/REST+API
@@ -9,60 +10,253 @@ package ros
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"net/http"
+	"net/url"
+	"strings"
+	"sync"
 )
 
-// Client is a ROS7 REST API client. It connects to a ROS www-ssl service
-// at the given Address, authenticating using the given Username and Password.
+// Client is a ROS7 API client. By default it connects to a ROS www-ssl
+// service at the given Address, authenticating using the given Credentials,
+// or Username and Password. Alternatively, a different Transport can be used,
+// eg. APITransport for the binary API.
 type Client struct {
 	// Address (like foo.example.com or 1.2.3.4:1234 or [2a0d:eb01::1]:443) of
 	// ROS7 www-ssl service.
 	Address string
-	// Username used to authenticate to ROS API.
+	// Username used to authenticate to ROS API, if Credentials is not set.
 	Username string
-	// Password used to authenticate to ROS API.
+	// Password used to authenticate to ROS API, if Credentials is not set.
 	Password string
+	// Credentials used to authenticate to ROS API. These are retrieved for
+	// every request and sent in an Authorization header.
+	Credentials Credentials
 	// HTTP client used for connections. If not set, uses http.DefaultClient.
 	// If connecting to a ROS7 device whose certificate was generated via
 	// built-in Let's Encrypt support, this should be set to LetsEncryptClient
-	// to add trust for the Let's Encrypt R3 CA.
+	// to add trust for the Let's Encrypt CAs (see LetsEncryptPEM).
 	HTTP *http.Client
+	// Pins are fingerprints (see ParseFingerprint) of the device's
+	// certificate, eg. of the self-signed www-ssl certificate, or of a CA
+	// certificate it presents and is signed by. If set, any certificate not
+	// matching them is refused, and other CAs are not checked. Changes to
+	// Pins or KnownHosts take effect on the next request.
+	Pins []string
+	// KnownHosts, if set, records the fingerprint of the device's
+	// certificate on first connection, and refuses certificates with a
+	// different fingerprint afterwards. CAs are not checked.
+	KnownHosts *KnownHosts
+	// Transport used to carry requests to ROS. If not set, the REST API is
+	// used, as configured by the above fields. Otherwise, the above fields
+	// are ignored.
+	Transport Transport
+
+	// Version of ROS running on the device, eg. 7.15.2. Menus and properties
+	// not supported by this version (as declared in gen/types.text.pb) are
+	// refused or left out of requests, see ErrUnsupportedOnVersion. If not
+	// set, the version is retrieved from system/resource the first time a
+	// version-dependent menu or property is used.
+	Version string
+	// StrictVersion, if set, makes the client refuse updates which set
+	// properties unsupported by the device's ROS version, instead of leaving
+	// them out.
+	StrictVersion bool
+	// Retry, if set, configures retrying of failed requests. Otherwise,
+	// requests are not retried.
+	Retry *RetryPolicy
+	// Interceptors wrap every request made to the device (including every
+	// retry attempt), in order, eg. for logging (Logger) or metrics
+	// (Metrics).
+	Interceptors []Interceptor
+
+	versionMu sync.Mutex
+	version   *Version
+
+	httpMu        sync.Mutex
+	pinnedHTTP    *http.Client
+	pinnedHTTPKey pinnedHTTPKey
+}
+
+// Request is a request to ROS, independent of the Transport used to carry it.
+type Request struct {
+	// Method is the REST method of the request: GET, PUT, PATCH, DELETE or
+	// POST.
+	Method string
+	// Menu is the path of the menu the request concerns, eg.
+	// interface/bridge/vlan. Empty for the root menu.
+	Menu string
+	// ID is the ID of the record the request concerns, if any.
+	ID RecordID
+	// Command is the command run by a POST request, eg. print or reboot.
+	Command string
+	// Query are the URL query parameters of a GET request, ie. equality
+	// filters and .proplist.
+	Query url.Values
+	// Body is the JSON body of the request, if any. Its values are
+	// serialized ROS values, ie. strings, with the exception of .proplist and
+	// .query in print requests.
+	Body []byte
+}
+
+// Path returns the REST path of the request, eg. interface/bridge/vlan/*1 or
+// system/reboot.
+func (r *Request) Path() string {
+	var parts []string
+	for _, p := range []string{r.Menu, string(r.ID), r.Command} {
+		if p != "" {
+			parts = append(parts, p)
+		}
+	}
+	return strings.Join(parts, "/")
+}
+
+// Transport carries Requests to a ROS device. The default transport used by
+// Client is the REST API, while APITransport implements the binary API.
+type Transport interface {
+	// Do performs the request, returning a JSON response body as the REST
+	// API would return it. Errors returned by ROS must be (or wrap)
+	// *APIError.
+	Do(ctx context.Context, req *Request) (io.ReadCloser, error)
 }
 
 func (c *Client) urlFor(path string) string {
-	return fmt.Sprintf("https://%s:%s@%s/rest/%s", c.Username, c.Password, c.Address, path)
+	return fmt.Sprintf("https://%s/rest/%s", c.Address, path)
 }
 
-func (c *Client) httpClient() *http.Client {
+func (c *Client) credentials() Credentials {
+	if c.Credentials == nil {
+		return &StaticCredentials{
+			Username: c.Username,
+			Password: c.Password,
+		}
+	}
+	return c.Credentials
+}
+
+func (c *Client) httpClient() (*http.Client, error) {
+	if len(c.Pins) > 0 || c.KnownHosts != nil {
+		return c.pinnedHTTPClient()
+	}
 	if c.HTTP == nil {
-		return http.DefaultClient
+		return http.DefaultClient, nil
+	}
+	return c.HTTP, nil
+}
+
+// do performs a request after checking it against the device's ROS version,
+// retrying it if configured.
+func (c *Client) do(ctx context.Context, req *Request) (io.ReadCloser, error) {
+	if err := c.gate(ctx, req); err != nil {
+		return nil, err
+	}
+	if c.Retry != nil {
+		return c.doRetry(ctx, req)
+	}
+	return c.transport(ctx, req)
+}
+
+// transport performs a request using the configured Transport, or the REST API
+// if not set, through the configured Interceptors.
+func (c *Client) transport(ctx context.Context, req *Request) (io.ReadCloser, error) {
+	var h Handler = c.doREST
+	if c.Transport != nil {
+		h = c.Transport.Do
+	}
+	device := c.device()
+	for i := len(c.Interceptors) - 1; i >= 0; i-- {
+		ic, next := c.Interceptors[i], h
+		h = func(ctx context.Context, req *Request) (io.ReadCloser, error) {
+			return ic(ctx, device, req, next)
+		}
+	}
+	return h(ctx, req)
+}
+
+// device returns the address of the device, as passed to Interceptors.
+func (c *Client) device() string {
+	if t, ok := c.Transport.(*APITransport); ok && c.Address == "" {
+		return t.Address
 	}
-	return c.HTTP
+	return c.Address
 }
 
-func (c *Client) doGET(ctx context.Context, path string) (io.ReadCloser, error) {
-	req, err := http.NewRequestWithContext(ctx, "GET", c.urlFor(path), nil)
+// doREST performs a request against the REST API, returning the response body
+// if successful, or an APIError if ROS responded with a non-2xx status.
+func (c *Client) doREST(ctx context.Context, r *Request) (io.ReadCloser, error) {
+	var rbody io.Reader
+	if r.Body != nil {
+		rbody = bytes.NewBuffer(r.Body)
+	}
+	path := r.Path()
+	url := c.urlFor(path)
+	if len(r.Query) > 0 {
+		url += "?" + r.Query.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, r.Method, url, rbody)
+	if err != nil {
+		return nil, fmt.Errorf("could not make %s request: %w", r.Method, err)
+	}
+	if r.Body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	username, password, err := c.credentials().Credentials(ctx, c.Address)
+	if err != nil {
+		return nil, fmt.Errorf("could not get credentials: %w", err)
+	}
+	req.SetBasicAuth(username, password)
+	hc, err := c.httpClient()
 	if err != nil {
-		return nil, fmt.Errorf("could not make GET request: %w", err)
+		return nil, err
 	}
-	resp, err := c.httpClient().Do(req)
+	resp, err := hc.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("when running REST request: %w", err)
 	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		defer resp.Body.Close()
+		return nil, apiErrorFromResponse(r.Method, path, resp)
+	}
 	return resp.Body, nil
 }
 
-func (c *Client) doPATCH(ctx context.Context, path string, rdata []byte) (io.ReadCloser, error) {
-	rbuf := bytes.NewBuffer(rdata)
-	req, err := http.NewRequestWithContext(ctx, "PATCH", c.urlFor(path), rbuf)
+func (c *Client) doGET(ctx context.Context, menu string, id RecordID, query url.Values) (io.ReadCloser, error) {
+	return c.do(ctx, &Request{Method: "GET", Menu: menu, ID: id, Query: query})
+}
+
+func (c *Client) doPATCH(ctx context.Context, menu string, id RecordID, rdata []byte) (io.ReadCloser, error) {
+	return c.do(ctx, &Request{Method: "PATCH", Menu: menu, ID: id, Body: rdata})
+}
+
+func (c *Client) doPUT(ctx context.Context, menu string, rdata []byte) (io.ReadCloser, error) {
+	return c.do(ctx, &Request{Method: "PUT", Menu: menu, Body: rdata})
+}
+
+func (c *Client) doDELETE(ctx context.Context, menu string, id RecordID) (io.ReadCloser, error) {
+	return c.do(ctx, &Request{Method: "DELETE", Menu: menu, ID: id})
+}
+
+func (c *Client) doPOST(ctx context.Context, menu, command string, rdata []byte) (io.ReadCloser, error) {
+	return c.do(ctx, &Request{Method: "POST", Menu: menu, Command: command, Body: rdata})
+}
+
+// decodeRows decodes a command reply into target, which must be a pointer to a
+// slice. ROS returns either a list of rows, a single row, or nothing at all,
+// depending on the command.
+func decodeRows(r io.Reader, target interface{}) error {
+	data, err := ioutil.ReadAll(r)
 	if err != nil {
-		return nil, fmt.Errorf("could not make GET request: %w", err)
+		return err
 	}
-	resp, err := c.httpClient().Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("when running REST request: %w", err)
+	data = bytes.TrimSpace(data)
+	switch {
+	case len(data) == 0:
+		return nil
+	case data[0] == '{':
+		data = append(append([]byte{'['}, data...), ']')
 	}
-	return resp.Body, nil
+	return json.Unmarshal(data, target)
 }
@@ -0,0 +1,200 @@
+package ros
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// testClient returns a Client connected to a test HTTPS server running the
+// given handler.
+func testClient(t *testing.T, h http.HandlerFunc) *Client {
+	t.Helper()
+	srv := httptest.NewTLSServer(h)
+	t.Cleanup(srv.Close)
+	return &Client{
+		Address:  strings.TrimPrefix(srv.URL, "https://"),
+		Username: "admin",
+		Password: "hunter2",
+		HTTP:     srv.Client(),
+	}
+}
+
+// TestAPIError ensures non-2xx responses are turned into APIErrors
+// distinguishable by the Is* helpers.
+func TestAPIError(t *testing.T) {
+	ctx := context.Background()
+	c := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/rest/interface/bridge/vlan/*1":
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error":404,"message":"Not Found"}`))
+		case "/rest/interface/bridge/vlan":
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"error":400,"message":"Bad Request","detail":"failure: vlan already added"}`))
+		default:
+			w.WriteHeader(http.StatusUnauthorized)
+		}
+	})
+
+	_, err := c.InterfaceBridgeVlanGet(ctx, "*1")
+	if !IsNotFound(err) {
+		t.Errorf("Get should have returned not found, got %v", err)
+	}
+	var aerr *APIError
+	if !errors.As(err, &aerr) {
+		t.Fatalf("Get should have returned APIError, got %v", err)
+	}
+	if want, got := "interface/bridge/vlan/*1", aerr.Path; want != got {
+		t.Errorf("wanted path %q, got %q", want, got)
+	}
+
+	_, err = c.InterfaceBridgeVlanAdd(ctx, &InterfaceBridgeVlan_Update{})
+	if !IsBadRequest(err) {
+		t.Errorf("Add should have returned bad request, got %v", err)
+	}
+	if !errors.As(err, &aerr) {
+		t.Fatalf("Add should have returned APIError, got %v", err)
+	}
+	if want, got := "failure: vlan already added", aerr.Detail; want != got {
+		t.Errorf("wanted detail %q, got %q", want, got)
+	}
+
+	_, err = c.InterfaceBridgePortList(ctx)
+	if !IsUnauthorized(err) {
+		t.Errorf("List should have returned unauthorized, got %v", err)
+	}
+	if IsNotFound(err) || IsBadRequest(err) {
+		t.Errorf("List error should only be unauthorized, got %v", err)
+	}
+}
+
+// TestListOptions ensures List options and Find filters are sent to ROS as
+// query parameters and print queries respectively.
+func TestListOptions(t *testing.T) {
+	ctx := context.Background()
+	var gotQuery, gotBody string
+	c := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		gotQuery = r.URL.RawQuery
+		body, _ := ioutil.ReadAll(r.Body)
+		gotBody = string(body)
+		w.Write([]byte(`[{".id":"*1","vlan-ids":"3005"}]`))
+	})
+
+	res, err := c.InterfaceBridgeVlanListWith(ctx, &InterfaceBridgeVlan_ListOptions{
+		Filter: &InterfaceBridgeVlan_Filter{
+			Bridge:   StringPtr("bridge1"),
+			Disabled: BooleanPtr(false),
+		},
+		Proplist: []InterfaceBridgeVlan_Field{
+			InterfaceBridgeVlan_FieldID,
+			InterfaceBridgeVlan_FieldVlanIDs,
+		},
+	})
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if want, got := ".proplist=.id%2Cvlan-ids&bridge=bridge1&disabled=false", gotQuery; want != got {
+		t.Errorf("wanted query %q, got %q", want, got)
+	}
+	if len(res) != 1 || res[0].ID != "*1" || res[0].VlanIDs != 3005 {
+		t.Errorf("unexpected List result %+v", res)
+	}
+
+	_, err = c.InterfaceBridgeVlanFind(ctx, &InterfaceBridgeVlan_Filter{
+		VlanIDs:  NumberPtr(3005),
+		Disabled: BooleanPtr(false),
+	}, InterfaceBridgeVlan_FieldID)
+	if err != nil {
+		t.Fatalf("Find: %v", err)
+	}
+	if want, got := `{".proplist":[".id"],".query":["disabled=false","vlan-ids=3005"]}`, gotBody; want != got {
+		t.Errorf("wanted body %q, got %q", want, got)
+	}
+}
+
+// TestCommand ensures commands are POSTed with their arguments, and that both
+// single-row and multi-row replies are decoded.
+func TestCommand(t *testing.T) {
+	ctx := context.Background()
+	var gotPath, gotBody string
+	c := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		body, _ := ioutil.ReadAll(r.Body)
+		gotBody = string(body)
+		switch r.URL.Path {
+		case "/rest/ping":
+			w.Write([]byte(`[{"seq":"0","host":"10.0.0.1","time":"1ms"},{"seq":"1","host":"10.0.0.1","sent":"2","received":"2","packet-loss":"0"}]`))
+		case "/rest/system/package/update/check-for-updates":
+			w.Write([]byte(`{"channel":"stable","installed-version":"7.1","latest-version":"7.1.1"}`))
+		default:
+			w.Write([]byte(`[]`))
+		}
+	})
+
+	rows, err := c.Ping(ctx, &Ping_Args{
+		Address: StringPtr("10.0.0.1"),
+		Count:   NumberPtr(2),
+	})
+	if err != nil {
+		t.Fatalf("Ping: %v", err)
+	}
+	if want, got := `{"address":"10.0.0.1","count":"2"}`, gotBody; want != got {
+		t.Errorf("wanted body %q, got %q", want, got)
+	}
+	if len(rows) != 2 || rows[1].Sent != 2 || rows[1].Received != 2 {
+		t.Errorf("unexpected Ping reply %+v", rows)
+	}
+
+	updates, err := c.SystemPackageUpdateCheckForUpdates(ctx)
+	if err != nil {
+		t.Fatalf("CheckForUpdates: %v", err)
+	}
+	if len(updates) != 1 || updates[0].LatestVersion != "7.1.1" {
+		t.Errorf("unexpected CheckForUpdates reply %+v", updates)
+	}
+
+	if err := c.SystemReboot(ctx); err != nil {
+		t.Fatalf("Reboot: %v", err)
+	}
+	if want, got := "/rest/system/reboot", gotPath; want != got {
+		t.Errorf("wanted path %q, got %q", want, got)
+	}
+}
+
+// TestSingleton ensures singleton records are read with a plain GET and
+// updated through set.
+func TestSingleton(t *testing.T) {
+	ctx := context.Background()
+	var gotMethod, gotPath, gotBody string
+	c := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		gotMethod = r.Method
+		gotPath = r.URL.Path
+		body, _ := ioutil.ReadAll(r.Body)
+		gotBody = string(body)
+		if r.Method == "GET" {
+			w.Write([]byte(`{"name":"core-sw1"}`))
+		} else {
+			w.Write([]byte(`[]`))
+		}
+	})
+
+	id, err := c.SystemIdentityGet(ctx)
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if want, got := "core-sw1", id.Name; want != got {
+		t.Errorf("wanted name %q, got %q", want, got)
+	}
+
+	if err := c.SystemIdentitySet(ctx, &SystemIdentity_Update{Name: StringPtr("core-sw2")}); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+	if gotMethod != "POST" || gotPath != "/rest/system/identity/set" || gotBody != `{"name":"core-sw2"}` {
+		t.Errorf("unexpected Set request %s %s %s", gotMethod, gotPath, gotBody)
+	}
+}
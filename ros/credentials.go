@@ -0,0 +1,220 @@
+package ros
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Credentials provide the username and password used to authenticate to ROS.
+// They are retrieved for every request, so implementations can rotate them
+// without the Client having to be recreated.
+type Credentials interface {
+	// Credentials returns the username and password to use when connecting
+	// to the ROS device at the given address (as in Client.Address).
+	Credentials(ctx context.Context, address string) (username, password string, err error)
+}
+
+// StaticCredentials are Credentials which never change.
+type StaticCredentials struct {
+	Username string
+	Password string
+}
+
+func (s *StaticCredentials) Credentials(_ context.Context, _ string) (string, string, error) {
+	return s.Username, s.Password, nil
+}
+
+// EnvCredentials are Credentials read from environment variables on every
+// request.
+type EnvCredentials struct {
+	// UsernameVar is the name of the environment variable containing the
+	// username. If not set, ROS7API_USERNAME is used.
+	UsernameVar string
+	// PasswordVar is the name of the environment variable containing the
+	// password. If not set, ROS7API_PASSWORD is used.
+	PasswordVar string
+}
+
+func (e *EnvCredentials) Credentials(_ context.Context, _ string) (string, string, error) {
+	uvar := e.UsernameVar
+	if uvar == "" {
+		uvar = "ROS7API_USERNAME"
+	}
+	pvar := e.PasswordVar
+	if pvar == "" {
+		pvar = "ROS7API_PASSWORD"
+	}
+	username, ok := os.LookupEnv(uvar)
+	if !ok {
+		return "", "", fmt.Errorf("environment variable %s not set", uvar)
+	}
+	password, ok := os.LookupEnv(pvar)
+	if !ok {
+		return "", "", fmt.Errorf("environment variable %s not set", pvar)
+	}
+	return username, password, nil
+}
+
+// watchedFile is a file which is parsed on first use and then re-read and
+// re-parsed whenever its modification time or size changes.
+type watchedFile struct {
+	mu      sync.Mutex
+	mtime   time.Time
+	size    int64
+	parsed  interface{}
+	loadErr error
+}
+
+// get returns the parsed contents of the file at path, re-parsing it with
+// parse if it changed since the last call.
+func (w *watchedFile) get(path string, parse func([]byte) (interface{}, error)) (interface{}, error) {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if (w.parsed != nil || w.loadErr != nil) && fi.ModTime().Equal(w.mtime) && fi.Size() == w.size {
+		return w.parsed, w.loadErr
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	w.mtime = fi.ModTime()
+	w.size = fi.Size()
+	w.parsed, w.loadErr = parse(data)
+	return w.parsed, w.loadErr
+}
+
+// FileCredentials are Credentials read from a file containing a single
+// username:password line. The file is re-read whenever it changes, so
+// passwords can be rotated by rewriting it.
+type FileCredentials struct {
+	// Path to the credentials file.
+	Path string
+
+	file watchedFile
+}
+
+func (f *FileCredentials) Credentials(_ context.Context, _ string) (string, string, error) {
+	res, err := f.file.get(f.Path, func(data []byte) (interface{}, error) {
+		line := strings.TrimRight(string(data), "\r\n")
+		if strings.Contains(line, "\n") {
+			return nil, fmt.Errorf("more than one line")
+		}
+		parts := strings.SplitN(line, ":", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("not in username:password format")
+		}
+		return &StaticCredentials{Username: parts[0], Password: parts[1]}, nil
+	})
+	if err != nil {
+		return "", "", fmt.Errorf("could not read credentials from %s: %w", f.Path, err)
+	}
+	s := res.(*StaticCredentials)
+	return s.Username, s.Password, nil
+}
+
+// NetrcCredentials are Credentials looked up by device host in a netrc file
+// (machine/login/password entries, with an optional default entry). The file
+// is re-read whenever it changes.
+type NetrcCredentials struct {
+	// Path to the netrc file. If not set, ~/.netrc is used.
+	Path string
+
+	file watchedFile
+}
+
+func (n *NetrcCredentials) Credentials(_ context.Context, address string) (string, string, error) {
+	path := n.Path
+	if path == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", "", fmt.Errorf("could not find home directory: %w", err)
+		}
+		path = filepath.Join(home, ".netrc")
+	}
+	res, err := n.file.get(path, func(data []byte) (interface{}, error) {
+		return parseNetrc(data)
+	})
+	if err != nil {
+		return "", "", fmt.Errorf("could not read netrc %s: %w", path, err)
+	}
+	machines := res.(map[string]*StaticCredentials)
+
+	host := address
+	if h, _, err := net.SplitHostPort(address); err == nil {
+		host = h
+	}
+	for _, k := range []string{address, host, ""} {
+		if c, ok := machines[k]; ok {
+			return c.Username, c.Password, nil
+		}
+	}
+	return "", "", fmt.Errorf("no entry for %s in netrc %s", host, path)
+}
+
+// parseNetrc parses a netrc file into a map from machine name to credentials.
+// The default entry, if any, is keyed by an empty string.
+func parseNetrc(data []byte) (map[string]*StaticCredentials, error) {
+	var tokens []string
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(strings.TrimSpace(line), "#") {
+			continue
+		}
+		tokens = append(tokens, strings.Fields(line)...)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	res := make(map[string]*StaticCredentials)
+	var cur *StaticCredentials
+	for i := 0; i < len(tokens); i++ {
+		switch tokens[i] {
+		case "default":
+			cur = &StaticCredentials{}
+			res[""] = cur
+			continue
+		case "macdef":
+			// Macros run until the next empty line, which we can't see
+			// anymore. They're not used with ROS anyway.
+			return nil, fmt.Errorf("macdef is not supported")
+		}
+		if i+1 >= len(tokens) {
+			return nil, fmt.Errorf("missing value for %q", tokens[i])
+		}
+		val := tokens[i+1]
+		i++
+		switch tokens[i-1] {
+		case "machine":
+			cur = &StaticCredentials{}
+			res[val] = cur
+		case "login", "password":
+			if cur == nil {
+				return nil, fmt.Errorf("%q outside of machine entry", tokens[i-1])
+			}
+			if tokens[i-1] == "login" {
+				cur.Username = val
+			} else {
+				cur.Password = val
+			}
+		case "account":
+		default:
+			return nil, fmt.Errorf("unknown token %q", tokens[i-1])
+		}
+	}
+	return res, nil
+}
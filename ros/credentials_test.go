@@ -0,0 +1,121 @@
+package ros
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// TestAuthorizationHeader ensures credentials are sent as an Authorization
+// header and never as part of the URL.
+func TestAuthorizationHeader(t *testing.T) {
+	ctx := context.Background()
+	var gotUser, gotPass string
+	var gotOK bool
+	c := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		gotUser, gotPass, gotOK = r.BasicAuth()
+		w.Write([]byte(`[]`))
+	})
+	c.Credentials = &StaticCredentials{Username: "admin", Password: "p@ss:w/rd"}
+	if _, err := c.InterfaceBridgeVlanList(ctx); err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if !gotOK || gotUser != "admin" || gotPass != "p@ss:w/rd" {
+		t.Errorf("unexpected basic auth %v %q %q", gotOK, gotUser, gotPass)
+	}
+}
+
+func TestEnvCredentials(t *testing.T) {
+	ctx := context.Background()
+	os.Setenv("TEST_ROS_USER", "admin")
+	os.Setenv("TEST_ROS_PASS", "hunter2")
+	defer os.Unsetenv("TEST_ROS_USER")
+	defer os.Unsetenv("TEST_ROS_PASS")
+
+	e := &EnvCredentials{UsernameVar: "TEST_ROS_USER", PasswordVar: "TEST_ROS_PASS"}
+	u, p, err := e.Credentials(ctx, "router")
+	if err != nil {
+		t.Fatalf("Credentials: %v", err)
+	}
+	if u != "admin" || p != "hunter2" {
+		t.Errorf("unexpected credentials %q %q", u, p)
+	}
+
+	e = &EnvCredentials{UsernameVar: "TEST_ROS_USER", PasswordVar: "TEST_ROS_UNSET"}
+	if _, _, err := e.Credentials(ctx, "router"); err == nil {
+		t.Errorf("Credentials with unset variable should have failed")
+	}
+}
+
+// TestFileCredentials ensures file credentials are re-read when the file is
+// rotated.
+func TestFileCredentials(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "creds")
+	if err := ioutil.WriteFile(path, []byte("admin:old:pass\n"), 0600); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+	f := &FileCredentials{Path: path}
+	u, p, err := f.Credentials(ctx, "router")
+	if err != nil {
+		t.Fatalf("Credentials: %v", err)
+	}
+	if u != "admin" || p != "old:pass" {
+		t.Errorf("unexpected credentials %q %q", u, p)
+	}
+
+	if err := ioutil.WriteFile(path, []byte("admin:rotated\n"), 0600); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+	// Make sure the modification time changes even on coarse filesystems.
+	later := time.Now().Add(time.Minute)
+	if err := os.Chtimes(path, later, later); err != nil {
+		t.Fatalf("Chtimes: %v", err)
+	}
+	u, p, err = f.Credentials(ctx, "router")
+	if err != nil {
+		t.Fatalf("Credentials: %v", err)
+	}
+	if u != "admin" || p != "rotated" {
+		t.Errorf("unexpected rotated credentials %q %q", u, p)
+	}
+}
+
+func TestNetrcCredentials(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "netrc")
+	netrc := `# routers
+machine sw1.example.com login admin password sw1pass
+machine 10.0.0.1
+  login api
+  password routerpass
+default login fallback password fallbackpass
+`
+	if err := ioutil.WriteFile(path, []byte(netrc), 0600); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+	n := &NetrcCredentials{Path: path}
+	for _, te := range []struct {
+		address  string
+		username string
+		password string
+	}{
+		{"sw1.example.com", "admin", "sw1pass"},
+		{"sw1.example.com:8443", "admin", "sw1pass"},
+		{"10.0.0.1", "api", "routerpass"},
+		{"sw2.example.com", "fallback", "fallbackpass"},
+	} {
+		u, p, err := n.Credentials(ctx, te.address)
+		if err != nil {
+			t.Errorf("%s: Credentials: %v", te.address, err)
+			continue
+		}
+		if u != te.username || p != te.password {
+			t.Errorf("%s: wanted %q %q, got %q %q", te.address, te.username, te.password, u, p)
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package ros
+
+import (
+	"log"
+	"strings"
+	"sync"
+)
+
+// UnknownEnumHandler is called whenever an enum value not known to this
+// library is deserialized or serialized, eg. when talking to a newer ROS
+// version. The value is kept as-is in both directions. The default handler
+// logs every distinct unknown value once. It can be replaced (or set to nil to
+// silence reports) before any Client is used.
+var UnknownEnumHandler func(typ, value string) = logUnknownEnum
+
+// unknownEnum is an enum value reported by logUnknownEnum.
+type unknownEnum struct {
+	typ, value string
+}
+
+var (
+	unknownEnumSeenMu sync.Mutex
+	unknownEnumSeen   = make(map[unknownEnum]bool)
+)
+
+func logUnknownEnum(typ, value string) {
+	k := unknownEnum{typ: typ, value: value}
+	unknownEnumSeenMu.Lock()
+	seen := unknownEnumSeen[k]
+	unknownEnumSeen[k] = true
+	unknownEnumSeenMu.Unlock()
+	if !seen {
+		log.Printf("ros: unknown %s value %q, keeping as-is", typ, value)
+	}
+}
+
+func reportUnknownEnum(typ, value string) {
+	if h := UnknownEnumHandler; h != nil {
+		h(typ, value)
+	}
+}
+
+// parseEnumList splits a serialized enum list, eg. !established,related, into
+// its values and whether it's negated.
+func parseEnumList(s string) (not bool, values []string) {
+	if strings.HasPrefix(s, "!") {
+		not = true
+		s = s[1:]
+	}
+	if s == "" {
+		return not, nil
+	}
+	return not, strings.Split(s, ",")
+}
+
+// formatEnumList serializes an enum list, the reverse of parseEnumList.
+func formatEnumList(not bool, values []string) string {
+	s := strings.Join(values, ",")
+	if not {
+		s = "!" + s
+	}
+	return s
+}
@@ -0,0 +1,143 @@
+package ros
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// TestEnum ensures generated enums keep and report unknown values when
+// deserializing and serializing.
+func TestEnum(t *testing.T) {
+	var reported []string
+	UnknownEnumHandler = func(typ, value string) {
+		reported = append(reported, typ+"="+value)
+	}
+	defer func() {
+		UnknownEnumHandler = logUnknownEnum
+	}()
+
+	var port InterfaceBridgePort
+	if err := json.Unmarshal([]byte(`{"edge":"auto","learn":"sometimes"}`), &port); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if want, got := InterfaceBridgePort_EdgeAuto, port.Edge; want != got {
+		t.Errorf("wanted edge %q, got %q", want, got)
+	}
+	if !port.Edge.IsKnown() {
+		t.Errorf("edge %q should be known", port.Edge)
+	}
+	if want, got := InterfaceBridgePort_Learn("sometimes"), port.Learn; want != got {
+		t.Errorf("wanted learn %q, got %q", want, got)
+	}
+	if port.Learn.IsKnown() {
+		t.Errorf("learn %q should not be known", port.Learn)
+	}
+	if want, got := []string{"InterfaceBridgePort_Learn=sometimes"}, reported; len(got) != 1 || want[0] != got[0] {
+		t.Errorf("wanted reports %v, got %v", want, got)
+	}
+
+	reported = nil
+	data, err := json.Marshal(&InterfaceBridgePort_Update{Edge: &port.Edge, Learn: &port.Learn})
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	if want, got := `{"edge":"auto","learn":"sometimes"}`, string(data); want != got {
+		t.Errorf("wanted %s, got %s", want, got)
+	}
+	if want, got := []string{"InterfaceBridgePort_Learn=sometimes"}, reported; len(got) != 1 || want[0] != got[0] {
+		t.Errorf("wanted reports %v, got %v", want, got)
+	}
+
+	for _, v := range InterfaceBridgePort_Edge("").Values() {
+		if !v.IsKnown() {
+			t.Errorf("value %q should be known", v)
+		}
+	}
+}
+
+// TestOpenEnum ensures open enums accept and serialize any value without
+// reporting it.
+func TestOpenEnum(t *testing.T) {
+	var reported []string
+	UnknownEnumHandler = func(typ, value string) {
+		reported = append(reported, typ+"="+value)
+	}
+	defer func() {
+		UnknownEnumHandler = logUnknownEnum
+	}()
+
+	var rule IpFirewallFilter
+	if err := json.Unmarshal([]byte(`{"chain":"my-chain","protocol":"tcp"}`), &rule); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if want, got := IpFirewallFilter_Chain("my-chain"), rule.Chain; want != got {
+		t.Errorf("wanted chain %q, got %q", want, got)
+	}
+	if rule.Chain.IsKnown() {
+		t.Errorf("chain %q should not be known", rule.Chain)
+	}
+	if want, got := FirewallRule_ProtocolTcp, rule.Protocol; want != got {
+		t.Errorf("wanted protocol %q, got %q", want, got)
+	}
+	if len(reported) != 0 {
+		t.Errorf("wanted no reports, got %v", reported)
+	}
+
+	data, err := json.Marshal(&IpFirewallFilter_Update{Chain: &rule.Chain})
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	if want, got := `{"chain":"my-chain"}`, string(data); want != got {
+		t.Errorf("wanted %s, got %s", want, got)
+	}
+}
+
+// TestEnumList ensures enum lists keep their negation and values, including
+// unknown ones.
+func TestEnumList(t *testing.T) {
+	var reported []string
+	UnknownEnumHandler = func(typ, value string) {
+		reported = append(reported, typ+"="+value)
+	}
+	defer func() {
+		UnknownEnumHandler = logUnknownEnum
+	}()
+
+	var rule IpFirewallFilter
+	if err := json.Unmarshal([]byte(`{"connection-state":"!established,related,sideways"}`), &rule); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	want := FirewallConntrack_ConnectionStateList{
+		Not:    true,
+		Values: []FirewallConntrack_ConnectionState{FirewallConntrack_ConnectionStateEstablished, FirewallConntrack_ConnectionStateRelated, "sideways"},
+	}
+	if !want.Equal(rule.ConnectionState) {
+		t.Errorf("wanted connection state %s, got %s", want, rule.ConnectionState)
+	}
+	if want, got := []string{"FirewallConntrack_ConnectionState=sideways"}, reported; len(got) != 1 || want[0] != got[0] {
+		t.Errorf("wanted reports %v, got %v", want, got)
+	}
+
+	reported = nil
+	data, err := json.Marshal(&IpFirewallFilter_Update{ConnectionState: &rule.ConnectionState})
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	if want, got := `{"connection-state":"!established,related,sideways"}`, string(data); want != got {
+		t.Errorf("wanted %s, got %s", want, got)
+	}
+	if want, got := []string{"FirewallConntrack_ConnectionState=sideways"}, reported; len(got) != 1 || want[0] != got[0] {
+		t.Errorf("wanted reports %v, got %v", want, got)
+	}
+
+	update := IpFirewallFilter_Update{
+		ConnectionState: FirewallConntrack_ConnectionStateListPtr(false, FirewallConntrack_ConnectionStateEstablished, FirewallConntrack_ConnectionStateRelated),
+	}
+	data, err = json.Marshal(&update)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	if want, got := `{"connection-state":"established,related"}`, string(data); want != got {
+		t.Errorf("wanted %s, got %s", want, got)
+	}
+}
@@ -0,0 +1,93 @@
+package ros
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+)
+
+// APIError is an error returned by the ROS REST API, either as a non-2xx HTTP
+// status or an error body. Errors returned by the binary API are mapped onto
+// the same form by APITransport. It is returned (wrapped) by all Client
+// methods that talk to ROS, and can be retrieved using errors.As, or checked
+// using IsNotFound, IsUnauthorized, IsBadRequest.
+type APIError struct {
+	// Status is the HTTP status code returned by ROS, eg. 404.
+	Status int
+	// Message is the error message returned by ROS, eg. 'Not Found'.
+	Message string
+	// Detail is the optional error detail returned by ROS, eg. 'no such
+	// item'.
+	Detail string
+	// Method is the HTTP method of the failed request, eg. PATCH.
+	Method string
+	// Path is the REST path of the failed request, eg. interface/bridge/vlan/*1.
+	Path string
+}
+
+func (e *APIError) Error() string {
+	msg := e.Message
+	if msg == "" {
+		msg = http.StatusText(e.Status)
+	}
+	if e.Detail != "" {
+		msg += ": " + e.Detail
+	}
+	return fmt.Sprintf("%s %s: %d %s", e.Method, e.Path, e.Status, msg)
+}
+
+// apiErrorFromResponse builds an APIError from a non-2xx ROS response. The
+// response body is consumed.
+func apiErrorFromResponse(method, path string, resp *http.Response) *APIError {
+	e := &APIError{
+		Status: resp.StatusCode,
+		Method: method,
+		Path:   path,
+	}
+	// ROS returns errors as {"error": 404, "message": "Not Found", "detail":
+	// "..."}. If the body is something else, we just go with the HTTP status.
+	data, err := ioutil.ReadAll(io.LimitReader(resp.Body, 64<<10))
+	if err != nil {
+		return e
+	}
+	var body struct {
+		Error   int64  `json:"error"`
+		Message string `json:"message"`
+		Detail  string `json:"detail"`
+	}
+	if err := json.Unmarshal(data, &body); err != nil {
+		return e
+	}
+	e.Message = body.Message
+	e.Detail = body.Detail
+	return e
+}
+
+func isStatus(err error, status int) bool {
+	var e *APIError
+	if !errors.As(err, &e) {
+		return false
+	}
+	return e.Status == status
+}
+
+// IsNotFound returns whether the error is an APIError caused by a missing
+// record or menu.
+func IsNotFound(err error) bool {
+	return isStatus(err, http.StatusNotFound)
+}
+
+// IsUnauthorized returns whether the error is an APIError caused by invalid
+// credentials.
+func IsUnauthorized(err error) bool {
+	return isStatus(err, http.StatusUnauthorized)
+}
+
+// IsBadRequest returns whether the error is an APIError caused by ROS
+// rejecting the request, eg. due to an invalid property value.
+func IsBadRequest(err error) bool {
+	return isStatus(err, http.StatusBadRequest)
+}
@@ -0,0 +1,122 @@
+package ros
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// TestLetsEncryptPEM ensures every certificate in the embedded bundle parses,
+// is a CA, and has not expired (nor will in the next 90 days, to leave time
+// for a refresh).
+func TestLetsEncryptPEM(t *testing.T) {
+	deadline := time.Now().Add(90 * 24 * time.Hour)
+	rest := LetsEncryptPEM
+	n := 0
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		n++
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			t.Errorf("certificate %d: %v", n, err)
+			continue
+		}
+		name := cert.Subject.CommonName
+		if !cert.IsCA {
+			t.Errorf("%s: not a CA", name)
+		}
+		if cert.NotAfter.Before(deadline) {
+			t.Errorf("%s: expires %s", name, cert.NotAfter)
+		}
+	}
+	if n == 0 {
+		t.Errorf("no certificates in bundle")
+	}
+}
+
+// TestNewClientWithCAs ensures bundles without certificates are refused.
+func TestNewClientWithCAs(t *testing.T) {
+	if _, err := NewClientWithCAs(LetsEncryptPEM); err != nil {
+		t.Errorf("NewClientWithCAs(LetsEncryptPEM): %v", err)
+	}
+	if _, err := NewClientWithCAs(LetsEncryptPEM, []byte("not a certificate")); err == nil {
+		t.Errorf("NewClientWithCAs with invalid bundle: wanted error")
+	}
+}
+
+// TestLetsEncryptIntermediates ensures every intermediate in the embedded
+// bundle chains to an embedded root.
+func TestLetsEncryptIntermediates(t *testing.T) {
+	roots := x509.NewCertPool()
+	var intermediates []*x509.Certificate
+	rest := LetsEncryptPEM
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			t.Fatalf("ParseCertificate: %v", err)
+		}
+		if cert.CheckSignatureFrom(cert) == nil {
+			roots.AddCert(cert)
+		} else {
+			intermediates = append(intermediates, cert)
+		}
+	}
+	if len(intermediates) == 0 {
+		t.Skip("no intermediates in bundle, see letsencrypt.pem")
+	}
+	for _, cert := range intermediates {
+		_, err := cert.Verify(x509.VerifyOptions{
+			Roots:     roots,
+			KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+		})
+		if err != nil {
+			t.Errorf("%s: %v", cert.Subject.CommonName, err)
+		}
+	}
+}
+
+// TestLeafOnlyChain ensures a certificate served without its intermediate, as
+// done by ROS, verifies only if the intermediate is in one of the bundles.
+func TestLeafOnlyChain(t *testing.T) {
+	root := testCert(t, "root", true, nil)
+	intermediate := testCert(t, "intermediate", true, &root)
+	leaf := testCert(t, "leaf", false, &intermediate)
+	encode := func(c tls.Certificate) []byte {
+		return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: c.Certificate[0]})
+	}
+
+	for _, te := range []struct {
+		desc string
+		pems [][]byte
+		ok   bool
+	}{
+		{"root and intermediate", [][]byte{encode(root), encode(intermediate)}, true},
+		{"intermediate only", [][]byte{encode(intermediate)}, true},
+		{"root only", [][]byte{encode(root)}, false},
+	} {
+		c, err := NewClientWithCAs(te.pems...)
+		if err != nil {
+			t.Fatalf("%s: NewClientWithCAs: %v", te.desc, err)
+		}
+		pool := c.Transport.(*http.Transport).TLSClientConfig.RootCAs
+		_, err = leaf.Leaf.Verify(x509.VerifyOptions{Roots: pool})
+		if te.ok && err != nil {
+			t.Errorf("%s: %v", te.desc, err)
+		}
+		if !te.ok && err == nil {
+			t.Errorf("%s: wanted error", te.desc)
+		}
+	}
+}
@@ -0,0 +1,309 @@
+package ros
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Handler performs a request, as a Transport does.
+type Handler func(ctx context.Context, req *Request) (io.ReadCloser, error)
+
+// Interceptor wraps every request a Client makes to a device, including every
+// retry attempt. It must call next to actually perform the request (unless it
+// wants to fail it), and can inspect or change the request and response.
+type Interceptor func(ctx context.Context, device string, req *Request, next Handler) (io.ReadCloser, error)
+
+// Call describes a finished request, as passed to Observe functions.
+type Call struct {
+	// Device is the address of the device the request was made to.
+	Device string
+	// Request is the request made.
+	Request *Request
+	// Status is the HTTP status of the response (or what the REST API would
+	// have returned): 200 on success, APIError.Status if ROS returned an
+	// error, or 0 if there was no response at all (eg. network errors).
+	Status int
+	// Duration is the time it took to get a response.
+	Duration time.Duration
+	// Err is the error returned by the request, if any.
+	Err error
+}
+
+// Observe returns an Interceptor which calls f after every request. It's the
+// basis of Logger and Metrics.
+func Observe(f func(ctx context.Context, call *Call)) Interceptor {
+	return func(ctx context.Context, device string, req *Request, next Handler) (io.ReadCloser, error) {
+		start := time.Now()
+		body, err := next(ctx, req)
+		call := &Call{
+			Device:   device,
+			Request:  req,
+			Status:   http.StatusOK,
+			Duration: time.Since(start),
+			Err:      err,
+		}
+		if err != nil {
+			call.Status = 0
+			var aerr *APIError
+			if errors.As(err, &aerr) {
+				call.Status = aerr.Status
+			}
+		}
+		f(ctx, call)
+		return body, err
+	}
+}
+
+// sensitive returns whether a property is likely to contain a secret, and its
+// value should not be logged.
+func sensitive(name string) bool {
+	for _, s := range []string{"password", "passphrase", "secret", "key", "token"} {
+		if strings.Contains(name, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// RedactedBody returns the body of the request with the values of properties
+// likely to contain secrets (like password or pre-shared-key) replaced with
+// ***, for logging. This includes key=value words of print/find .query and
+// .proplist lists, eg. password=***.
+func (r *Request) RedactedBody() string {
+	if len(r.Body) == 0 {
+		return ""
+	}
+	var values map[string]json.RawMessage
+	if err := json.Unmarshal(r.Body, &values); err != nil {
+		return "<invalid>"
+	}
+	var keys []string
+	for k := range values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var parts []string
+	for _, k := range keys {
+		v := string(values[k])
+		switch {
+		case sensitive(k):
+			v = `"***"`
+		case k == ".query" || k == ".proplist":
+			v = redactWords(values[k])
+		}
+		parts = append(parts, fmt.Sprintf("%q:%s", k, v))
+	}
+	return "{" + strings.Join(parts, ",") + "}"
+}
+
+// redactWords redacts the values of sensitive key=value words in a JSON list
+// of them (or a single comma-separated string), as sent in .query and
+// .proplist.
+func redactWords(raw json.RawMessage) string {
+	var words []string
+	if err := json.Unmarshal(raw, &words); err != nil {
+		var s string
+		if err := json.Unmarshal(raw, &s); err != nil {
+			return `"<invalid>"`
+		}
+		words = strings.Split(s, ",")
+	}
+	res := make([]string, len(words))
+	for i, w := range words {
+		res[i] = w
+		if j := strings.Index(w, "="); j != -1 && sensitive(w[:j]) {
+			res[i] = w[:j+1] + "***"
+		}
+	}
+	out, _ := json.Marshal(res)
+	return string(out)
+}
+
+// RedactedQuery returns the URL query parameters of the request with the
+// values of parameters likely to contain secrets replaced with ***, for
+// logging.
+func (r *Request) RedactedQuery() string {
+	if len(r.Query) == 0 {
+		return ""
+	}
+	var keys []string
+	for k := range r.Query {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var parts []string
+	for _, k := range keys {
+		for _, v := range r.Query[k] {
+			v = url.QueryEscape(v)
+			if sensitive(k) {
+				v = "***"
+			}
+			parts = append(parts, url.QueryEscape(k)+"="+v)
+		}
+	}
+	return strings.Join(parts, "&")
+}
+
+// Logger returns an Interceptor which logs every request to l (or the standard
+// logger if nil) in logfmt, eg.:
+//
+//	ros: device=10.0.0.1 method=PATCH path=interface/bridge/vlan/*1 status=200 duration=12ms body="{\"disabled\":\"true\"}"
+//
+// Request bodies and query parameters are redacted using Request.RedactedBody
+// and Request.RedactedQuery.
+func Logger(l *log.Logger) Interceptor {
+	if l == nil {
+		l = log.Default()
+	}
+	return Observe(func(_ context.Context, call *Call) {
+		parts := []string{
+			"device=" + logfmtValue(call.Device),
+			"method=" + call.Request.Method,
+			"path=" + logfmtValue(call.Request.Path()),
+			"status=" + strconv.Itoa(call.Status),
+			"duration=" + call.Duration.Round(time.Millisecond).String(),
+		}
+		if query := call.Request.RedactedQuery(); query != "" {
+			parts = append(parts, "query="+logfmtValue(query))
+		}
+		if body := call.Request.RedactedBody(); body != "" {
+			parts = append(parts, "body="+logfmtValue(body))
+		}
+		if call.Err != nil {
+			parts = append(parts, "err="+logfmtValue(call.Err.Error()))
+		}
+		l.Printf("ros: %s", strings.Join(parts, " "))
+	})
+}
+
+// logfmtValue quotes a value if needed.
+func logfmtValue(s string) string {
+	if s == "" || strings.ContainsAny(s, " \"=\\") {
+		return strconv.Quote(s)
+	}
+	return s
+}
+
+// DefaultBuckets are the latency histogram bucket upper bounds used by Metrics
+// if not configured.
+var DefaultBuckets = []time.Duration{
+	10 * time.Millisecond,
+	25 * time.Millisecond,
+	50 * time.Millisecond,
+	100 * time.Millisecond,
+	250 * time.Millisecond,
+	500 * time.Millisecond,
+	time.Second,
+	2500 * time.Millisecond,
+	5 * time.Second,
+	10 * time.Second,
+}
+
+// MetricsKey identifies a series of requests collected by Metrics.
+type MetricsKey struct {
+	// Device is the address of the device.
+	Device string
+	// Menu is the menu of the requests, eg. interface/bridge/vlan.
+	Menu string
+}
+
+// MetricsSeries are statistics of a series of requests.
+type MetricsSeries struct {
+	// Requests is the number of requests by status, as in Call.Status.
+	Requests map[int]int64
+	// Buckets are the upper bounds of the latency histogram buckets.
+	Buckets []time.Duration
+	// Counts are the numbers of requests per latency bucket, with one more
+	// element than Buckets for requests slower than the last bucket.
+	Counts []int64
+	// Sum is the sum of the latencies of all requests.
+	Sum time.Duration
+}
+
+// Total returns the total number of requests.
+func (s *MetricsSeries) Total() int64 {
+	var res int64
+	for _, n := range s.Requests {
+		res += n
+	}
+	return res
+}
+
+// Metrics collects request counts and latency histograms per device and menu.
+// Its Interceptor must be added to the Clients to observe, and a Snapshot can
+// be exported at any time, eg. to Prometheus.
+type Metrics struct {
+	// Buckets are the upper bounds of the latency histogram buckets, in
+	// increasing order. If not set, DefaultBuckets are used. Must not be
+	// changed after first use.
+	Buckets []time.Duration
+
+	mu     sync.Mutex
+	series map[MetricsKey]*MetricsSeries
+}
+
+// Interceptor returns an Interceptor which records all requests.
+func (m *Metrics) Interceptor() Interceptor {
+	return Observe(func(_ context.Context, call *Call) {
+		m.record(call)
+	})
+}
+
+func (m *Metrics) record(call *Call) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.series == nil {
+		m.series = make(map[MetricsKey]*MetricsSeries)
+	}
+	key := MetricsKey{Device: call.Device, Menu: call.Request.Menu}
+	s, ok := m.series[key]
+	if !ok {
+		buckets := m.Buckets
+		if buckets == nil {
+			buckets = DefaultBuckets
+		}
+		s = &MetricsSeries{
+			Requests: make(map[int]int64),
+			Buckets:  buckets,
+			Counts:   make([]int64, len(buckets)+1),
+		}
+		m.series[key] = s
+	}
+	s.Requests[call.Status]++
+	i := sort.Search(len(s.Buckets), func(i int) bool {
+		return call.Duration <= s.Buckets[i]
+	})
+	s.Counts[i]++
+	s.Sum += call.Duration
+}
+
+// Snapshot returns a copy of all statistics collected so far.
+func (m *Metrics) Snapshot() map[MetricsKey]*MetricsSeries {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	res := make(map[MetricsKey]*MetricsSeries)
+	for k, s := range m.series {
+		c := &MetricsSeries{
+			Requests: make(map[int]int64),
+			Buckets:  s.Buckets,
+			Counts:   append([]int64(nil), s.Counts...),
+			Sum:      s.Sum,
+		}
+		for status, n := range s.Requests {
+			c.Requests[status] = n
+		}
+		res[k] = c
+	}
+	return res
+}
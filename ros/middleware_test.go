@@ -0,0 +1,97 @@
+package ros
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+// fakeTransport responds to adds with a record, and fails everything else.
+type fakeTransport struct{}
+
+func (fakeTransport) Do(ctx context.Context, req *Request) (io.ReadCloser, error) {
+	if req.Method == "PUT" {
+		return ioutil.NopCloser(strings.NewReader(`{".id":"*1"}`)), nil
+	}
+	return nil, &APIError{Status: http.StatusNotFound, Message: "no such item"}
+}
+
+// TestInterceptors ensures interceptors run in order around every request, and
+// that the logger and metrics see (redacted) requests and their outcome.
+func TestInterceptors(t *testing.T) {
+	ctx := context.Background()
+	var order []string
+	tracer := func(name string) Interceptor {
+		return func(ctx context.Context, device string, req *Request, next Handler) (io.ReadCloser, error) {
+			order = append(order, name+" "+device+" "+req.Method)
+			return next(ctx, req)
+		}
+	}
+	var logs bytes.Buffer
+	metrics := &Metrics{Buckets: []time.Duration{time.Hour}}
+	c := &Client{
+		Address:   "10.0.0.1",
+		Transport: fakeTransport{},
+		Interceptors: []Interceptor{
+			tracer("a"),
+			tracer("b"),
+			Logger(log.New(&logs, "", 0)),
+			metrics.Interceptor(),
+		},
+	}
+
+	if _, err := c.AddRow(ctx, "user", Row{"name": "q3k", "password": "hunter2"}); err != nil {
+		t.Fatalf("AddRow: %v", err)
+	}
+	if _, err := c.PatchRow(ctx, "user", "*1", Row{"group": "full"}); !IsNotFound(err) {
+		t.Fatalf("PatchRow: wanted not found, got %v", err)
+	}
+
+	if want, got := "a 10.0.0.1 PUT,b 10.0.0.1 PUT,a 10.0.0.1 PATCH,b 10.0.0.1 PATCH", strings.Join(order, ","); want != got {
+		t.Errorf("wanted order %q, got %q", want, got)
+	}
+	// Secrets in queries are redacted too. Only the logger sees these.
+	c.Interceptors = c.Interceptors[2:3]
+	c.doGET(ctx, "user", "", url.Values{"name": {"q3k"}, "password": {"hunter2"}})
+	c.doPOST(ctx, "user", "print", []byte(`{".proplist":["name"],".query":["name=q3k","password=hunter2"]}`))
+
+	lines := strings.Split(strings.TrimSpace(logs.String()), "\n")
+	if len(lines) != 4 {
+		t.Fatalf("wanted 4 log lines, got %q", lines)
+	}
+	for i, want := range []string{
+		`ros: device=10.0.0.1 method=PUT path=user status=200 duration=0s body="{\"name\":\"q3k\",\"password\":\"***\"}"`,
+		`ros: device=10.0.0.1 method=PATCH path=user/*1 status=404 duration=0s body="{\"group\":\"full\"}" err=`,
+		`ros: device=10.0.0.1 method=GET path=user status=404 duration=0s query="name=q3k&password=***" err=`,
+		`ros: device=10.0.0.1 method=POST path=user/print status=404 duration=0s body="{\".proplist\":[\"name\"],\".query\":[\"name=q3k\",\"password=***\"]}" err=`,
+	} {
+		if !strings.HasPrefix(lines[i], want) {
+			t.Errorf("log line %d: wanted prefix %s, got %s", i, want, lines[i])
+		}
+	}
+	if strings.Contains(logs.String(), "hunter2") {
+		t.Errorf("password logged")
+	}
+
+	snap := metrics.Snapshot()
+	s := snap[MetricsKey{Device: "10.0.0.1", Menu: "user"}]
+	if s == nil {
+		t.Fatalf("no series for user, got %v", snap)
+	}
+	if want, got := int64(2), s.Total(); want != got {
+		t.Errorf("wanted %d requests, got %d", want, got)
+	}
+	if s.Requests[200] != 1 || s.Requests[404] != 1 {
+		t.Errorf("wanted one 200 and one 404, got %v", s.Requests)
+	}
+	if want, got := []int64{2, 0}, s.Counts; got[0] != want[0] || got[1] != want[1] {
+		t.Errorf("wanted bucket counts %v, got %v", want, got)
+	}
+}
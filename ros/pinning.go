@@ -0,0 +1,319 @@
+package ros
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+)
+
+// Fingerprint is the SHA-256 hash of the DER-encoded SubjectPublicKeyInfo of a
+// certificate, as used for pinning. Unlike a hash of the whole certificate, it
+// doesn't change when a certificate is renewed with the same key.
+type Fingerprint [sha256.Size]byte
+
+// SPKIFingerprint returns the fingerprint of a certificate.
+func SPKIFingerprint(cert *x509.Certificate) Fingerprint {
+	return sha256.Sum256(cert.RawSubjectPublicKeyInfo)
+}
+
+// ParseFingerprint parses a fingerprint in hex (optionally separated by
+// colons, as printed by openssl) or base64 (optionally prefixed with sha256/,
+// as used by HPKP and curl's --pinnedpubkey).
+func ParseFingerprint(s string) (Fingerprint, error) {
+	var res Fingerprint
+	s = strings.TrimSpace(s)
+	var data []byte
+	var err error
+	if hs := strings.ReplaceAll(s, ":", ""); len(hs) == hex.EncodedLen(len(res)) {
+		data, err = hex.DecodeString(hs)
+	} else {
+		data, err = base64.StdEncoding.DecodeString(strings.TrimPrefix(s, "sha256/"))
+	}
+	if err != nil || len(data) != len(res) {
+		return res, fmt.Errorf("invalid fingerprint %q", s)
+	}
+	copy(res[:], data)
+	return res, nil
+}
+
+// String returns the fingerprint in lowercase hex.
+func (f Fingerprint) String() string {
+	return hex.EncodeToString(f[:])
+}
+
+// ErrFingerprintMismatch is returned (wrapped in a *FingerprintError) when a
+// device presents a certificate which doesn't match its pins or the
+// fingerprint recorded in KnownHosts. It can be checked for using errors.Is.
+var ErrFingerprintMismatch = errors.New("certificate fingerprint mismatch")
+
+// FingerprintError is returned when a device presents a certificate which
+// doesn't match its pins or the fingerprint recorded in KnownHosts.
+type FingerprintError struct {
+	// Address of the device.
+	Address string
+	// Got is the fingerprint of the certificate presented by the device.
+	Got Fingerprint
+	// Want are the accepted fingerprints.
+	Want []Fingerprint
+}
+
+func (e *FingerprintError) Error() string {
+	var want []string
+	for _, f := range e.Want {
+		want = append(want, f.String())
+	}
+	return fmt.Sprintf("%s presented %s, wanted %s: %v", e.Address, e.Got, strings.Join(want, " or "), ErrFingerprintMismatch)
+}
+
+func (e *FingerprintError) Unwrap() error {
+	return ErrFingerprintMismatch
+}
+
+// KnownHosts is a trust-on-first-use store of device certificate fingerprints,
+// backed by a file similar to ssh's known_hosts, with one '<address>
+// <fingerprint>' line per device. The first time a device is connected to, the
+// fingerprint of its certificate is recorded in the file. Afterwards,
+// connections to the device are refused if its certificate's fingerprint
+// changes, until the line is removed from the file.
+type KnownHosts struct {
+	// Path of the file. It is created if it doesn't exist.
+	Path string
+
+	mu sync.Mutex
+}
+
+// Lookup returns the fingerprint recorded for a device, if any.
+func (k *KnownHosts) Lookup(address string) (Fingerprint, bool, error) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	return k.lookupLocked(address)
+}
+
+func (k *KnownHosts) lookupLocked(address string) (Fingerprint, bool, error) {
+	f, err := os.Open(k.Path)
+	if errors.Is(err, os.ErrNotExist) {
+		return Fingerprint{}, false, nil
+	}
+	if err != nil {
+		return Fingerprint{}, false, err
+	}
+	defer f.Close()
+	s := bufio.NewScanner(f)
+	for n := 1; s.Scan(); n++ {
+		line := strings.TrimSpace(s.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			return Fingerprint{}, false, fmt.Errorf("%s:%d: invalid line", k.Path, n)
+		}
+		if fields[0] != address {
+			continue
+		}
+		fp, err := ParseFingerprint(fields[1])
+		if err != nil {
+			return Fingerprint{}, false, fmt.Errorf("%s:%d: %w", k.Path, n, err)
+		}
+		return fp, true, nil
+	}
+	return Fingerprint{}, false, s.Err()
+}
+
+// Add records the fingerprint of a device, eg. when enrolling it using
+// FetchFingerprint.
+func (k *KnownHosts) Add(address string, fp Fingerprint) error {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	return k.addLocked(address, fp)
+}
+
+func (k *KnownHosts) addLocked(address string, fp Fingerprint) error {
+	f, err := os.OpenFile(k.Path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(f, "%s %s\n", address, fp); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// check verifies a device's fingerprint, recording it if not yet known.
+func (k *KnownHosts) check(address string, fp Fingerprint) error {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	known, ok, err := k.lookupLocked(address)
+	if err != nil {
+		return fmt.Errorf("could not read known hosts: %w", err)
+	}
+	if !ok {
+		if err := k.addLocked(address, fp); err != nil {
+			return fmt.Errorf("could not record known host: %w", err)
+		}
+		return nil
+	}
+	if known != fp {
+		return &FingerprintError{Address: address, Got: fp, Want: []Fingerprint{known}}
+	}
+	return nil
+}
+
+// PinnedTLSConfig returns a TLS configuration which accepts a device's
+// certificate if its fingerprint is one of the given pins, or if it's signed
+// (possibly through intermediates presented by the device) by a pinned CA
+// certificate presented by the device. Its fingerprint must also match the one
+// recorded in knownHosts (if not nil). The usual verification against CAs is
+// not performed, so that self-signed certificates can be used. It is used by
+// Client when Pins or KnownHosts are set, and can be used as APITransport.TLS.
+func PinnedTLSConfig(address string, pins []string, knownHosts *KnownHosts) (*tls.Config, error) {
+	var fps []Fingerprint
+	for _, p := range pins {
+		fp, err := ParseFingerprint(p)
+		if err != nil {
+			return nil, err
+		}
+		fps = append(fps, fp)
+	}
+	return &tls.Config{
+		// Verification is done in VerifyConnection instead.
+		InsecureSkipVerify: true,
+		VerifyConnection: func(cs tls.ConnectionState) error {
+			if len(cs.PeerCertificates) == 0 {
+				return fmt.Errorf("%s presented no certificate", address)
+			}
+			got := SPKIFingerprint(cs.PeerCertificates[0])
+			if len(fps) > 0 && !pinnedChain(cs.PeerCertificates, fps) {
+				return &FingerprintError{Address: address, Got: got, Want: fps}
+			}
+			if knownHosts != nil {
+				return knownHosts.check(address, got)
+			}
+			return nil
+		},
+	}, nil
+}
+
+// pinnedChain returns whether the leaf of a presented (unverified) chain is
+// pinned, or verifies up to a pinned certificate within the chain. Merely
+// presenting a pinned certificate after an unrelated leaf is not enough.
+func pinnedChain(certs []*x509.Certificate, pins []Fingerprint) bool {
+	isPinned := func(cert *x509.Certificate) bool {
+		fp := SPKIFingerprint(cert)
+		for _, pin := range pins {
+			if fp == pin {
+				return true
+			}
+		}
+		return false
+	}
+	if isPinned(certs[0]) {
+		return true
+	}
+	for i, cert := range certs[1:] {
+		if !isPinned(cert) {
+			continue
+		}
+		roots := x509.NewCertPool()
+		roots.AddCert(cert)
+		intermediates := x509.NewCertPool()
+		for _, c := range certs[1 : i+1] {
+			intermediates.AddCert(c)
+		}
+		// Devices are usually addressed by IP, so names aren't checked, as
+		// with pinned leaves.
+		_, err := certs[0].Verify(x509.VerifyOptions{
+			Roots:         roots,
+			Intermediates: intermediates,
+			KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+		})
+		if err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// pinnedHTTPKey is the configuration a cached pinned HTTP client was built
+// from.
+type pinnedHTTPKey struct {
+	address    string
+	pins       string
+	knownHosts *KnownHosts
+	base       *http.Client
+}
+
+// pinnedHTTPClient returns the HTTP client used when Pins or KnownHosts are
+// set: a copy of the configured HTTP client using PinnedTLSConfig. It is
+// rebuilt whenever Address, Pins, KnownHosts or HTTP change.
+func (c *Client) pinnedHTTPClient() (*http.Client, error) {
+	c.httpMu.Lock()
+	defer c.httpMu.Unlock()
+	key := pinnedHTTPKey{
+		address:    c.Address,
+		pins:       strings.Join(c.Pins, ","),
+		knownHosts: c.KnownHosts,
+		base:       c.HTTP,
+	}
+	if c.pinnedHTTP != nil && c.pinnedHTTPKey == key {
+		return c.pinnedHTTP, nil
+	}
+	config, err := PinnedTLSConfig(c.Address, c.Pins, c.KnownHosts)
+	if err != nil {
+		return nil, err
+	}
+	base := c.HTTP
+	if base == nil {
+		base = http.DefaultClient
+	}
+	var transport *http.Transport
+	switch t := base.Transport.(type) {
+	case nil:
+		transport = http.DefaultTransport.(*http.Transport).Clone()
+	case *http.Transport:
+		transport = t.Clone()
+	default:
+		return nil, fmt.Errorf("pinning needs HTTP client with *http.Transport, got %T", base.Transport)
+	}
+	transport.TLSClientConfig = config
+	hc := *base
+	hc.Transport = transport
+	c.pinnedHTTP = &hc
+	c.pinnedHTTPKey = key
+	return c.pinnedHTTP, nil
+}
+
+// FetchFingerprint connects to a device's www-ssl service (or any other TLS
+// service, if address contains a port) and returns the fingerprint of the
+// certificate it presents, without verifying it. It's meant for enrolling
+// devices, ie. recording their fingerprint in Client.Pins or KnownHosts, and
+// the result should be confirmed out of band (eg. against /certificate print
+// on the device).
+func FetchFingerprint(ctx context.Context, address string) (Fingerprint, error) {
+	if _, _, err := net.SplitHostPort(address); err != nil {
+		address = net.JoinHostPort(strings.Trim(address, "[]"), "443")
+	}
+	d := &tls.Dialer{Config: &tls.Config{InsecureSkipVerify: true}}
+	conn, err := d.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return Fingerprint{}, fmt.Errorf("could not connect: %w", err)
+	}
+	defer conn.Close()
+	certs := conn.(*tls.Conn).ConnectionState().PeerCertificates
+	if len(certs) == 0 {
+		return Fingerprint{}, fmt.Errorf("%s presented no certificate", address)
+	}
+	return SPKIFingerprint(certs[0]), nil
+}
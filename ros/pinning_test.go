@@ -0,0 +1,194 @@
+package ros
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestParseFingerprint ensures fingerprints are accepted in all supported
+// formats.
+func TestParseFingerprint(t *testing.T) {
+	hexfp := "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+	want, err := ParseFingerprint(hexfp)
+	if err != nil {
+		t.Fatalf("ParseFingerprint: %v", err)
+	}
+	if got := want.String(); got != hexfp {
+		t.Errorf("wanted %s, got %s", hexfp, got)
+	}
+	for _, s := range []string{
+		strings.ToUpper(hexfp),
+		"01:23:45:67:89:ab:cd:ef:01:23:45:67:89:ab:cd:ef:01:23:45:67:89:ab:cd:ef:01:23:45:67:89:ab:cd:ef",
+		"ASNFZ4mrze8BI0VniavN7wEjRWeJq83vASNFZ4mrze8=",
+		"sha256/ASNFZ4mrze8BI0VniavN7wEjRWeJq83vASNFZ4mrze8=",
+	} {
+		got, err := ParseFingerprint(s)
+		if err != nil {
+			t.Errorf("%q: %v", s, err)
+			continue
+		}
+		if got != want {
+			t.Errorf("%q: wanted %s, got %s", s, want, got)
+		}
+	}
+	for _, s := range []string{"", "0123", "sha256/AAAA"} {
+		if _, err := ParseFingerprint(s); err == nil {
+			t.Errorf("%q: wanted error", s)
+		}
+	}
+}
+
+// TestPinning ensures self-signed certificates are accepted if pinned or
+// trusted on first use, and refused if they don't match.
+func TestPinning(t *testing.T) {
+	ctx := context.Background()
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("[]"))
+	}))
+	defer srv.Close()
+	address := srv.Listener.Addr().String()
+	wantFP := SPKIFingerprint(srv.Certificate())
+
+	fp, err := FetchFingerprint(ctx, address)
+	if err != nil {
+		t.Fatalf("FetchFingerprint: %v", err)
+	}
+	if fp != wantFP {
+		t.Fatalf("FetchFingerprint: wanted %s, got %s", wantFP, fp)
+	}
+
+	// Not trusted by default.
+	c := &Client{Address: address}
+	if _, err := c.ListRows(ctx, "interface/bridge/vlan"); err == nil {
+		t.Errorf("ListRows without pins: wanted error")
+	}
+
+	c = &Client{Address: address, Pins: []string{fp.String()}}
+	if _, err := c.ListRows(ctx, "interface/bridge/vlan"); err != nil {
+		t.Errorf("ListRows with pin: %v", err)
+	}
+
+	other := "sha256/ASNFZ4mrze8BI0VniavN7wEjRWeJq83vASNFZ4mrze8="
+	c = &Client{Address: address, Pins: []string{other}}
+	if _, err := c.ListRows(ctx, "interface/bridge/vlan"); !errors.Is(err, ErrFingerprintMismatch) {
+		t.Errorf("ListRows with wrong pin: wanted mismatch, got %v", err)
+	}
+	// Changed pins are used by the same client.
+	c.Pins = []string{fp.String()}
+	if _, err := c.ListRows(ctx, "interface/bridge/vlan"); err != nil {
+		t.Errorf("ListRows with corrected pin: %v", err)
+	}
+
+	path := filepath.Join(t.TempDir(), "known_hosts")
+	kh := &KnownHosts{Path: path}
+	c = &Client{Address: address, KnownHosts: kh}
+	for i := 0; i < 2; i++ {
+		if _, err := c.ListRows(ctx, "interface/bridge/vlan"); err != nil {
+			t.Fatalf("ListRows with known hosts (%d): %v", i, err)
+		}
+	}
+	known, ok, err := kh.Lookup(address)
+	if err != nil || !ok || known != fp {
+		t.Fatalf("Lookup: wanted %s, got %s, %v, %v", fp, known, ok, err)
+	}
+
+	// The certificate changed.
+	if err := ioutil.WriteFile(path, []byte("# changed\n"+address+" "+other+"\n"), 0600); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+	c = &Client{Address: address, KnownHosts: kh}
+	if _, err := c.ListRows(ctx, "interface/bridge/vlan"); !errors.Is(err, ErrFingerprintMismatch) {
+		t.Errorf("ListRows with changed certificate: wanted mismatch, got %v", err)
+	}
+}
+
+// testCert returns a new certificate, signed by parent (or self-signed if
+// nil).
+func testCert(t *testing.T, name string, ca bool, parent *tls.Certificate) tls.Certificate {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("GenerateKey: %v", err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  ca,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	signer, signerKey := tmpl, interface{}(key)
+	if parent != nil {
+		signer, signerKey = parent.Leaf, parent.PrivateKey
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, signer, &key.PublicKey, signerKey)
+	if err != nil {
+		t.Fatalf("CreateCertificate: %v", err)
+	}
+	leaf, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("ParseCertificate: %v", err)
+	}
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key, Leaf: leaf}
+}
+
+// TestPinnedChain ensures a pinned certificate only vouches for a leaf it
+// actually signed, and not for a forged leaf presented in front of it.
+func TestPinnedChain(t *testing.T) {
+	ctx := context.Background()
+	ca := testCert(t, "ca", true, nil)
+	device := testCert(t, "device", false, &ca)
+	forged := testCert(t, "forged", false, nil)
+
+	serve := func(cert tls.Certificate, chain ...tls.Certificate) string {
+		for _, c := range chain {
+			cert.Certificate = append(cert.Certificate, c.Certificate...)
+		}
+		srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte("[]"))
+		}))
+		srv.TLS = &tls.Config{Certificates: []tls.Certificate{cert}}
+		srv.StartTLS()
+		t.Cleanup(srv.Close)
+		return srv.Listener.Addr().String()
+	}
+
+	for _, te := range []struct {
+		desc    string
+		address string
+		pin     *tls.Certificate
+		ok      bool
+	}{
+		{"pinned leaf", serve(device, ca), &device, true},
+		{"pinned CA", serve(device, ca), &ca, true},
+		{"forged leaf before pinned CA", serve(forged, ca), &ca, false},
+		{"forged leaf before pinned leaf", serve(forged, device), &device, false},
+	} {
+		pin := SPKIFingerprint(te.pin.Leaf).String()
+		c := &Client{Address: te.address, Pins: []string{pin}}
+		_, err := c.ListRows(ctx, "interface/bridge/vlan")
+		if te.ok && err != nil {
+			t.Errorf("%s: %v", te.desc, err)
+		}
+		if !te.ok && !errors.Is(err, ErrFingerprintMismatch) {
+			t.Errorf("%s: wanted mismatch, got %v", te.desc, err)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package ros
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// filterValues serializes a generated _Filter struct (or nil) into a map from
+// ROS property name to ROS value, as it would be sent in a request body.
+func filterValues(filter interface{}) (map[string]string, error) {
+	data, err := json.Marshal(filter)
+	if err != nil {
+		return nil, err
+	}
+	var values map[string]string
+	if err := json.Unmarshal(data, &values); err != nil {
+		return nil, fmt.Errorf("filter did not serialize into strings: %w", err)
+	}
+	return values, nil
+}
+
+// listQuery builds URL query parameters for a GET request from a generated
+// _Filter struct (or nil) and an optional list of properties to return.
+func listQuery(filter interface{}, proplist []string) (url.Values, error) {
+	values, err := filterValues(filter)
+	if err != nil {
+		return nil, err
+	}
+	query := make(url.Values)
+	for k, v := range values {
+		query.Set(k, v)
+	}
+	if len(proplist) > 0 {
+		query.Set(".proplist", strings.Join(proplist, ","))
+	}
+	return query, nil
+}
+
+// printQuery builds the body of a POST print request from a generated _Filter
+// struct (or nil) and an optional list of properties to return. All filter
+// conditions are ANDed together by ROS, as that is what happens to multiple
+// values left on the query stack.
+func printQuery(filter interface{}, proplist []string) ([]byte, error) {
+	values, err := filterValues(filter)
+	if err != nil {
+		return nil, err
+	}
+	var keys []string
+	for k := range values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	body := struct {
+		Proplist []string `json:".proplist,omitempty"`
+		Query    []string `json:".query,omitempty"`
+	}{
+		Proplist: proplist,
+	}
+	for _, k := range keys {
+		body.Query = append(body.Query, fmt.Sprintf("%s=%s", k, values[k]))
+	}
+	return json.Marshal(body)
+}
@@ -0,0 +1,280 @@
+package ros
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"math/rand"
+	"net"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// RetryPolicy configures how a Client retries failed requests. Only requests
+// which are safe to repeat are retried: GET, PATCH, DELETE, print and set.
+// Adds (PUT) are retried only after checking that the failed attempt did not
+// create the record after all, which needs the matching records to be listed
+// before every add. If that fails, the add is not retried. Other commands (eg.
+// reboot) are never retried.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts of a request, including
+	// the first one. If not set, 3 is used.
+	MaxAttempts int
+	// InitialBackoff is the delay before the first retry. If not set, 200ms
+	// is used.
+	InitialBackoff time.Duration
+	// MaxBackoff is the maximum delay between retries. If not set, 10s is
+	// used.
+	MaxBackoff time.Duration
+	// Multiplier is the factor by which the delay grows after every retry.
+	// If not set, 2 is used.
+	Multiplier float64
+	// Jitter is the fraction by which every delay is randomly changed, eg.
+	// 0.2 for +/- 20%. If not set, 0.2 is used. Negative disables jitter.
+	Jitter float64
+	// Retryable returns whether a request which failed with the given error
+	// should be retried. If not set, IsRetryable is used.
+	Retryable func(err error) bool
+}
+
+// IsRetryable returns whether an error might not happen again if the request
+// is retried, ie. it's a network error (a timeout, a reset or unexpectedly
+// closed connection, or any other *net.OpError), or ROS returned a 429, 502,
+// 503 or 504 status. Other errors, eg. context, version, certificate and
+// credentials errors, are deterministic and not retryable.
+func IsRetryable(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	if errors.Is(err, ErrUnsupportedOnVersion) || errors.Is(err, ErrFingerprintMismatch) {
+		return false
+	}
+	var aerr *APIError
+	if errors.As(err, &aerr) {
+		switch aerr.Status {
+		case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+			return true
+		}
+		return false
+	}
+	if isCertificateError(err) {
+		return false
+	}
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, syscall.ECONNRESET) {
+		return true
+	}
+	// url.Error is a net.Error whatever it wraps, so look into it.
+	var uerr *url.Error
+	if errors.As(err, &uerr) {
+		err = uerr.Err
+	}
+	var operr *net.OpError
+	if errors.As(err, &operr) {
+		return true
+	}
+	var nerr net.Error
+	return errors.As(err, &nerr) && nerr.Timeout()
+}
+
+// isCertificateError returns whether err is a failure to verify a TLS
+// certificate.
+func isCertificateError(err error) bool {
+	var unknown x509.UnknownAuthorityError
+	var invalid x509.CertificateInvalidError
+	var hostname x509.HostnameError
+	var roots x509.SystemRootsError
+	return errors.As(err, &unknown) || errors.As(err, &invalid) || errors.As(err, &hostname) || errors.As(err, &roots)
+}
+
+func (p *RetryPolicy) maxAttempts() int {
+	if p.MaxAttempts <= 0 {
+		return 3
+	}
+	return p.MaxAttempts
+}
+
+func (p *RetryPolicy) retryable(err error) bool {
+	if p.Retryable != nil {
+		return p.Retryable(err)
+	}
+	return IsRetryable(err)
+}
+
+// backoff returns the delay before the given retry (1 for the first one).
+func (p *RetryPolicy) backoff(retry int) time.Duration {
+	initial := p.InitialBackoff
+	if initial <= 0 {
+		initial = 200 * time.Millisecond
+	}
+	max := p.MaxBackoff
+	if max <= 0 {
+		max = 10 * time.Second
+	}
+	mult := p.Multiplier
+	if mult <= 0 {
+		mult = 2
+	}
+	jitter := p.Jitter
+	if jitter == 0 {
+		jitter = 0.2
+	}
+	d := float64(initial) * math.Pow(mult, float64(retry-1))
+	if d > float64(max) {
+		d = float64(max)
+	}
+	if jitter > 0 {
+		d *= 1 + jitter*(2*rand.Float64()-1)
+	}
+	return time.Duration(d)
+}
+
+// idempotent returns whether a request can be repeated without changing its
+// outcome.
+func (r *Request) idempotent() bool {
+	switch r.Method {
+	case "GET", "PATCH", "DELETE":
+		return true
+	case "POST":
+		return r.Command == "print" || r.Command == "set"
+	}
+	return false
+}
+
+// doRetry performs a request, retrying it according to the client's
+// RetryPolicy.
+func (c *Client) doRetry(ctx context.Context, req *Request) (io.ReadCloser, error) {
+	p := c.Retry
+	if req.Method != "PUT" && !req.idempotent() {
+		return c.transport(ctx, req)
+	}
+
+	// To know whether a failed add created a record, remember which records
+	// matching it existed before. This can't wait until an attempt fails, as
+	// by then that attempt might have created one. If the records can't be
+	// listed, the add is attempted once, without retrying.
+	var before map[RecordID]bool
+	if req.Method == "PUT" {
+		var err error
+		before, err = c.matchingIDs(ctx, req)
+		if err != nil {
+			return c.transport(ctx, req)
+		}
+	}
+
+	for attempt := 1; ; attempt++ {
+		body, err := c.transport(ctx, req)
+		if err == nil {
+			return body, nil
+		}
+		// A previous attempt might have removed the record before failing.
+		if req.Method == "DELETE" && attempt > 1 && IsNotFound(err) {
+			return ioutil.NopCloser(strings.NewReader("")), nil
+		}
+		if attempt >= p.maxAttempts() || !p.retryable(err) {
+			if attempt > 1 {
+				return nil, fmt.Errorf("after %d attempts: %w", attempt, err)
+			}
+			return nil, err
+		}
+
+		t := time.NewTimer(p.backoff(attempt))
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return nil, ctx.Err()
+		case <-t.C:
+		}
+
+		if req.Method == "PUT" {
+			after, err := c.matchingIDs(ctx, req)
+			if err != nil {
+				return nil, fmt.Errorf("could not check whether add succeeded: %w", err)
+			}
+			for _, id := range sortedIDs(after) {
+				if before[id] {
+					continue
+				}
+				// The failed attempt created the record, return it as
+				// a successful add would.
+				return c.doRetry(ctx, &Request{Method: "GET", Menu: req.Menu, ID: id})
+			}
+		}
+	}
+}
+
+// matchingIDs returns the IDs of all records which have all properties set to
+// the values in the body of an add request. Arguments of the add which aren't
+// properties of the menu's records, eg. place-before, are not matched.
+func (c *Client) matchingIDs(ctx context.Context, req *Request) (map[RecordID]bool, error) {
+	var values map[string]string
+	if err := json.Unmarshal(req.Body, &values); err != nil {
+		return nil, fmt.Errorf("add body did not serialize into strings: %w", err)
+	}
+	schema := LookupMenu(req.Menu)
+	var keys []string
+	for k := range values {
+		if schema != nil && schema.Property(k) == nil {
+			continue
+		}
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	query := struct {
+		Proplist []string `json:".proplist"`
+		Query    []string `json:".query,omitempty"`
+	}{
+		Proplist: []string{".id"},
+	}
+	for _, k := range keys {
+		query.Query = append(query.Query, fmt.Sprintf("%s=%s", k, values[k]))
+	}
+	rdata, err := json.Marshal(query)
+	if err != nil {
+		return nil, err
+	}
+	body, err := c.doRetry(ctx, &Request{Method: "POST", Menu: req.Menu, Command: "print", Body: rdata})
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+	var rows []Record
+	if err := decodeRows(body, &rows); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	res := make(map[RecordID]bool)
+	for _, r := range rows {
+		res[r.ID] = true
+	}
+	return res, nil
+}
+
+// sortedIDs returns IDs in the order they were created in, ie. by the hex
+// number after the '*'. IDs not in that form are sorted last, as strings.
+func sortedIDs(ids map[RecordID]bool) []RecordID {
+	var res []RecordID
+	for id := range ids {
+		res = append(res, id)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		a, aerr := strconv.ParseUint(strings.TrimPrefix(string(res[i]), "*"), 16, 64)
+		b, berr := strconv.ParseUint(strings.TrimPrefix(string(res[j]), "*"), 16, 64)
+		switch {
+		case aerr == nil && berr == nil:
+			return a < b
+		case aerr == nil || berr == nil:
+			return aerr == nil
+		}
+		return res[i] < res[j]
+	})
+	return res
+}
@@ -0,0 +1,258 @@
+package ros
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"sync"
+	"syscall"
+	"testing"
+	"time"
+)
+
+// flakyServer is a fake ROS serving a single vlan table, which fails requests
+// as instructed by fail. It records all requests made.
+type flakyServer struct {
+	mu       sync.Mutex
+	rows     map[string]string
+	requests []string
+	// fail is called for every request, and returns the status to fail it
+	// with (after handling it, if after is set), or 0.
+	fail func(req string) (status int, after bool)
+}
+
+func (f *flakyServer) handle(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	body, _ := ioutil.ReadAll(r.Body)
+	req := r.Method + " " + r.URL.Path
+	f.requests = append(f.requests, req)
+	status, after := f.fail(req)
+	if status != 0 && !after {
+		w.WriteHeader(status)
+		return
+	}
+	var res string
+	switch req {
+	case "PUT /rest/interface/bridge/vlan":
+		id := "*1"
+		if f.rows[id] != "" {
+			id = "*2"
+		}
+		f.rows[id] = string(body)
+		res = `{".id":"` + id + `"}`
+	case "POST /rest/interface/bridge/vlan/print":
+		res = "["
+		for id := range f.rows {
+			res += `{".id":"` + id + `"}`
+		}
+		res += "]"
+	case "GET /rest/interface/bridge/vlan/*1", "GET /rest/interface/bridge/vlan/*2":
+		res = `{".id":"` + r.URL.Path[len(r.URL.Path)-2:] + `","vlan-ids":"10"}`
+	case "DELETE /rest/interface/bridge/vlan/*1":
+		if _, ok := f.rows["*1"]; !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		delete(f.rows, "*1")
+		w.WriteHeader(http.StatusNoContent)
+		return
+	default:
+		res = "[]"
+	}
+	if status != 0 {
+		w.WriteHeader(status)
+		return
+	}
+	w.Write([]byte(res))
+}
+
+func (f *flakyServer) count(req string) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	n := 0
+	for _, r := range f.requests {
+		if r == req {
+			n++
+		}
+	}
+	return n
+}
+
+func testFlaky(t *testing.T, fail func(req string) (int, bool)) (*Client, *flakyServer) {
+	f := &flakyServer{rows: make(map[string]string), fail: fail}
+	c := testClient(t, f.handle)
+	c.Retry = &RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: time.Millisecond,
+	}
+	return c, f
+}
+
+// failFirst fails the first n requests matching req.
+func failFirst(req string, n int, status int, after bool) func(string) (int, bool) {
+	return func(r string) (int, bool) {
+		if r != req || n == 0 {
+			return 0, false
+		}
+		n--
+		return status, after
+	}
+}
+
+// TestRetry ensures idempotent requests are retried on retryable errors, and
+// that others are not.
+func TestRetry(t *testing.T) {
+	ctx := context.Background()
+
+	c, f := testFlaky(t, failFirst("GET /rest/interface/bridge/vlan/*1", 2, http.StatusServiceUnavailable, false))
+	if _, err := c.InterfaceBridgeVlanGet(ctx, "*1"); err != nil {
+		t.Errorf("Get should have succeeded after retries, got %v", err)
+	}
+	if want, got := 3, f.count("GET /rest/interface/bridge/vlan/*1"); want != got {
+		t.Errorf("wanted %d attempts, got %d", want, got)
+	}
+
+	c, f = testFlaky(t, failFirst("GET /rest/interface/bridge/vlan/*1", 3, http.StatusServiceUnavailable, false))
+	if _, err := c.InterfaceBridgeVlanGet(ctx, "*1"); err == nil {
+		t.Errorf("Get should have failed after running out of attempts")
+	}
+
+	c, f = testFlaky(t, failFirst("GET /rest/interface/bridge/vlan/*1", 1, http.StatusBadRequest, false))
+	if _, err := c.InterfaceBridgeVlanGet(ctx, "*1"); !IsBadRequest(err) {
+		t.Errorf("Get should have failed with bad request, got %v", err)
+	}
+	if want, got := 1, f.count("GET /rest/interface/bridge/vlan/*1"); want != got {
+		t.Errorf("wanted %d attempts, got %d", want, got)
+	}
+
+	c, f = testFlaky(t, failFirst("POST /rest/system/reboot", 1, http.StatusServiceUnavailable, false))
+	if err := c.SystemReboot(ctx); err == nil {
+		t.Errorf("Reboot should have failed")
+	}
+	if want, got := 1, f.count("POST /rest/system/reboot"); want != got {
+		t.Errorf("commands should not be retried, got %d attempts", got)
+	}
+
+	// The record is removed, but the response lost.
+	c, f = testFlaky(t, failFirst("DELETE /rest/interface/bridge/vlan/*1", 1, http.StatusBadGateway, true))
+	f.rows["*1"] = "{}"
+	if err := c.InterfaceBridgeVlanRemove(ctx, "*1"); err != nil {
+		t.Errorf("Remove should have succeeded, got %v", err)
+	}
+}
+
+// TestRetryAdd ensures adds are only retried if the failed attempt did not
+// create the record, and are still attempted if that can't be checked.
+func TestRetryAdd(t *testing.T) {
+	ctx := context.Background()
+	u := &InterfaceBridgeVlan_Update{
+		Bridge:  StringPtr("bridge1"),
+		VlanIDs: NumberPtr(10),
+	}
+
+	// The record is created, but the response lost.
+	c, f := testFlaky(t, failFirst("PUT /rest/interface/bridge/vlan", 1, http.StatusGatewayTimeout, true))
+	v, err := c.InterfaceBridgeVlanAdd(ctx, u)
+	if err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+	if v.ID != "*1" {
+		t.Errorf("Add should have returned the created record, got %+v", v)
+	}
+	if want, got := 1, f.count("PUT /rest/interface/bridge/vlan"); want != got {
+		t.Errorf("wanted %d adds, got %d", want, got)
+	}
+	if want, got := 1, len(f.rows); want != got {
+		t.Errorf("wanted %d records, got %d", want, got)
+	}
+
+	// The request is lost before the record is created.
+	c, f = testFlaky(t, failFirst("PUT /rest/interface/bridge/vlan", 1, http.StatusGatewayTimeout, false))
+	if _, err := c.InterfaceBridgeVlanAdd(ctx, u); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+	if want, got := 2, f.count("PUT /rest/interface/bridge/vlan"); want != got {
+		t.Errorf("wanted %d adds, got %d", want, got)
+	}
+	if want, got := 1, len(f.rows); want != got {
+		t.Errorf("wanted %d records, got %d", want, got)
+	}
+
+	// The records can't be listed before adding.
+	c, f = testFlaky(t, failFirst("POST /rest/interface/bridge/vlan/print", 1, http.StatusBadRequest, false))
+	if _, err := c.InterfaceBridgeVlanAdd(ctx, u); err != nil {
+		t.Fatalf("Add without listing: %v", err)
+	}
+	if want, got := 1, f.count("PUT /rest/interface/bridge/vlan"); want != got {
+		t.Errorf("wanted %d adds, got %d", want, got)
+	}
+}
+
+// TestIsRetryable ensures only transient errors are retryable.
+func TestIsRetryable(t *testing.T) {
+	for i, te := range []struct {
+		err  error
+		want bool
+	}{
+		{&APIError{Status: http.StatusServiceUnavailable}, true},
+		{&APIError{Status: http.StatusBadRequest}, false},
+		{&url.Error{Op: "Get", URL: "https://sw1/rest", Err: &net.OpError{Op: "dial", Err: syscall.ECONNREFUSED}}, true},
+		{fmt.Errorf("could not read: %w", syscall.ECONNRESET), true},
+		{&url.Error{Op: "Get", URL: "https://sw1/rest", Err: io.EOF}, true},
+		{io.ErrUnexpectedEOF, true},
+		{&url.Error{Op: "Get", URL: "https://sw1/rest", Err: x509.UnknownAuthorityError{}}, false},
+		{&url.Error{Op: "Get", URL: "https://sw1/rest", Err: x509.HostnameError{Host: "sw1"}}, false},
+		{&url.Error{Op: "Get", URL: "https://sw1/rest", Err: ErrFingerprintMismatch}, false},
+		{&url.Error{Op: "Get", URL: "https://sw1", Err: errors.New("unsupported protocol scheme")}, false},
+		{fmt.Errorf("could not get credentials: %w", os.ErrNotExist), false},
+		{&json.SyntaxError{}, false},
+		{context.DeadlineExceeded, false},
+	} {
+		if got := IsRetryable(te.err); got != te.want {
+			t.Errorf("%d: IsRetryable(%v): wanted %v, got %v", i, te.err, te.want, got)
+		}
+	}
+}
+
+// TestSortedIDs ensures IDs are sorted by their numeric value.
+func TestSortedIDs(t *testing.T) {
+	ids := map[RecordID]bool{"*A": true, "*9": true, "*10": true, "*1": true, "other": true}
+	want := []RecordID{"*1", "*9", "*A", "*10", "other"}
+	got := sortedIDs(ids)
+	if len(got) != len(want) {
+		t.Fatalf("wanted %v, got %v", want, got)
+	}
+	for i := range want {
+		if want[i] != got[i] {
+			t.Fatalf("wanted %v, got %v", want, got)
+		}
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	p := &RetryPolicy{
+		InitialBackoff: 100 * time.Millisecond,
+		MaxBackoff:     time.Second,
+		Jitter:         -1,
+	}
+	for i, want := range []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond, 800 * time.Millisecond, time.Second} {
+		if got := p.backoff(i + 1); want != got {
+			t.Errorf("retry %d: wanted %v, got %v", i+1, want, got)
+		}
+	}
+	p.Jitter = 0.5
+	for i := 0; i < 100; i++ {
+		if d := p.backoff(1); d < 50*time.Millisecond || d > 150*time.Millisecond {
+			t.Fatalf("jittered backoff %v out of range", d)
+		}
+	}
+}
@@ -0,0 +1,130 @@
+package ros
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Row is a ROS record in serialized form, ie. a map from ROS property name to
+// ROS value, as sent and returned by the API. It's used by code which works on
+// any menu by way of its MenuSchema, instead of on generated types.
+type Row map[string]string
+
+// ID returns the ID of the record, if any.
+func (r Row) ID() RecordID {
+	return RecordID(r[".id"])
+}
+
+// RowsOf serializes a generated record, _Update struct, or a slice of either,
+// into Rows.
+func RowsOf(v interface{}) ([]Row, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var rows []Row
+	if err := decodeRows(bytes.NewReader(data), &rows); err != nil {
+		return nil, fmt.Errorf("value did not serialize into rows: %w", err)
+	}
+	return rows, nil
+}
+
+// ListRows returns all records of a menu as Rows.
+func (c *Client) ListRows(ctx context.Context, menu string) ([]Row, error) {
+	body, err := c.doGET(ctx, menu, "", nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not GET: %w", err)
+	}
+	defer body.Close()
+
+	var target []Row
+	if err := decodeRows(body, &target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return target, nil
+}
+
+// AddRow creates a new record in a menu, returning it as created by ROS.
+func (c *Client) AddRow(ctx context.Context, menu string, row Row) (Row, error) {
+	rdata, err := json.Marshal(row)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal row: %w", err)
+	}
+	body, err := c.doPUT(ctx, menu, rdata)
+	if err != nil {
+		return nil, fmt.Errorf("could not PUT: %w", err)
+	}
+	defer body.Close()
+
+	var target Row
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return target, nil
+}
+
+// AddRowBefore creates a new record in an ordered menu, placed before the
+// record with the given ID, returning it as created by ROS.
+func (c *Client) AddRowBefore(ctx context.Context, menu string, row Row, before RecordID) (Row, error) {
+	placed := make(Row)
+	for k, v := range row {
+		placed[k] = v
+	}
+	placed["place-before"] = string(before)
+	return c.AddRow(ctx, menu, placed)
+}
+
+// MoveRows moves records of an ordered menu, in the given order, before the
+// record with ID before, or to the end if before is empty.
+func (c *Client) MoveRows(ctx context.Context, menu string, ids []RecordID, before RecordID) error {
+	numbers := make([]string, len(ids))
+	for i, id := range ids {
+		numbers[i] = string(id)
+	}
+	args := Row{"numbers": strings.Join(numbers, ",")}
+	if before != "" {
+		args["destination"] = string(before)
+	}
+	rdata, err := json.Marshal(args)
+	if err != nil {
+		return fmt.Errorf("could not marshal arguments: %w", err)
+	}
+	body, err := c.doPOST(ctx, menu, "move", rdata)
+	if err != nil {
+		return fmt.Errorf("could not POST: %w", err)
+	}
+	body.Close()
+	return nil
+}
+
+// PatchRow updates the given properties of a record in a menu by ID.
+func (c *Client) PatchRow(ctx context.Context, menu string, id RecordID, row Row) (Row, error) {
+	rdata, err := json.Marshal(row)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal row: %w", err)
+	}
+	body, err := c.doPATCH(ctx, menu, id, rdata)
+	if err != nil {
+		return nil, fmt.Errorf("could not PATCH: %w", err)
+	}
+	defer body.Close()
+
+	var target Row
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return target, nil
+}
+
+// RemoveRow deletes a record from a menu by ID.
+func (c *Client) RemoveRow(ctx context.Context, menu string, id RecordID) error {
+	body, err := c.doDELETE(ctx, menu, id)
+	if err != nil {
+		return fmt.Errorf("could not DELETE: %w", err)
+	}
+	body.Close()
+	return nil
+}
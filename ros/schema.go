@@ -0,0 +1,255 @@
+package ros
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// Kind is the kind of value of a ROS property, as declared in the generator
+// schema.
+type Kind int
+
+const (
+	KindString Kind = iota
+	KindNumber
+	KindBoolean
+	KindStringList
+	KindNumberList
+	KindEnum
+	KindIP
+	KindIPPrefix
+	KindMAC
+	KindDuration
+	KindBytes
+	KindRate
+	KindGatewayList
+	KindEnumList
+	KindPortList
+	KindIPRangeList
+	KindRatePair
+	KindIPPrefixList
+)
+
+// PropertySchema describes a property of a ROS record, or an argument or reply
+// property of a ROS command.
+type PropertySchema struct {
+	// Name is the ROS name of the property, eg. vlan-ids.
+	Name string
+	Kind Kind
+	// ReadOnly is set for properties which cannot be set by the user, eg.
+	// dynamic.
+	ReadOnly bool
+	// Variants are the known values of a KindEnum or KindEnumList property.
+	Variants []string
+	// Open is set for KindEnum and KindEnumList properties which accept any
+	// value besides Variants, eg. user-defined firewall chains.
+	Open bool
+	// Since is the first ROS version which has this property, or zero if
+	// present in all versions.
+	Since Version
+	// Until is the first ROS version which no longer has this property, or
+	// zero if not removed.
+	Until Version
+}
+
+// Supported returns whether the property is present in the given ROS version.
+func (p *PropertySchema) Supported(v Version) bool {
+	return v.inRange(p.Since, p.Until)
+}
+
+// codec is implemented by pointers to all ROS value types.
+type codec interface {
+	json.Marshaler
+	json.Unmarshaler
+}
+
+func (p *PropertySchema) codec() codec {
+	switch p.Kind {
+	case KindNumber:
+		return new(Number)
+	case KindBoolean:
+		return new(Boolean)
+	case KindStringList:
+		return new(StringList)
+	case KindNumberList:
+		return new(NumberList)
+	case KindIP:
+		return new(IP)
+	case KindIPPrefix:
+		return new(IPNet)
+	case KindMAC:
+		return new(MAC)
+	case KindDuration:
+		return new(Duration)
+	case KindBytes:
+		return new(Bytes)
+	case KindRate:
+		return new(Rate)
+	case KindGatewayList:
+		return new(GatewayList)
+	case KindPortList:
+		return new(PortList)
+	case KindIPRangeList:
+		return new(IPRangeList)
+	case KindRatePair:
+		return new(RatePair)
+	case KindIPPrefixList:
+		return new(IPNetList)
+	}
+	return nil
+}
+
+// known returns whether a value is accepted by a KindEnum or KindEnumList
+// property.
+func (p *PropertySchema) known(value string) bool {
+	if p.Open {
+		return true
+	}
+	for _, v := range p.Variants {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// Canonical validates a serialized ROS value of this property, and returns it
+// in the form this library would serialize it, eg. 0x10 becomes 16 for
+// numbers.
+func (p *PropertySchema) Canonical(value string) (string, error) {
+	switch p.Kind {
+	case KindString:
+		return value, nil
+	case KindEnum:
+		if !p.known(value) {
+			return "", fmt.Errorf("unknown %s value %q", p.Name, value)
+		}
+		return value, nil
+	case KindEnumList:
+		not, values := parseEnumList(value)
+		for _, v := range values {
+			if !p.known(v) {
+				return "", fmt.Errorf("unknown %s value %q", p.Name, v)
+			}
+		}
+		return formatEnumList(not, values), nil
+	}
+	c := p.codec()
+	if c == nil {
+		return "", fmt.Errorf("unknown kind %d of %s", p.Kind, p.Name)
+	}
+	in, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	if err := c.UnmarshalJSON(in); err != nil {
+		return "", fmt.Errorf("invalid %s: %w", p.Name, err)
+	}
+	out, err := c.MarshalJSON()
+	if err != nil {
+		return "", fmt.Errorf("invalid %s: %w", p.Name, err)
+	}
+	var res string
+	if err := json.Unmarshal(out, &res); err != nil {
+		return "", err
+	}
+	return res, nil
+}
+
+// CommandSchema describes a ROS command within a menu.
+type CommandSchema struct {
+	// Name is the ROS name of the command, eg. check-for-updates.
+	Name      string
+	Arguments []*PropertySchema
+	Reply     []*PropertySchema
+}
+
+// MenuSchema describes a ROS menu known to this library, ie. one described in
+// gen/types.text.pb.
+type MenuSchema struct {
+	// Path of the menu, eg. interface/bridge/vlan. Empty for the root menu.
+	Path string
+	// Table is set for menus containing a list of records with IDs.
+	Table bool
+	// Singleton is set for menus containing a single record without an ID.
+	Singleton bool
+	// Key is the list of properties which together uniquely identify a record
+	// within a Table menu, if declared, eg. bridge and vlan-ids.
+	Key []string
+	// Ordered is set for Table menus whose record order is significant, eg.
+	// firewall rules. Records of these can be moved, and added before a given
+	// record.
+	Ordered bool
+	// Properties of the menu's records, if any.
+	Properties []*PropertySchema
+	// Commands available within the menu.
+	Commands []*CommandSchema
+	// Since and Until limit the ROS versions which have this menu, as in
+	// PropertySchema.
+	Since Version
+	Until Version
+}
+
+// Supported returns whether the menu is present in the given ROS version.
+func (m *MenuSchema) Supported(v Version) bool {
+	return v.inRange(m.Since, m.Until)
+}
+
+// Property returns the schema of a record property by name, or nil if
+// not found.
+func (m *MenuSchema) Property(name string) *PropertySchema {
+	for _, p := range m.Properties {
+		if p.Name == name {
+			return p
+		}
+	}
+	return nil
+}
+
+// Command returns the schema of a command by name, or nil if not found.
+func (m *MenuSchema) Command(name string) *CommandSchema {
+	for _, c := range m.Commands {
+		if c.Name == name {
+			return c
+		}
+	}
+	return nil
+}
+
+var (
+	menusMu sync.RWMutex
+	menus   = make(map[string]*MenuSchema)
+)
+
+// registerMenu is called by generated code to make a menu schema available
+// through Menus and LookupMenu.
+func registerMenu(m *MenuSchema) {
+	menusMu.Lock()
+	defer menusMu.Unlock()
+	menus[m.Path] = m
+}
+
+// Menus returns the schemas of all menus known to this library, sorted by
+// path.
+func Menus() []*MenuSchema {
+	menusMu.RLock()
+	defer menusMu.RUnlock()
+	var res []*MenuSchema
+	for _, m := range menus {
+		res = append(res, m)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Path < res[j].Path
+	})
+	return res
+}
+
+// LookupMenu returns the schema of a menu by path (eg. interface/bridge/vlan),
+// or nil if the menu is not known to this library.
+func LookupMenu(path string) *MenuSchema {
+	menusMu.RLock()
+	defer menusMu.RUnlock()
+	return menus[path]
+}
@@ -0,0 +1,47 @@
+package ros
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// TestDiff ensures generated Diff functions only set differing settable
+// fields, and that ToUpdate sets all of them.
+func TestDiff(t *testing.T) {
+	current := &InterfaceBridgeVlan{
+		Bridge:        "bridge1",
+		VlanIDs:       3005,
+		Tagged:        StringList{"ether1"},
+		CurrentTagged: StringList{"ether1"},
+	}
+	desired := *current
+	desired.CurrentTagged = StringList{"ether1", "ether2"}
+	desired.Dynamic = true
+
+	u := DiffInterfaceBridgeVlan(current, &desired)
+	if !u.IsEmpty() {
+		t.Errorf("read-only changes should result in empty update, got %+v", u)
+	}
+
+	desired.Tagged = StringList{"ether1", "ether8"}
+	desired.Disabled = true
+	u = DiffInterfaceBridgeVlan(current, &desired)
+	if u.IsEmpty() {
+		t.Fatalf("update should not be empty")
+	}
+	data, err := json.Marshal(u)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	if want, got := `{"disabled":"true","tagged":"ether1,ether8"}`, string(data); want != got {
+		t.Errorf("wanted update %s, got %s", want, got)
+	}
+
+	data, err = json.Marshal(current.ToUpdate())
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	if want, got := `{"bridge":"bridge1","disabled":"false","tagged":"ether1","untagged":"","vlan-ids":"3005"}`, string(data); want != got {
+		t.Errorf("wanted update %s, got %s", want, got)
+	}
+}
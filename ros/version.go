@@ -0,0 +1,234 @@
+package ros
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Version is a ROS version, eg. 7.15.2. Pre-release versions (eg. 7.16beta3)
+// are treated as the version they precede.
+type Version struct {
+	Major int
+	Minor int
+	Patch int
+}
+
+var reVersion = regexp.MustCompile(`^(\d+)\.(\d+)(?:\.(\d+))?`)
+
+// ParseVersion parses a ROS version as returned by system/resource, eg. 7.15.2
+// (stable) or 7.16beta3 (testing).
+func ParseVersion(s string) (Version, error) {
+	m := reVersion.FindStringSubmatch(strings.TrimSpace(s))
+	if m == nil {
+		return Version{}, fmt.Errorf("invalid version %q", s)
+	}
+	var v Version
+	v.Major, _ = strconv.Atoi(m[1])
+	v.Minor, _ = strconv.Atoi(m[2])
+	if m[3] != "" {
+		v.Patch, _ = strconv.Atoi(m[3])
+	}
+	return v, nil
+}
+
+// IsZero returns whether the version is unset.
+func (v Version) IsZero() bool {
+	return v == Version{}
+}
+
+// Less returns whether v is older than o.
+func (v Version) Less(o Version) bool {
+	if v.Major != o.Major {
+		return v.Major < o.Major
+	}
+	if v.Minor != o.Minor {
+		return v.Minor < o.Minor
+	}
+	return v.Patch < o.Patch
+}
+
+func (v Version) String() string {
+	if v.Patch == 0 {
+		return fmt.Sprintf("%d.%d", v.Major, v.Minor)
+	}
+	return fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+}
+
+// inRange returns whether v is within [since, until), with zero bounds being
+// unbounded.
+func (v Version) inRange(since, until Version) bool {
+	if !since.IsZero() && v.Less(since) {
+		return false
+	}
+	if !until.IsZero() && !v.Less(until) {
+		return false
+	}
+	return true
+}
+
+// ErrUnsupportedOnVersion is returned (wrapped in an *UnsupportedError) when a
+// request uses a menu or property which is not supported by the ROS version
+// running on the device. It can be checked for using errors.Is.
+var ErrUnsupportedOnVersion = errors.New("unsupported on this ROS version")
+
+// UnsupportedError is returned when a request uses a menu or property which is
+// not supported by the ROS version running on the device.
+type UnsupportedError struct {
+	// Menu is the path of the menu, eg. interface/bridge/port.
+	Menu string
+	// Property is the name of the property, or empty if the menu itself is
+	// unsupported.
+	Property string
+	// Version is the ROS version running on the device.
+	Version Version
+	// Since and Until are the versions supporting the menu or property, as
+	// in PropertySchema.
+	Since Version
+	Until Version
+}
+
+func (e *UnsupportedError) Error() string {
+	what := e.Menu
+	if e.Property != "" {
+		what += " property " + e.Property
+	}
+	var bounds []string
+	if !e.Since.IsZero() {
+		bounds = append(bounds, "since "+e.Since.String())
+	}
+	if !e.Until.IsZero() {
+		bounds = append(bounds, "until "+e.Until.String())
+	}
+	return fmt.Sprintf("%s (%s) is %v %s", what, strings.Join(bounds, ", "), ErrUnsupportedOnVersion, e.Version)
+}
+
+func (e *UnsupportedError) Unwrap() error {
+	return ErrUnsupportedOnVersion
+}
+
+// deviceVersion returns the ROS version running on the device, retrieving it
+// from system/resource on first use, unless Client.Version is set.
+func (c *Client) deviceVersion(ctx context.Context) (Version, error) {
+	if c.Version != "" {
+		return ParseVersion(c.Version)
+	}
+	c.versionMu.Lock()
+	defer c.versionMu.Unlock()
+	if c.version != nil {
+		return *c.version, nil
+	}
+	req := &Request{Method: "GET", Menu: "system/resource"}
+	var body io.ReadCloser
+	var err error
+	if c.Retry != nil {
+		body, err = c.doRetry(ctx, req)
+	} else {
+		body, err = c.transport(ctx, req)
+	}
+	if err != nil {
+		return Version{}, fmt.Errorf("could not get ROS version: %w", err)
+	}
+	defer body.Close()
+	var res struct {
+		Version string `json:"version"`
+	}
+	if err := json.NewDecoder(body).Decode(&res); err != nil {
+		return Version{}, fmt.Errorf("could not decode ROS version: %w", err)
+	}
+	v, err := ParseVersion(res.Version)
+	if err != nil {
+		return Version{}, err
+	}
+	c.version = &v
+	return v, nil
+}
+
+// gate checks a request against the version gates of the menu and properties
+// it uses. Unsupported properties are removed from update bodies, or refused
+// if Client.StrictVersion is set. Unsupported menus and properties used in
+// filters are always refused. The device version is only retrieved if the
+// request uses any gated menu or property.
+func (c *Client) gate(ctx context.Context, req *Request) error {
+	m := LookupMenu(req.Menu)
+	if m == nil {
+		return nil
+	}
+	properties := m.Properties
+	if cmd := m.Command(req.Command); cmd != nil {
+		properties = cmd.Arguments
+	}
+	gated := make(map[string]*PropertySchema)
+	for _, p := range properties {
+		if !p.Since.IsZero() || !p.Until.IsZero() {
+			gated[p.Name] = p
+		}
+	}
+	if m.Since.IsZero() && m.Until.IsZero() && len(gated) == 0 {
+		return nil
+	}
+
+	v, err := c.deviceVersion(ctx)
+	if err != nil {
+		return err
+	}
+	if !v.inRange(m.Since, m.Until) {
+		return &UnsupportedError{Menu: m.Path, Version: v, Since: m.Since, Until: m.Until}
+	}
+	unsupported := func(name string) *UnsupportedError {
+		p, ok := gated[name]
+		if !ok || v.inRange(p.Since, p.Until) {
+			return nil
+		}
+		return &UnsupportedError{Menu: m.Path, Property: name, Version: v, Since: p.Since, Until: p.Until}
+	}
+
+	for k := range req.Query {
+		if err := unsupported(k); err != nil {
+			return err
+		}
+	}
+	if len(req.Body) == 0 {
+		return nil
+	}
+	var body map[string]json.RawMessage
+	if err := json.Unmarshal(req.Body, &body); err != nil {
+		return nil
+	}
+	if req.Command == "print" {
+		var query []string
+		if err := json.Unmarshal(body[".query"], &query); err == nil {
+			for _, q := range query {
+				if err := unsupported(strings.SplitN(q, "=", 2)[0]); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+	changed := false
+	for k := range body {
+		err := unsupported(k)
+		if err == nil {
+			continue
+		}
+		if c.StrictVersion {
+			return err
+		}
+		delete(body, k)
+		changed = true
+	}
+	if changed {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		req.Body = data
+	}
+	return nil
+}
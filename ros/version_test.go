@@ -0,0 +1,106 @@
+package ros
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestParseVersion(t *testing.T) {
+	for _, te := range []struct {
+		in   string
+		want Version
+	}{
+		{"7.15.2 (stable)", Version{7, 15, 2}},
+		{"7.16beta3 (testing)", Version{7, 16, 0}},
+		{"7.1rc4", Version{7, 1, 0}},
+		{"7.10", Version{7, 10, 0}},
+	} {
+		got, err := ParseVersion(te.in)
+		if err != nil {
+			t.Errorf("%q: %v", te.in, err)
+			continue
+		}
+		if got != te.want {
+			t.Errorf("%q: wanted %v, got %v", te.in, te.want, got)
+		}
+	}
+	if _, err := ParseVersion("stable"); err == nil {
+		t.Errorf("ParseVersion should have failed")
+	}
+	if !(Version{7, 9, 0}).Less(Version{7, 10, 0}) {
+		t.Errorf("7.9 should be less than 7.10")
+	}
+}
+
+// TestVersionGating ensures properties unsupported by the device's version
+// are left out of updates or refused, and that the version is only retrieved
+// when needed.
+func TestVersionGating(t *testing.T) {
+	ctx := context.Background()
+	version := "7.14.3 (stable)"
+	var resourceGets int
+	var gotBody string
+	c := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/rest/system/resource" {
+			resourceGets++
+			w.Write([]byte(`{"version":"` + version + `","uptime":"1d"}`))
+			return
+		}
+		body, _ := ioutil.ReadAll(r.Body)
+		gotBody = string(body)
+		w.Write([]byte(`{".id":"*1"}`))
+	})
+
+	if _, err := c.InterfaceBridgeVlanPatch(ctx, "*1", &InterfaceBridgeVlan_Update{Disabled: BooleanPtr(true)}); err != nil {
+		t.Fatalf("Patch vlan: %v", err)
+	}
+	if resourceGets != 0 {
+		t.Errorf("version should not have been retrieved for ungated menu")
+	}
+
+	u := &InterfaceBridgePort_Update{
+		PVID:               NumberPtr(10),
+		MVRPRegistrarState: new(InterfaceBridgePort_MVRPRegistrarState),
+	}
+	*u.MVRPRegistrarState = InterfaceBridgePort_MVRPRegistrarStateFixed
+	if _, err := c.InterfaceBridgePortPatch(ctx, "*1", u); err != nil {
+		t.Fatalf("Patch port: %v", err)
+	}
+	if want, got := `{"pvid":"10"}`, gotBody; want != got {
+		t.Errorf("wanted body %s, got %s", want, got)
+	}
+
+	c.StrictVersion = true
+	_, err := c.InterfaceBridgePortPatch(ctx, "*1", u)
+	if !errors.Is(err, ErrUnsupportedOnVersion) {
+		t.Errorf("strict Patch should have failed with ErrUnsupportedOnVersion, got %v", err)
+	}
+	var uerr *UnsupportedError
+	if !errors.As(err, &uerr) || uerr.Property != "mvrp-registrar-state" {
+		t.Errorf("wanted UnsupportedError for mvrp-registrar-state, got %v", err)
+	}
+
+	_, err = c.InterfaceBridgePortFind(ctx, &InterfaceBridgePort_Filter{MVRPRegistrarState: u.MVRPRegistrarState})
+	if !errors.Is(err, ErrUnsupportedOnVersion) {
+		t.Errorf("Find should have failed with ErrUnsupportedOnVersion, got %v", err)
+	}
+	if want, got := 1, resourceGets; want != got {
+		t.Errorf("wanted version retrieved %d times, got %d", want, got)
+	}
+
+	c = testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		gotBody = string(body)
+		w.Write([]byte(`{".id":"*1"}`))
+	})
+	c.Version = "7.15"
+	if _, err := c.InterfaceBridgePortPatch(ctx, "*1", u); err != nil {
+		t.Fatalf("Patch port: %v", err)
+	}
+	if want, got := `{"pvid":"10","mvrp-registrar-state":"fixed"}`, gotBody; want != got {
+		t.Errorf("wanted body %s, got %s", want, got)
+	}
+}
@@ -0,0 +1,130 @@
+package ros
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// EventType is the type of a change observed by a generated Watch method.
+type EventType int
+
+const (
+	// EventAdded is sent when a record appears, including for every record
+	// present when the watch starts.
+	EventAdded EventType = iota + 1
+	// EventModified is sent when any property of a record changes.
+	EventModified
+	// EventRemoved is sent when a record disappears.
+	EventRemoved
+	// EventError is sent when polling fails. Polling continues, with
+	// backoff.
+	EventError
+)
+
+func (t EventType) String() string {
+	switch t {
+	case EventAdded:
+		return "added"
+	case EventModified:
+		return "modified"
+	case EventRemoved:
+		return "removed"
+	case EventError:
+		return "error"
+	}
+	return "unknown"
+}
+
+// watchedRecord is a record returned by a poll of a watch, as a pointer to a
+// generated record type.
+type watchedRecord struct {
+	id     RecordID
+	record interface{}
+}
+
+// watchEmitFunc sends an event of a watch, returning false if the watch should
+// stop.
+type watchEmitFunc func(t EventType, before, after interface{}, err error) bool
+
+// watch implements the generated Watch methods: it polls list every interval,
+// diffs the records by ID and value, and emits the changes, until the context
+// is canceled or emit returns false. After consecutive errors, the delay
+// doubles up to 32 times the interval. A non-positive interval is refused
+// with a single EventError. done is called when the watch stops.
+func watch(ctx context.Context, interval time.Duration, list func(ctx context.Context) ([]watchedRecord, error), emit watchEmitFunc, done func()) {
+	defer done()
+	if interval <= 0 {
+		emit(EventError, nil, nil, fmt.Errorf("invalid interval %v, must be positive", interval))
+		return
+	}
+	backoff := &RetryPolicy{
+		InitialBackoff: interval,
+		MaxBackoff:     32 * interval,
+	}
+	var prev []watchedRecord
+	failures := 0
+	for {
+		delay := interval
+		cur, err := list(ctx)
+		switch {
+		case ctx.Err() != nil:
+			return
+		case err != nil:
+			failures++
+			delay = backoff.backoff(failures + 1)
+			if !emit(EventError, nil, nil, err) {
+				return
+			}
+		default:
+			failures = 0
+			if !watchDiff(prev, cur, emit) {
+				return
+			}
+			prev = cur
+		}
+
+		t := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return
+		case <-t.C:
+		}
+	}
+}
+
+// watchDiff emits the changes between two polls, returning false if emit
+// did. Additions and modifications are emitted in the order of cur, removals
+// in the order of prev.
+func watchDiff(prev, cur []watchedRecord, emit watchEmitFunc) bool {
+	before := make(map[RecordID]interface{})
+	for _, r := range prev {
+		before[r.id] = r.record
+	}
+	seen := make(map[RecordID]bool)
+	for _, r := range cur {
+		seen[r.id] = true
+		b, ok := before[r.id]
+		switch {
+		case !ok:
+			if !emit(EventAdded, nil, r.record, nil) {
+				return false
+			}
+		case !reflect.DeepEqual(b, r.record):
+			if !emit(EventModified, b, r.record, nil) {
+				return false
+			}
+		}
+	}
+	for _, r := range prev {
+		if seen[r.id] {
+			continue
+		}
+		if !emit(EventRemoved, r.record, nil, nil) {
+			return false
+		}
+	}
+	return true
+}
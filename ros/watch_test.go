@@ -0,0 +1,122 @@
+package ros
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+)
+
+// TestWatch ensures changes between polls are sent as events, errors are
+// reported without losing state, and the channel is closed on cancellation.
+func TestWatch(t *testing.T) {
+	polls := []string{
+		`[{".id":"*1","vlan-ids":"10"},{".id":"*2","vlan-ids":"20"}]`,
+		`[{".id":"*1","vlan-ids":"10"},{".id":"*2","vlan-ids":"20"}]`,
+		"",
+		`[{".id":"*1","vlan-ids":"11"},{".id":"*3","vlan-ids":"30","dynamic":"true"}]`,
+	}
+	var mu sync.Mutex
+	n := 0
+	c := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		res := polls[len(polls)-1]
+		if n < len(polls) {
+			res = polls[n]
+		}
+		n++
+		if res == "" {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(res))
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	events := c.InterfaceBridgeVlanWatch(ctx, time.Millisecond)
+
+	type summary struct {
+		t      EventType
+		id     RecordID
+		before Number
+		after  Number
+	}
+	want := []summary{
+		{EventAdded, "*1", 0, 10},
+		{EventAdded, "*2", 0, 20},
+		{EventError, "", 0, 0},
+		{EventModified, "*1", 10, 11},
+		{EventAdded, "*3", 0, 30},
+		{EventRemoved, "*2", 20, 0},
+	}
+	for i, w := range want {
+		var ev InterfaceBridgeVlan_Event
+		select {
+		case ev = <-events:
+		case <-time.After(10 * time.Second):
+			t.Fatalf("event %d: timed out", i)
+		}
+		got := summary{t: ev.Type}
+		if ev.Before != nil {
+			got.id = ev.Before.ID
+			got.before = ev.Before.VlanIDs
+		}
+		if ev.After != nil {
+			got.id = ev.After.ID
+			got.after = ev.After.VlanIDs
+		}
+		if got != w {
+			t.Errorf("event %d: wanted %+v, got %+v", i, w, got)
+		}
+		var aerr *APIError
+		if ev.Type == EventError && (!errors.As(ev.Err, &aerr) || aerr.Status != http.StatusServiceUnavailable) {
+			t.Errorf("event %d: wanted service unavailable, got %v", i, ev.Err)
+		}
+	}
+
+	cancel()
+	timeout := time.After(10 * time.Second)
+	for {
+		select {
+		case ev, ok := <-events:
+			if !ok {
+				return
+			}
+			t.Errorf("unexpected event after cancel: %+v", ev)
+		case <-timeout:
+			t.Fatalf("channel not closed after cancel")
+		}
+	}
+}
+
+// TestWatchInvalidInterval ensures watches with a non-positive interval fail
+// without polling.
+func TestWatchInvalidInterval(t *testing.T) {
+	c := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL)
+	})
+
+	for _, interval := range []time.Duration{0, -time.Second} {
+		events := c.InterfaceBridgeVlanWatch(context.Background(), interval)
+		select {
+		case ev := <-events:
+			if ev.Type != EventError || ev.Err == nil {
+				t.Errorf("interval %v: wanted error event, got %+v", interval, ev)
+			}
+		case <-time.After(10 * time.Second):
+			t.Fatalf("interval %v: timed out", interval)
+		}
+		select {
+		case ev, ok := <-events:
+			if ok {
+				t.Errorf("interval %v: unexpected event %+v", interval, ev)
+			}
+		case <-time.After(10 * time.Second):
+			t.Fatalf("interval %v: channel not closed", interval)
+		}
+	}
+}
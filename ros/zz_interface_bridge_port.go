@@ -4,63 +4,390 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 )
 
 // Automatically generated by github.com/q3k/ros7api/gen, do not edit.
 
+// InterfaceBridgePort_Edge is the type of the `edge` property. Values not known to this library
+// (eg. introduced in newer ROS versions) are kept as-is, but reported
+// through UnknownEnumHandler.
 type InterfaceBridgePort_Edge string
 
 const (
 	// same as no-discover, but will additionally detect if a bridge port is a Wireless interface with disabled bridge-mode, such interface will be automatically set as an edge port without discovery.
-	InterfaceBridgePort_EdgeAuto = "auto"
+	InterfaceBridgePort_EdgeAuto InterfaceBridgePort_Edge = "auto"
 	// non-edge port, will participate in learning and listening states in STP.
-	InterfaceBridgePort_EdgeNo = "no"
+	InterfaceBridgePort_EdgeNo InterfaceBridgePort_Edge = "no"
 	// non-edge port with enabled discovery, will participate in learning and listening states in STP, a port can become an edge port if no BPDU is received.
-	InterfaceBridgePort_EdgeNoDiscover = "no-discover"
+	InterfaceBridgePort_EdgeNoDiscover InterfaceBridgePort_Edge = "no-discover"
 	// edge port without discovery, will transit directly to forwarding state.
-	InterfaceBridgePort_EdgeYes = "yes"
+	InterfaceBridgePort_EdgeYes InterfaceBridgePort_Edge = "yes"
 	// edge port with enabled discovery, will transit directly to forwarding state.
-	InterfaceBridgePort_EdgeYesDiscover = "yes-discover"
+	InterfaceBridgePort_EdgeYesDiscover InterfaceBridgePort_Edge = "yes-discover"
 )
 
+// Values returns all values of InterfaceBridgePort_Edge known to this library.
+func (InterfaceBridgePort_Edge) Values() []InterfaceBridgePort_Edge {
+	return []InterfaceBridgePort_Edge{
+		InterfaceBridgePort_EdgeAuto,
+		InterfaceBridgePort_EdgeNo,
+		InterfaceBridgePort_EdgeNoDiscover,
+		InterfaceBridgePort_EdgeYes,
+		InterfaceBridgePort_EdgeYesDiscover,
+	}
+}
+
+// IsKnown returns whether the value is known to this library.
+func (e InterfaceBridgePort_Edge) IsKnown() bool {
+	switch e {
+	case InterfaceBridgePort_EdgeAuto, InterfaceBridgePort_EdgeNo, InterfaceBridgePort_EdgeNoDiscover, InterfaceBridgePort_EdgeYes, InterfaceBridgePort_EdgeYesDiscover:
+		return true
+	}
+	return false
+}
+
+func (e InterfaceBridgePort_Edge) String() string {
+	return string(e)
+}
+
+func (e *InterfaceBridgePort_Edge) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*e = InterfaceBridgePort_Edge(s)
+	if s != "" && !e.IsKnown() {
+		reportUnknownEnum("InterfaceBridgePort_Edge", s)
+	}
+	return nil
+}
+
+func (e InterfaceBridgePort_Edge) MarshalJSON() ([]byte, error) {
+	if e != "" && !e.IsKnown() {
+		reportUnknownEnum("InterfaceBridgePort_Edge", string(e))
+	}
+	return json.Marshal(string(e))
+}
+
+// InterfaceBridgePort_FrameTypes is the type of the `frame-types` property. Values not known to this library
+// (eg. introduced in newer ROS versions) are kept as-is, but reported
+// through UnknownEnumHandler.
 type InterfaceBridgePort_FrameTypes string
 
 const (
-	InterfaceBridgePort_FrameTypesAdmitAll                           = "admit-all"
-	InterfaceBridgePort_FrameTypesAdmitOnlyUntaggedAndPriorityTagged = "admit-only-untagged-and-priority-tagged"
-	InterfaceBridgePort_FrameTypesAdmitOnlyVlanTagged                = "admit-only-vlan-tagged"
+	InterfaceBridgePort_FrameTypesAdmitAll                           InterfaceBridgePort_FrameTypes = "admit-all"
+	InterfaceBridgePort_FrameTypesAdmitOnlyUntaggedAndPriorityTagged InterfaceBridgePort_FrameTypes = "admit-only-untagged-and-priority-tagged"
+	InterfaceBridgePort_FrameTypesAdmitOnlyVlanTagged                InterfaceBridgePort_FrameTypes = "admit-only-vlan-tagged"
 )
 
+// Values returns all values of InterfaceBridgePort_FrameTypes known to this library.
+func (InterfaceBridgePort_FrameTypes) Values() []InterfaceBridgePort_FrameTypes {
+	return []InterfaceBridgePort_FrameTypes{
+		InterfaceBridgePort_FrameTypesAdmitAll,
+		InterfaceBridgePort_FrameTypesAdmitOnlyUntaggedAndPriorityTagged,
+		InterfaceBridgePort_FrameTypesAdmitOnlyVlanTagged,
+	}
+}
+
+// IsKnown returns whether the value is known to this library.
+func (e InterfaceBridgePort_FrameTypes) IsKnown() bool {
+	switch e {
+	case InterfaceBridgePort_FrameTypesAdmitAll, InterfaceBridgePort_FrameTypesAdmitOnlyUntaggedAndPriorityTagged, InterfaceBridgePort_FrameTypesAdmitOnlyVlanTagged:
+		return true
+	}
+	return false
+}
+
+func (e InterfaceBridgePort_FrameTypes) String() string {
+	return string(e)
+}
+
+func (e *InterfaceBridgePort_FrameTypes) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*e = InterfaceBridgePort_FrameTypes(s)
+	if s != "" && !e.IsKnown() {
+		reportUnknownEnum("InterfaceBridgePort_FrameTypes", s)
+	}
+	return nil
+}
+
+func (e InterfaceBridgePort_FrameTypes) MarshalJSON() ([]byte, error) {
+	if e != "" && !e.IsKnown() {
+		reportUnknownEnum("InterfaceBridgePort_FrameTypes", string(e))
+	}
+	return json.Marshal(string(e))
+}
+
+// InterfaceBridgePort_Learn is the type of the `learn` property. Values not known to this library
+// (eg. introduced in newer ROS versions) are kept as-is, but reported
+// through UnknownEnumHandler.
 type InterfaceBridgePort_Learn string
 
 const (
 	// enables MAC learning
-	InterfaceBridgePort_LearnYes = "yes"
+	InterfaceBridgePort_LearnYes InterfaceBridgePort_Learn = "yes"
 	// disables MAC learning
-	InterfaceBridgePort_LearnNo = "no"
+	InterfaceBridgePort_LearnNo InterfaceBridgePort_Learn = "no"
 	// detects if bridge port is a Wireless interface and uses a Wireless registration table instead of MAC learning, will use Wireless registration table if the Wireless interface is set to one of ap-bridge, bridge, wds-slave mode and bridge mode for the Wireless interface is disabled.
-	InterfaceBridgePort_LearnAuto = "auto"
+	InterfaceBridgePort_LearnAuto InterfaceBridgePort_Learn = "auto"
 )
 
+// Values returns all values of InterfaceBridgePort_Learn known to this library.
+func (InterfaceBridgePort_Learn) Values() []InterfaceBridgePort_Learn {
+	return []InterfaceBridgePort_Learn{
+		InterfaceBridgePort_LearnYes,
+		InterfaceBridgePort_LearnNo,
+		InterfaceBridgePort_LearnAuto,
+	}
+}
+
+// IsKnown returns whether the value is known to this library.
+func (e InterfaceBridgePort_Learn) IsKnown() bool {
+	switch e {
+	case InterfaceBridgePort_LearnYes, InterfaceBridgePort_LearnNo, InterfaceBridgePort_LearnAuto:
+		return true
+	}
+	return false
+}
+
+func (e InterfaceBridgePort_Learn) String() string {
+	return string(e)
+}
+
+func (e *InterfaceBridgePort_Learn) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*e = InterfaceBridgePort_Learn(s)
+	if s != "" && !e.IsKnown() {
+		reportUnknownEnum("InterfaceBridgePort_Learn", s)
+	}
+	return nil
+}
+
+func (e InterfaceBridgePort_Learn) MarshalJSON() ([]byte, error) {
+	if e != "" && !e.IsKnown() {
+		reportUnknownEnum("InterfaceBridgePort_Learn", string(e))
+	}
+	return json.Marshal(string(e))
+}
+
+// InterfaceBridgePort_MulticastRouter is the type of the `multicast-router` property. Values not known to this library
+// (eg. introduced in newer ROS versions) are kept as-is, but reported
+// through UnknownEnumHandler.
 type InterfaceBridgePort_MulticastRouter string
 
 const (
 	// disabled multicast router state on the bridge port. Unregistered multicast and IGMP/MLD membership reports are not sent to the bridge port regardless of what is connected to it.
-	InterfaceBridgePort_MulticastRouterDisabled = "disabled"
+	InterfaceBridgePort_MulticastRouterDisabled InterfaceBridgePort_MulticastRouter = "disabled"
 	// enabled multicast router state on the bridge port. Unregistered multicast and IGMP/MLD membership reports are sent to the bridge port regardless of what is connected to it.
-	InterfaceBridgePort_MulticastRouterPermanent = "permanent"
+	InterfaceBridgePort_MulticastRouterPermanent InterfaceBridgePort_MulticastRouter = "permanent"
 	// automatically detect multicast router state on the bridge port using IGMP/MLD queries.
-	InterfaceBridgePort_MulticastRouterTemporaryQuery = "temporary-query"
+	InterfaceBridgePort_MulticastRouterTemporaryQuery InterfaceBridgePort_MulticastRouter = "temporary-query"
 )
 
+// Values returns all values of InterfaceBridgePort_MulticastRouter known to this library.
+func (InterfaceBridgePort_MulticastRouter) Values() []InterfaceBridgePort_MulticastRouter {
+	return []InterfaceBridgePort_MulticastRouter{
+		InterfaceBridgePort_MulticastRouterDisabled,
+		InterfaceBridgePort_MulticastRouterPermanent,
+		InterfaceBridgePort_MulticastRouterTemporaryQuery,
+	}
+}
+
+// IsKnown returns whether the value is known to this library.
+func (e InterfaceBridgePort_MulticastRouter) IsKnown() bool {
+	switch e {
+	case InterfaceBridgePort_MulticastRouterDisabled, InterfaceBridgePort_MulticastRouterPermanent, InterfaceBridgePort_MulticastRouterTemporaryQuery:
+		return true
+	}
+	return false
+}
+
+func (e InterfaceBridgePort_MulticastRouter) String() string {
+	return string(e)
+}
+
+func (e *InterfaceBridgePort_MulticastRouter) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*e = InterfaceBridgePort_MulticastRouter(s)
+	if s != "" && !e.IsKnown() {
+		reportUnknownEnum("InterfaceBridgePort_MulticastRouter", s)
+	}
+	return nil
+}
+
+func (e InterfaceBridgePort_MulticastRouter) MarshalJSON() ([]byte, error) {
+	if e != "" && !e.IsKnown() {
+		reportUnknownEnum("InterfaceBridgePort_MulticastRouter", string(e))
+	}
+	return json.Marshal(string(e))
+}
+
+// InterfaceBridgePort_PointToPoint is the type of the `point-to-point` property. Values not known to this library
+// (eg. introduced in newer ROS versions) are kept as-is, but reported
+// through UnknownEnumHandler.
 type InterfaceBridgePort_PointToPoint string
 
 const (
-	InterfaceBridgePort_PointToPointAuto = "auto"
-	InterfaceBridgePort_PointToPointYes  = "yes"
-	InterfaceBridgePort_PointToPointNo   = "no"
+	InterfaceBridgePort_PointToPointAuto InterfaceBridgePort_PointToPoint = "auto"
+	InterfaceBridgePort_PointToPointYes  InterfaceBridgePort_PointToPoint = "yes"
+	InterfaceBridgePort_PointToPointNo   InterfaceBridgePort_PointToPoint = "no"
 )
 
+// Values returns all values of InterfaceBridgePort_PointToPoint known to this library.
+func (InterfaceBridgePort_PointToPoint) Values() []InterfaceBridgePort_PointToPoint {
+	return []InterfaceBridgePort_PointToPoint{
+		InterfaceBridgePort_PointToPointAuto,
+		InterfaceBridgePort_PointToPointYes,
+		InterfaceBridgePort_PointToPointNo,
+	}
+}
+
+// IsKnown returns whether the value is known to this library.
+func (e InterfaceBridgePort_PointToPoint) IsKnown() bool {
+	switch e {
+	case InterfaceBridgePort_PointToPointAuto, InterfaceBridgePort_PointToPointYes, InterfaceBridgePort_PointToPointNo:
+		return true
+	}
+	return false
+}
+
+func (e InterfaceBridgePort_PointToPoint) String() string {
+	return string(e)
+}
+
+func (e *InterfaceBridgePort_PointToPoint) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*e = InterfaceBridgePort_PointToPoint(s)
+	if s != "" && !e.IsKnown() {
+		reportUnknownEnum("InterfaceBridgePort_PointToPoint", s)
+	}
+	return nil
+}
+
+func (e InterfaceBridgePort_PointToPoint) MarshalJSON() ([]byte, error) {
+	if e != "" && !e.IsKnown() {
+		reportUnknownEnum("InterfaceBridgePort_PointToPoint", string(e))
+	}
+	return json.Marshal(string(e))
+}
+
+// InterfaceBridgePort_MVRPApplicantState is the type of the `mvrp-applicant-state` property. Values not known to this library
+// (eg. introduced in newer ROS versions) are kept as-is, but reported
+// through UnknownEnumHandler.
+type InterfaceBridgePort_MVRPApplicantState string
+
+const (
+	// the port does not send any MRP messages.
+	InterfaceBridgePort_MVRPApplicantStateNonParticipant InterfaceBridgePort_MVRPApplicantState = "non-participant"
+	// the port participates normally in MRP exchanges.
+	InterfaceBridgePort_MVRPApplicantStateNormalParticipant InterfaceBridgePort_MVRPApplicantState = "normal-participant"
+)
+
+// Values returns all values of InterfaceBridgePort_MVRPApplicantState known to this library.
+func (InterfaceBridgePort_MVRPApplicantState) Values() []InterfaceBridgePort_MVRPApplicantState {
+	return []InterfaceBridgePort_MVRPApplicantState{
+		InterfaceBridgePort_MVRPApplicantStateNonParticipant,
+		InterfaceBridgePort_MVRPApplicantStateNormalParticipant,
+	}
+}
+
+// IsKnown returns whether the value is known to this library.
+func (e InterfaceBridgePort_MVRPApplicantState) IsKnown() bool {
+	switch e {
+	case InterfaceBridgePort_MVRPApplicantStateNonParticipant, InterfaceBridgePort_MVRPApplicantStateNormalParticipant:
+		return true
+	}
+	return false
+}
+
+func (e InterfaceBridgePort_MVRPApplicantState) String() string {
+	return string(e)
+}
+
+func (e *InterfaceBridgePort_MVRPApplicantState) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*e = InterfaceBridgePort_MVRPApplicantState(s)
+	if s != "" && !e.IsKnown() {
+		reportUnknownEnum("InterfaceBridgePort_MVRPApplicantState", s)
+	}
+	return nil
+}
+
+func (e InterfaceBridgePort_MVRPApplicantState) MarshalJSON() ([]byte, error) {
+	if e != "" && !e.IsKnown() {
+		reportUnknownEnum("InterfaceBridgePort_MVRPApplicantState", string(e))
+	}
+	return json.Marshal(string(e))
+}
+
+// InterfaceBridgePort_MVRPRegistrarState is the type of the `mvrp-registrar-state` property. Values not known to this library
+// (eg. introduced in newer ROS versions) are kept as-is, but reported
+// through UnknownEnumHandler.
+type InterfaceBridgePort_MVRPRegistrarState string
+
+const (
+	// the port ignores all MRP messages, and remains registered in all configured VLANs.
+	InterfaceBridgePort_MVRPRegistrarStateFixed InterfaceBridgePort_MVRPRegistrarState = "fixed"
+	// the port receives and processes MRP messages.
+	InterfaceBridgePort_MVRPRegistrarStateNormal InterfaceBridgePort_MVRPRegistrarState = "normal"
+)
+
+// Values returns all values of InterfaceBridgePort_MVRPRegistrarState known to this library.
+func (InterfaceBridgePort_MVRPRegistrarState) Values() []InterfaceBridgePort_MVRPRegistrarState {
+	return []InterfaceBridgePort_MVRPRegistrarState{
+		InterfaceBridgePort_MVRPRegistrarStateFixed,
+		InterfaceBridgePort_MVRPRegistrarStateNormal,
+	}
+}
+
+// IsKnown returns whether the value is known to this library.
+func (e InterfaceBridgePort_MVRPRegistrarState) IsKnown() bool {
+	switch e {
+	case InterfaceBridgePort_MVRPRegistrarStateFixed, InterfaceBridgePort_MVRPRegistrarStateNormal:
+		return true
+	}
+	return false
+}
+
+func (e InterfaceBridgePort_MVRPRegistrarState) String() string {
+	return string(e)
+}
+
+func (e *InterfaceBridgePort_MVRPRegistrarState) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*e = InterfaceBridgePort_MVRPRegistrarState(s)
+	if s != "" && !e.IsKnown() {
+		reportUnknownEnum("InterfaceBridgePort_MVRPRegistrarState", s)
+	}
+	return nil
+}
+
+func (e InterfaceBridgePort_MVRPRegistrarState) MarshalJSON() ([]byte, error) {
+	if e != "" && !e.IsKnown() {
+		reportUnknownEnum("InterfaceBridgePort_MVRPRegistrarState", string(e))
+	}
+	return json.Marshal(string(e))
+}
+
 // InterfaceBridgePort represents a ROS `interface/bridge/port` record, including read-only fields.
 //
 // Port submenu is used to add interfaces in a particular bridge.
@@ -111,6 +438,10 @@ type InterfaceBridgePort struct {
 	UnknownMulticastFlood Boolean `json:"unknown-multicast-flood"`
 	// Changes the unknown unicast flood option on bridge port, only controls the egress traffic. When enabled, the bridge allows flooding unknown unicast packets to the specified bridge port, but when disabled, the bridge restricts unknown unicast traffic from being flooded to the specified bridge port. If a MAC address is not learned in the host table, then the traffic is considered as unknown unicast traffic and will be flooded to all ports. MAC address is learned as soon as a packet on a bridge port is received and the source MAC address is added to the bridge host table. Since it is required for the bridge to receive at least one packet on the bridge port to learn the MAC address, it is recommended to use static bridge host entries to avoid packets being dropped until the MAC address has been learned.
 	UnknownUnicastFlood Boolean `json:"unknown-unicast-flood"`
+	// MVRP applicant options. This property only has an effect when mvrp is set to yes on the bridge.
+	MVRPApplicantState InterfaceBridgePort_MVRPApplicantState `json:"mvrp-applicant-state"`
+	// MVRP registrar options. This property only has an effect when mvrp is set to yes on the bridge.
+	MVRPRegistrarState InterfaceBridgePort_MVRPRegistrarState `json:"mvrp-registrar-state"`
 }
 
 // InterfaceBridgePort_Update is an update to a ROS `interface/bridge/port` record. Any unset field will not be updated.
@@ -159,11 +490,308 @@ type InterfaceBridgePort_Update struct {
 	UnknownMulticastFlood *Boolean `json:"unknown-multicast-flood,omitempty"`
 	// Changes the unknown unicast flood option on bridge port, only controls the egress traffic. When enabled, the bridge allows flooding unknown unicast packets to the specified bridge port, but when disabled, the bridge restricts unknown unicast traffic from being flooded to the specified bridge port. If a MAC address is not learned in the host table, then the traffic is considered as unknown unicast traffic and will be flooded to all ports. MAC address is learned as soon as a packet on a bridge port is received and the source MAC address is added to the bridge host table. Since it is required for the bridge to receive at least one packet on the bridge port to learn the MAC address, it is recommended to use static bridge host entries to avoid packets being dropped until the MAC address has been learned.
 	UnknownUnicastFlood *Boolean `json:"unknown-unicast-flood,omitempty"`
+	// MVRP applicant options. This property only has an effect when mvrp is set to yes on the bridge.
+	MVRPApplicantState *InterfaceBridgePort_MVRPApplicantState `json:"mvrp-applicant-state,omitempty"`
+	// MVRP registrar options. This property only has an effect when mvrp is set to yes on the bridge.
+	MVRPRegistrarState *InterfaceBridgePort_MVRPRegistrarState `json:"mvrp-registrar-state,omitempty"`
+}
+
+// ToUpdate returns an update setting all settable fields of a `interface/bridge/port` record to
+// their values in r. Fields unset in r which ROS doesn't accept empty (eg.
+// addresses, port lists or enums) are left out, so that listed records can be
+// written back. List values are shared between r and the update.
+func (r *InterfaceBridgePort) ToUpdate() *InterfaceBridgePort_Update {
+	u := &InterfaceBridgePort_Update{}
+	u.AutoIsolate = new(Boolean)
+	*u.AutoIsolate = r.AutoIsolate
+	u.BPDUGuard = new(Boolean)
+	*u.BPDUGuard = r.BPDUGuard
+	u.Bridge = new(string)
+	*u.Bridge = r.Bridge
+	u.BroadcastFlood = new(Boolean)
+	*u.BroadcastFlood = r.BroadcastFlood
+	if r.Edge != "" {
+		u.Edge = new(InterfaceBridgePort_Edge)
+		*u.Edge = r.Edge
+	}
+	u.FastLeave = new(Boolean)
+	*u.FastLeave = r.FastLeave
+	if r.FrameTypes != "" {
+		u.FrameTypes = new(InterfaceBridgePort_FrameTypes)
+		*u.FrameTypes = r.FrameTypes
+	}
+	u.IngressFiltering = new(Boolean)
+	*u.IngressFiltering = r.IngressFiltering
+	if r.Learn != "" {
+		u.Learn = new(InterfaceBridgePort_Learn)
+		*u.Learn = r.Learn
+	}
+	if r.MulticastRouter != "" {
+		u.MulticastRouter = new(InterfaceBridgePort_MulticastRouter)
+		*u.MulticastRouter = r.MulticastRouter
+	}
+	u.InternalPathCost = new(Number)
+	*u.InternalPathCost = r.InternalPathCost
+	u.Interface = new(string)
+	*u.Interface = r.Interface
+	u.PathCost = new(Number)
+	*u.PathCost = r.PathCost
+	if r.PointToPoint != "" {
+		u.PointToPoint = new(InterfaceBridgePort_PointToPoint)
+		*u.PointToPoint = r.PointToPoint
+	}
+	u.Priority = new(Number)
+	*u.Priority = r.Priority
+	u.PVID = new(Number)
+	*u.PVID = r.PVID
+	u.RestrictedRole = new(Boolean)
+	*u.RestrictedRole = r.RestrictedRole
+	u.RestrictedTCN = new(Boolean)
+	*u.RestrictedTCN = r.RestrictedTCN
+	u.TagStacking = new(Boolean)
+	*u.TagStacking = r.TagStacking
+	u.Trusted = new(Boolean)
+	*u.Trusted = r.Trusted
+	u.UnknownMulticastFlood = new(Boolean)
+	*u.UnknownMulticastFlood = r.UnknownMulticastFlood
+	u.UnknownUnicastFlood = new(Boolean)
+	*u.UnknownUnicastFlood = r.UnknownUnicastFlood
+	if r.MVRPApplicantState != "" {
+		u.MVRPApplicantState = new(InterfaceBridgePort_MVRPApplicantState)
+		*u.MVRPApplicantState = r.MVRPApplicantState
+	}
+	if r.MVRPRegistrarState != "" {
+		u.MVRPRegistrarState = new(InterfaceBridgePort_MVRPRegistrarState)
+		*u.MVRPRegistrarState = r.MVRPRegistrarState
+	}
+	return u
+}
+
+// DiffInterfaceBridgePort returns an update which changes current into desired, setting only
+// the settable fields that differ between them. Read-only fields, and fields
+// left out by ToUpdate when unset in desired, are ignored. The update IsEmpty if
+// there is nothing to change.
+func DiffInterfaceBridgePort(current, desired *InterfaceBridgePort) *InterfaceBridgePort_Update {
+	u := &InterfaceBridgePort_Update{}
+	if current.AutoIsolate != desired.AutoIsolate {
+		u.AutoIsolate = new(Boolean)
+		*u.AutoIsolate = desired.AutoIsolate
+	}
+	if current.BPDUGuard != desired.BPDUGuard {
+		u.BPDUGuard = new(Boolean)
+		*u.BPDUGuard = desired.BPDUGuard
+	}
+	if current.Bridge != desired.Bridge {
+		u.Bridge = new(string)
+		*u.Bridge = desired.Bridge
+	}
+	if current.BroadcastFlood != desired.BroadcastFlood {
+		u.BroadcastFlood = new(Boolean)
+		*u.BroadcastFlood = desired.BroadcastFlood
+	}
+	if (desired.Edge != "") && current.Edge != desired.Edge {
+		u.Edge = new(InterfaceBridgePort_Edge)
+		*u.Edge = desired.Edge
+	}
+	if current.FastLeave != desired.FastLeave {
+		u.FastLeave = new(Boolean)
+		*u.FastLeave = desired.FastLeave
+	}
+	if (desired.FrameTypes != "") && current.FrameTypes != desired.FrameTypes {
+		u.FrameTypes = new(InterfaceBridgePort_FrameTypes)
+		*u.FrameTypes = desired.FrameTypes
+	}
+	if current.IngressFiltering != desired.IngressFiltering {
+		u.IngressFiltering = new(Boolean)
+		*u.IngressFiltering = desired.IngressFiltering
+	}
+	if (desired.Learn != "") && current.Learn != desired.Learn {
+		u.Learn = new(InterfaceBridgePort_Learn)
+		*u.Learn = desired.Learn
+	}
+	if (desired.MulticastRouter != "") && current.MulticastRouter != desired.MulticastRouter {
+		u.MulticastRouter = new(InterfaceBridgePort_MulticastRouter)
+		*u.MulticastRouter = desired.MulticastRouter
+	}
+	if current.InternalPathCost != desired.InternalPathCost {
+		u.InternalPathCost = new(Number)
+		*u.InternalPathCost = desired.InternalPathCost
+	}
+	if current.Interface != desired.Interface {
+		u.Interface = new(string)
+		*u.Interface = desired.Interface
+	}
+	if current.PathCost != desired.PathCost {
+		u.PathCost = new(Number)
+		*u.PathCost = desired.PathCost
+	}
+	if (desired.PointToPoint != "") && current.PointToPoint != desired.PointToPoint {
+		u.PointToPoint = new(InterfaceBridgePort_PointToPoint)
+		*u.PointToPoint = desired.PointToPoint
+	}
+	if current.Priority != desired.Priority {
+		u.Priority = new(Number)
+		*u.Priority = desired.Priority
+	}
+	if current.PVID != desired.PVID {
+		u.PVID = new(Number)
+		*u.PVID = desired.PVID
+	}
+	if current.RestrictedRole != desired.RestrictedRole {
+		u.RestrictedRole = new(Boolean)
+		*u.RestrictedRole = desired.RestrictedRole
+	}
+	if current.RestrictedTCN != desired.RestrictedTCN {
+		u.RestrictedTCN = new(Boolean)
+		*u.RestrictedTCN = desired.RestrictedTCN
+	}
+	if current.TagStacking != desired.TagStacking {
+		u.TagStacking = new(Boolean)
+		*u.TagStacking = desired.TagStacking
+	}
+	if current.Trusted != desired.Trusted {
+		u.Trusted = new(Boolean)
+		*u.Trusted = desired.Trusted
+	}
+	if current.UnknownMulticastFlood != desired.UnknownMulticastFlood {
+		u.UnknownMulticastFlood = new(Boolean)
+		*u.UnknownMulticastFlood = desired.UnknownMulticastFlood
+	}
+	if current.UnknownUnicastFlood != desired.UnknownUnicastFlood {
+		u.UnknownUnicastFlood = new(Boolean)
+		*u.UnknownUnicastFlood = desired.UnknownUnicastFlood
+	}
+	if (desired.MVRPApplicantState != "") && current.MVRPApplicantState != desired.MVRPApplicantState {
+		u.MVRPApplicantState = new(InterfaceBridgePort_MVRPApplicantState)
+		*u.MVRPApplicantState = desired.MVRPApplicantState
+	}
+	if (desired.MVRPRegistrarState != "") && current.MVRPRegistrarState != desired.MVRPRegistrarState {
+		u.MVRPRegistrarState = new(InterfaceBridgePort_MVRPRegistrarState)
+		*u.MVRPRegistrarState = desired.MVRPRegistrarState
+	}
+	return u
+}
+
+// IsEmpty returns whether the update does not set any field, ie. applying it
+// would be a no-op.
+func (u *InterfaceBridgePort_Update) IsEmpty() bool {
+	return u.AutoIsolate == nil &&
+		u.BPDUGuard == nil &&
+		u.Bridge == nil &&
+		u.BroadcastFlood == nil &&
+		u.Edge == nil &&
+		u.FastLeave == nil &&
+		u.FrameTypes == nil &&
+		u.IngressFiltering == nil &&
+		u.Learn == nil &&
+		u.MulticastRouter == nil &&
+		u.InternalPathCost == nil &&
+		u.Interface == nil &&
+		u.PathCost == nil &&
+		u.PointToPoint == nil &&
+		u.Priority == nil &&
+		u.PVID == nil &&
+		u.RestrictedRole == nil &&
+		u.RestrictedTCN == nil &&
+		u.TagStacking == nil &&
+		u.Trusted == nil &&
+		u.UnknownMulticastFlood == nil &&
+		u.UnknownUnicastFlood == nil &&
+		u.MVRPApplicantState == nil &&
+		u.MVRPRegistrarState == nil
+}
+
+// InterfaceBridgePort_Field is the name of a `interface/bridge/port` record property, for use in .proplist
+// projections.
+type InterfaceBridgePort_Field string
+
+const (
+	InterfaceBridgePort_FieldID                    InterfaceBridgePort_Field = ".id"
+	InterfaceBridgePort_FieldAutoIsolate           InterfaceBridgePort_Field = "auto-isolate"
+	InterfaceBridgePort_FieldBPDUGuard             InterfaceBridgePort_Field = "bpdu-guard"
+	InterfaceBridgePort_FieldBridge                InterfaceBridgePort_Field = "bridge"
+	InterfaceBridgePort_FieldBroadcastFlood        InterfaceBridgePort_Field = "broadcast-flood"
+	InterfaceBridgePort_FieldEdge                  InterfaceBridgePort_Field = "edge"
+	InterfaceBridgePort_FieldFastLeave             InterfaceBridgePort_Field = "fast-leave"
+	InterfaceBridgePort_FieldFrameTypes            InterfaceBridgePort_Field = "frame-types"
+	InterfaceBridgePort_FieldIngressFiltering      InterfaceBridgePort_Field = "ingress-filtering"
+	InterfaceBridgePort_FieldLearn                 InterfaceBridgePort_Field = "learn"
+	InterfaceBridgePort_FieldMulticastRouter       InterfaceBridgePort_Field = "multicast-router"
+	InterfaceBridgePort_FieldInternalPathCost      InterfaceBridgePort_Field = "internal-path-cost"
+	InterfaceBridgePort_FieldInterface             InterfaceBridgePort_Field = "interface"
+	InterfaceBridgePort_FieldPathCost              InterfaceBridgePort_Field = "path-cost"
+	InterfaceBridgePort_FieldPointToPoint          InterfaceBridgePort_Field = "point-to-point"
+	InterfaceBridgePort_FieldPriority              InterfaceBridgePort_Field = "priority"
+	InterfaceBridgePort_FieldPVID                  InterfaceBridgePort_Field = "pvid"
+	InterfaceBridgePort_FieldRestrictedRole        InterfaceBridgePort_Field = "restricted-role"
+	InterfaceBridgePort_FieldRestrictedTCN         InterfaceBridgePort_Field = "restricted-tcn"
+	InterfaceBridgePort_FieldTagStacking           InterfaceBridgePort_Field = "tag-stacking"
+	InterfaceBridgePort_FieldTrusted               InterfaceBridgePort_Field = "trusted"
+	InterfaceBridgePort_FieldUnknownMulticastFlood InterfaceBridgePort_Field = "unknown-multicast-flood"
+	InterfaceBridgePort_FieldUnknownUnicastFlood   InterfaceBridgePort_Field = "unknown-unicast-flood"
+	InterfaceBridgePort_FieldMVRPApplicantState    InterfaceBridgePort_Field = "mvrp-applicant-state"
+	InterfaceBridgePort_FieldMVRPRegistrarState    InterfaceBridgePort_Field = "mvrp-registrar-state"
+)
+
+// InterfaceBridgePort_Filter is an equality filter on `interface/bridge/port` records, evaluated by ROS.
+// Any unset field will not be filtered on.
+type InterfaceBridgePort_Filter struct {
+	ID                    *RecordID                               `json:".id,omitempty"`
+	AutoIsolate           *Boolean                                `json:"auto-isolate,omitempty"`
+	BPDUGuard             *Boolean                                `json:"bpdu-guard,omitempty"`
+	Bridge                *string                                 `json:"bridge,omitempty"`
+	BroadcastFlood        *Boolean                                `json:"broadcast-flood,omitempty"`
+	Edge                  *InterfaceBridgePort_Edge               `json:"edge,omitempty"`
+	FastLeave             *Boolean                                `json:"fast-leave,omitempty"`
+	FrameTypes            *InterfaceBridgePort_FrameTypes         `json:"frame-types,omitempty"`
+	IngressFiltering      *Boolean                                `json:"ingress-filtering,omitempty"`
+	Learn                 *InterfaceBridgePort_Learn              `json:"learn,omitempty"`
+	MulticastRouter       *InterfaceBridgePort_MulticastRouter    `json:"multicast-router,omitempty"`
+	InternalPathCost      *Number                                 `json:"internal-path-cost,omitempty"`
+	Interface             *string                                 `json:"interface,omitempty"`
+	PathCost              *Number                                 `json:"path-cost,omitempty"`
+	PointToPoint          *InterfaceBridgePort_PointToPoint       `json:"point-to-point,omitempty"`
+	Priority              *Number                                 `json:"priority,omitempty"`
+	PVID                  *Number                                 `json:"pvid,omitempty"`
+	RestrictedRole        *Boolean                                `json:"restricted-role,omitempty"`
+	RestrictedTCN         *Boolean                                `json:"restricted-tcn,omitempty"`
+	TagStacking           *Boolean                                `json:"tag-stacking,omitempty"`
+	Trusted               *Boolean                                `json:"trusted,omitempty"`
+	UnknownMulticastFlood *Boolean                                `json:"unknown-multicast-flood,omitempty"`
+	UnknownUnicastFlood   *Boolean                                `json:"unknown-unicast-flood,omitempty"`
+	MVRPApplicantState    *InterfaceBridgePort_MVRPApplicantState `json:"mvrp-applicant-state,omitempty"`
+	MVRPRegistrarState    *InterfaceBridgePort_MVRPRegistrarState `json:"mvrp-registrar-state,omitempty"`
+}
+
+// InterfaceBridgePort_ListOptions limits the records and fields returned by InterfaceBridgePortListWith.
+type InterfaceBridgePort_ListOptions struct {
+	// Filter, if set, only returns records matching all set fields.
+	Filter *InterfaceBridgePort_Filter
+	// Proplist, if set, only returns the given fields of every record.
+	Proplist []InterfaceBridgePort_Field
 }
 
 // InterfaceBridgePortList returns a list of all `interface/bridge/port` records.
 func (c *Client) InterfaceBridgePortList(ctx context.Context) ([]InterfaceBridgePort, error) {
-	body, err := c.doGET(ctx, "interface/bridge/port")
+	return c.InterfaceBridgePortListWith(ctx, nil)
+}
+
+// InterfaceBridgePortListWith returns a list of all `interface/bridge/port` records, optionally filtered
+// and projected by ROS according to the given options, which may be nil.
+func (c *Client) InterfaceBridgePortListWith(ctx context.Context, opts *InterfaceBridgePort_ListOptions) ([]InterfaceBridgePort, error) {
+	var filter *InterfaceBridgePort_Filter
+	var proplist []string
+	if opts != nil {
+		filter = opts.Filter
+		for _, f := range opts.Proplist {
+			proplist = append(proplist, string(f))
+		}
+	}
+	query, err := listQuery(filter, proplist)
+	if err != nil {
+		return nil, fmt.Errorf("invalid options: %w", err)
+	}
+	body, err := c.doGET(ctx, "interface/bridge/port", "", query)
 	if err != nil {
 		return nil, fmt.Errorf("could not GET: %w", err)
 	}
@@ -176,29 +804,176 @@ func (c *Client) InterfaceBridgePortList(ctx context.Context) ([]InterfaceBridge
 	return target, nil
 }
 
+// InterfaceBridgePortFind returns all `interface/bridge/port` records matching the given filter, which may
+// be nil. The filter is sent as a print .query and evaluated by ROS. If
+// proplist is given, only the given fields of every record are returned.
+func (c *Client) InterfaceBridgePortFind(ctx context.Context, filter *InterfaceBridgePort_Filter, proplist ...InterfaceBridgePort_Field) ([]InterfaceBridgePort, error) {
+	var props []string
+	for _, f := range proplist {
+		props = append(props, string(f))
+	}
+	rdata, err := printQuery(filter, props)
+	if err != nil {
+		return nil, fmt.Errorf("invalid filter: %w", err)
+	}
+	body, err := c.doPOST(ctx, "interface/bridge/port", "print", rdata)
+	if err != nil {
+		return nil, fmt.Errorf("could not POST: %w", err)
+	}
+	defer body.Close()
+
+	var target []InterfaceBridgePort
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return target, nil
+}
+
 // InterfaceBridgePortPatch updates the given fields of a `interface/bridge/port` record by ID.
 func (c *Client) InterfaceBridgePortPatch(ctx context.Context, id RecordID, u *InterfaceBridgePort_Update) (*InterfaceBridgePort, error) {
 	rdata, err := json.Marshal(u)
 	if err != nil {
 		return nil, fmt.Errorf("could not marshal update: %w", err)
 	}
-	body, err := c.doPATCH(ctx, "interface/bridge/port/"+string(id), rdata)
+	body, err := c.doPATCH(ctx, "interface/bridge/port", id, rdata)
 	if err != nil {
 		return nil, fmt.Errorf("could not PATCH: %w", err)
 	}
 	defer body.Close()
 
-	var target struct {
-		InterfaceBridgePort
-		Error   int64  `json:"error"`
-		Message string `json:"message"`
-		Detail  string `json:"detail"`
+	var target InterfaceBridgePort
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
 	}
+	return &target, nil
+}
+
+// InterfaceBridgePortGet returns a single `interface/bridge/port` record by ID.
+func (c *Client) InterfaceBridgePortGet(ctx context.Context, id RecordID) (*InterfaceBridgePort, error) {
+	body, err := c.doGET(ctx, "interface/bridge/port", id, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not GET: %w", err)
+	}
+	defer body.Close()
+
+	var target InterfaceBridgePort
 	if err := json.NewDecoder(body).Decode(&target); err != nil {
 		return nil, fmt.Errorf("could not decode JSON: %w", err)
 	}
-	if target.Error != 0 {
-		return nil, fmt.Errorf("server error: %s: %s", target.Message, target.Detail)
+	return &target, nil
+}
+
+// InterfaceBridgePortAdd creates a new `interface/bridge/port` record with the given fields set. Any unset
+// field will be populated by ROS with its default value.
+func (c *Client) InterfaceBridgePortAdd(ctx context.Context, u *InterfaceBridgePort_Update) (*InterfaceBridgePort, error) {
+	rdata, err := json.Marshal(u)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal update: %w", err)
+	}
+	body, err := c.doPUT(ctx, "interface/bridge/port", rdata)
+	if err != nil {
+		return nil, fmt.Errorf("could not PUT: %w", err)
+	}
+	defer body.Close()
+
+	var target InterfaceBridgePort
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return &target, nil
+}
+
+// InterfaceBridgePortRemove deletes a `interface/bridge/port` record by ID.
+func (c *Client) InterfaceBridgePortRemove(ctx context.Context, id RecordID) error {
+	body, err := c.doDELETE(ctx, "interface/bridge/port", id)
+	if err != nil {
+		return fmt.Errorf("could not DELETE: %w", err)
 	}
-	return &target.InterfaceBridgePort, nil
+	body.Close()
+	return nil
+}
+
+// InterfaceBridgePort_Event is a change to a `interface/bridge/port` record observed by InterfaceBridgePortWatch.
+type InterfaceBridgePort_Event struct {
+	Type EventType
+	// Before is the record before the change, nil if Type is EventAdded.
+	Before *InterfaceBridgePort
+	// After is the record after the change, nil if Type is EventRemoved.
+	After *InterfaceBridgePort
+	// Err is the polling error if Type is EventError.
+	Err error
+}
+
+// InterfaceBridgePortWatch polls the `interface/bridge/port` records every interval and sends an event for
+// every record added, modified or removed since the previous poll, starting
+// with an EventAdded for every existing record. Polling errors are sent as
+// EventError, and retried with backoff. The channel is closed once ctx is
+// canceled, or after a single EventError if interval is not positive.
+func (c *Client) InterfaceBridgePortWatch(ctx context.Context, interval time.Duration) <-chan InterfaceBridgePort_Event {
+	ch := make(chan InterfaceBridgePort_Event)
+	list := func(ctx context.Context) ([]watchedRecord, error) {
+		records, err := c.InterfaceBridgePortList(ctx)
+		if err != nil {
+			return nil, err
+		}
+		res := make([]watchedRecord, len(records))
+		for i := range records {
+			res[i] = watchedRecord{id: records[i].ID, record: &records[i]}
+		}
+		return res, nil
+	}
+	emit := func(t EventType, before, after interface{}, err error) bool {
+		ev := InterfaceBridgePort_Event{Type: t, Err: err}
+		if before != nil {
+			ev.Before = before.(*InterfaceBridgePort)
+		}
+		if after != nil {
+			ev.After = after.(*InterfaceBridgePort)
+		}
+		select {
+		case ch <- ev:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+	go watch(ctx, interval, list, emit, func() { close(ch) })
+	return ch
+}
+
+// InterfaceBridgePort_Schema describes the `interface/bridge/port` menu.
+var InterfaceBridgePort_Schema = &MenuSchema{
+	Path:  "interface/bridge/port",
+	Table: true,
+	Key:   []string{"interface"},
+	Properties: []*PropertySchema{
+		{Name: "auto-isolate", Kind: KindBoolean},
+		{Name: "bpdu-guard", Kind: KindBoolean},
+		{Name: "bridge", Kind: KindString},
+		{Name: "broadcast-flood", Kind: KindBoolean},
+		{Name: "edge", Kind: KindEnum, Variants: []string{"auto", "no", "no-discover", "yes", "yes-discover"}},
+		{Name: "fast-leave", Kind: KindBoolean},
+		{Name: "frame-types", Kind: KindEnum, Variants: []string{"admit-all", "admit-only-untagged-and-priority-tagged", "admit-only-vlan-tagged"}},
+		{Name: "ingress-filtering", Kind: KindBoolean},
+		{Name: "learn", Kind: KindEnum, Variants: []string{"yes", "no", "auto"}},
+		{Name: "multicast-router", Kind: KindEnum, Variants: []string{"disabled", "permanent", "temporary-query"}},
+		{Name: "internal-path-cost", Kind: KindNumber},
+		{Name: "interface", Kind: KindString},
+		{Name: "path-cost", Kind: KindNumber},
+		{Name: "point-to-point", Kind: KindEnum, Variants: []string{"auto", "yes", "no"}},
+		{Name: "priority", Kind: KindNumber},
+		{Name: "pvid", Kind: KindNumber},
+		{Name: "restricted-role", Kind: KindBoolean},
+		{Name: "restricted-tcn", Kind: KindBoolean},
+		{Name: "tag-stacking", Kind: KindBoolean},
+		{Name: "trusted", Kind: KindBoolean},
+		{Name: "unknown-multicast-flood", Kind: KindBoolean},
+		{Name: "unknown-unicast-flood", Kind: KindBoolean},
+		{Name: "mvrp-applicant-state", Kind: KindEnum, Since: Version{7, 15, 0}, Variants: []string{"non-participant", "normal-participant"}},
+		{Name: "mvrp-registrar-state", Kind: KindEnum, Since: Version{7, 15, 0}, Variants: []string{"fixed", "normal"}},
+	},
+}
+
+func init() {
+	registerMenu(InterfaceBridgePort_Schema)
 }
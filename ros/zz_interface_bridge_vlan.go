@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 )
 
 // Automatically generated by github.com/q3k/ros7api/gen, do not edit.
@@ -43,9 +44,123 @@ type InterfaceBridgeVlan_Update struct {
 	VlanIDs *Number `json:"vlan-ids,omitempty"`
 }
 
+// ToUpdate returns an update setting all settable fields of a `interface/bridge/vlan` record to
+// their values in r. Fields unset in r which ROS doesn't accept empty (eg.
+// addresses, port lists or enums) are left out, so that listed records can be
+// written back. List values are shared between r and the update.
+func (r *InterfaceBridgeVlan) ToUpdate() *InterfaceBridgeVlan_Update {
+	u := &InterfaceBridgeVlan_Update{}
+	u.Bridge = new(string)
+	*u.Bridge = r.Bridge
+	u.Disabled = new(Boolean)
+	*u.Disabled = r.Disabled
+	u.Tagged = new(StringList)
+	*u.Tagged = r.Tagged
+	u.Untagged = new(StringList)
+	*u.Untagged = r.Untagged
+	u.VlanIDs = new(Number)
+	*u.VlanIDs = r.VlanIDs
+	return u
+}
+
+// DiffInterfaceBridgeVlan returns an update which changes current into desired, setting only
+// the settable fields that differ between them. Read-only fields, and fields
+// left out by ToUpdate when unset in desired, are ignored. The update IsEmpty if
+// there is nothing to change.
+func DiffInterfaceBridgeVlan(current, desired *InterfaceBridgeVlan) *InterfaceBridgeVlan_Update {
+	u := &InterfaceBridgeVlan_Update{}
+	if current.Bridge != desired.Bridge {
+		u.Bridge = new(string)
+		*u.Bridge = desired.Bridge
+	}
+	if current.Disabled != desired.Disabled {
+		u.Disabled = new(Boolean)
+		*u.Disabled = desired.Disabled
+	}
+	if !current.Tagged.Equal(desired.Tagged) {
+		u.Tagged = new(StringList)
+		*u.Tagged = desired.Tagged
+	}
+	if !current.Untagged.Equal(desired.Untagged) {
+		u.Untagged = new(StringList)
+		*u.Untagged = desired.Untagged
+	}
+	if current.VlanIDs != desired.VlanIDs {
+		u.VlanIDs = new(Number)
+		*u.VlanIDs = desired.VlanIDs
+	}
+	return u
+}
+
+// IsEmpty returns whether the update does not set any field, ie. applying it
+// would be a no-op.
+func (u *InterfaceBridgeVlan_Update) IsEmpty() bool {
+	return u.Bridge == nil &&
+		u.Disabled == nil &&
+		u.Tagged == nil &&
+		u.Untagged == nil &&
+		u.VlanIDs == nil
+}
+
+// InterfaceBridgeVlan_Field is the name of a `interface/bridge/vlan` record property, for use in .proplist
+// projections.
+type InterfaceBridgeVlan_Field string
+
+const (
+	InterfaceBridgeVlan_FieldID              InterfaceBridgeVlan_Field = ".id"
+	InterfaceBridgeVlan_FieldBridge          InterfaceBridgeVlan_Field = "bridge"
+	InterfaceBridgeVlan_FieldDisabled        InterfaceBridgeVlan_Field = "disabled"
+	InterfaceBridgeVlan_FieldTagged          InterfaceBridgeVlan_Field = "tagged"
+	InterfaceBridgeVlan_FieldUntagged        InterfaceBridgeVlan_Field = "untagged"
+	InterfaceBridgeVlan_FieldVlanIDs         InterfaceBridgeVlan_Field = "vlan-ids"
+	InterfaceBridgeVlan_FieldCurrentTagged   InterfaceBridgeVlan_Field = "current-tagged"
+	InterfaceBridgeVlan_FieldCurrentUntagged InterfaceBridgeVlan_Field = "current-untagged"
+	InterfaceBridgeVlan_FieldDynamic         InterfaceBridgeVlan_Field = "dynamic"
+)
+
+// InterfaceBridgeVlan_Filter is an equality filter on `interface/bridge/vlan` records, evaluated by ROS.
+// Any unset field will not be filtered on.
+type InterfaceBridgeVlan_Filter struct {
+	ID              *RecordID   `json:".id,omitempty"`
+	Bridge          *string     `json:"bridge,omitempty"`
+	Disabled        *Boolean    `json:"disabled,omitempty"`
+	Tagged          *StringList `json:"tagged,omitempty"`
+	Untagged        *StringList `json:"untagged,omitempty"`
+	VlanIDs         *Number     `json:"vlan-ids,omitempty"`
+	CurrentTagged   *StringList `json:"current-tagged,omitempty"`
+	CurrentUntagged *StringList `json:"current-untagged,omitempty"`
+	Dynamic         *Boolean    `json:"dynamic,omitempty"`
+}
+
+// InterfaceBridgeVlan_ListOptions limits the records and fields returned by InterfaceBridgeVlanListWith.
+type InterfaceBridgeVlan_ListOptions struct {
+	// Filter, if set, only returns records matching all set fields.
+	Filter *InterfaceBridgeVlan_Filter
+	// Proplist, if set, only returns the given fields of every record.
+	Proplist []InterfaceBridgeVlan_Field
+}
+
 // InterfaceBridgeVlanList returns a list of all `interface/bridge/vlan` records.
 func (c *Client) InterfaceBridgeVlanList(ctx context.Context) ([]InterfaceBridgeVlan, error) {
-	body, err := c.doGET(ctx, "interface/bridge/vlan")
+	return c.InterfaceBridgeVlanListWith(ctx, nil)
+}
+
+// InterfaceBridgeVlanListWith returns a list of all `interface/bridge/vlan` records, optionally filtered
+// and projected by ROS according to the given options, which may be nil.
+func (c *Client) InterfaceBridgeVlanListWith(ctx context.Context, opts *InterfaceBridgeVlan_ListOptions) ([]InterfaceBridgeVlan, error) {
+	var filter *InterfaceBridgeVlan_Filter
+	var proplist []string
+	if opts != nil {
+		filter = opts.Filter
+		for _, f := range opts.Proplist {
+			proplist = append(proplist, string(f))
+		}
+	}
+	query, err := listQuery(filter, proplist)
+	if err != nil {
+		return nil, fmt.Errorf("invalid options: %w", err)
+	}
+	body, err := c.doGET(ctx, "interface/bridge/vlan", "", query)
 	if err != nil {
 		return nil, fmt.Errorf("could not GET: %w", err)
 	}
@@ -58,29 +173,160 @@ func (c *Client) InterfaceBridgeVlanList(ctx context.Context) ([]InterfaceBridge
 	return target, nil
 }
 
+// InterfaceBridgeVlanFind returns all `interface/bridge/vlan` records matching the given filter, which may
+// be nil. The filter is sent as a print .query and evaluated by ROS. If
+// proplist is given, only the given fields of every record are returned.
+func (c *Client) InterfaceBridgeVlanFind(ctx context.Context, filter *InterfaceBridgeVlan_Filter, proplist ...InterfaceBridgeVlan_Field) ([]InterfaceBridgeVlan, error) {
+	var props []string
+	for _, f := range proplist {
+		props = append(props, string(f))
+	}
+	rdata, err := printQuery(filter, props)
+	if err != nil {
+		return nil, fmt.Errorf("invalid filter: %w", err)
+	}
+	body, err := c.doPOST(ctx, "interface/bridge/vlan", "print", rdata)
+	if err != nil {
+		return nil, fmt.Errorf("could not POST: %w", err)
+	}
+	defer body.Close()
+
+	var target []InterfaceBridgeVlan
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return target, nil
+}
+
 // InterfaceBridgeVlanPatch updates the given fields of a `interface/bridge/vlan` record by ID.
 func (c *Client) InterfaceBridgeVlanPatch(ctx context.Context, id RecordID, u *InterfaceBridgeVlan_Update) (*InterfaceBridgeVlan, error) {
 	rdata, err := json.Marshal(u)
 	if err != nil {
 		return nil, fmt.Errorf("could not marshal update: %w", err)
 	}
-	body, err := c.doPATCH(ctx, "interface/bridge/vlan/"+string(id), rdata)
+	body, err := c.doPATCH(ctx, "interface/bridge/vlan", id, rdata)
 	if err != nil {
 		return nil, fmt.Errorf("could not PATCH: %w", err)
 	}
 	defer body.Close()
 
-	var target struct {
-		InterfaceBridgeVlan
-		Error   int64  `json:"error"`
-		Message string `json:"message"`
-		Detail  string `json:"detail"`
+	var target InterfaceBridgeVlan
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return &target, nil
+}
+
+// InterfaceBridgeVlanGet returns a single `interface/bridge/vlan` record by ID.
+func (c *Client) InterfaceBridgeVlanGet(ctx context.Context, id RecordID) (*InterfaceBridgeVlan, error) {
+	body, err := c.doGET(ctx, "interface/bridge/vlan", id, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not GET: %w", err)
+	}
+	defer body.Close()
+
+	var target InterfaceBridgeVlan
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return &target, nil
+}
+
+// InterfaceBridgeVlanAdd creates a new `interface/bridge/vlan` record with the given fields set. Any unset
+// field will be populated by ROS with its default value.
+func (c *Client) InterfaceBridgeVlanAdd(ctx context.Context, u *InterfaceBridgeVlan_Update) (*InterfaceBridgeVlan, error) {
+	rdata, err := json.Marshal(u)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal update: %w", err)
+	}
+	body, err := c.doPUT(ctx, "interface/bridge/vlan", rdata)
+	if err != nil {
+		return nil, fmt.Errorf("could not PUT: %w", err)
 	}
+	defer body.Close()
+
+	var target InterfaceBridgeVlan
 	if err := json.NewDecoder(body).Decode(&target); err != nil {
 		return nil, fmt.Errorf("could not decode JSON: %w", err)
 	}
-	if target.Error != 0 {
-		return nil, fmt.Errorf("server error: %s: %s", target.Message, target.Detail)
+	return &target, nil
+}
+
+// InterfaceBridgeVlanRemove deletes a `interface/bridge/vlan` record by ID.
+func (c *Client) InterfaceBridgeVlanRemove(ctx context.Context, id RecordID) error {
+	body, err := c.doDELETE(ctx, "interface/bridge/vlan", id)
+	if err != nil {
+		return fmt.Errorf("could not DELETE: %w", err)
+	}
+	body.Close()
+	return nil
+}
+
+// InterfaceBridgeVlan_Event is a change to a `interface/bridge/vlan` record observed by InterfaceBridgeVlanWatch.
+type InterfaceBridgeVlan_Event struct {
+	Type EventType
+	// Before is the record before the change, nil if Type is EventAdded.
+	Before *InterfaceBridgeVlan
+	// After is the record after the change, nil if Type is EventRemoved.
+	After *InterfaceBridgeVlan
+	// Err is the polling error if Type is EventError.
+	Err error
+}
+
+// InterfaceBridgeVlanWatch polls the `interface/bridge/vlan` records every interval and sends an event for
+// every record added, modified or removed since the previous poll, starting
+// with an EventAdded for every existing record. Polling errors are sent as
+// EventError, and retried with backoff. The channel is closed once ctx is
+// canceled, or after a single EventError if interval is not positive.
+func (c *Client) InterfaceBridgeVlanWatch(ctx context.Context, interval time.Duration) <-chan InterfaceBridgeVlan_Event {
+	ch := make(chan InterfaceBridgeVlan_Event)
+	list := func(ctx context.Context) ([]watchedRecord, error) {
+		records, err := c.InterfaceBridgeVlanList(ctx)
+		if err != nil {
+			return nil, err
+		}
+		res := make([]watchedRecord, len(records))
+		for i := range records {
+			res[i] = watchedRecord{id: records[i].ID, record: &records[i]}
+		}
+		return res, nil
+	}
+	emit := func(t EventType, before, after interface{}, err error) bool {
+		ev := InterfaceBridgeVlan_Event{Type: t, Err: err}
+		if before != nil {
+			ev.Before = before.(*InterfaceBridgeVlan)
+		}
+		if after != nil {
+			ev.After = after.(*InterfaceBridgeVlan)
+		}
+		select {
+		case ch <- ev:
+			return true
+		case <-ctx.Done():
+			return false
+		}
 	}
-	return &target.InterfaceBridgeVlan, nil
+	go watch(ctx, interval, list, emit, func() { close(ch) })
+	return ch
+}
+
+// InterfaceBridgeVlan_Schema describes the `interface/bridge/vlan` menu.
+var InterfaceBridgeVlan_Schema = &MenuSchema{
+	Path:  "interface/bridge/vlan",
+	Table: true,
+	Key:   []string{"bridge", "vlan-ids"},
+	Properties: []*PropertySchema{
+		{Name: "bridge", Kind: KindString},
+		{Name: "disabled", Kind: KindBoolean},
+		{Name: "tagged", Kind: KindStringList},
+		{Name: "untagged", Kind: KindStringList},
+		{Name: "vlan-ids", Kind: KindNumber},
+		{Name: "current-tagged", Kind: KindStringList, ReadOnly: true},
+		{Name: "current-untagged", Kind: KindStringList, ReadOnly: true},
+		{Name: "dynamic", Kind: KindBoolean, ReadOnly: true},
+	},
+}
+
+func init() {
+	registerMenu(InterfaceBridgeVlan_Schema)
 }
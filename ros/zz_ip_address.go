@@ -0,0 +1,339 @@
+package ros
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Automatically generated by github.com/q3k/ros7api/gen, do not edit.
+
+// IpAddress represents a ROS `ip/address` record, including read-only fields.
+//
+// IPv4 addresses assigned to interfaces. The same address can be assigned to multiple interfaces.
+type IpAddress struct {
+	Record
+
+	// IP address with prefix length, eg. 10.0.0.1/24.
+	Address IPNet `json:"address"`
+	// IP address of the network. If not set, it is calculated from the address and its prefix length.
+	Network IP `json:"network"`
+	// Name of the interface the IP address is assigned to.
+	Interface string `json:"interface"`
+	// Short description of the address.
+	Comment string `json:"comment"`
+	// Whether the address is disabled.
+	Disabled Boolean `json:"disabled"`
+	// Name of the interface the address is actually bound to, eg. the bridge when interface is one of its ports.
+	ActualInterface string `json:"actual-interface"`
+	// Whether the address was added dynamically, eg. by a DHCP client.
+	Dynamic Boolean `json:"dynamic"`
+	// Whether the address is invalid, eg. because its interface does not exist.
+	Invalid Boolean `json:"invalid"`
+}
+
+// IpAddress_Update is an update to a ROS `ip/address` record. Any unset field will not be updated.
+type IpAddress_Update struct {
+	// IP address with prefix length, eg. 10.0.0.1/24.
+	Address *IPNet `json:"address,omitempty"`
+	// IP address of the network. If not set, it is calculated from the address and its prefix length.
+	Network *IP `json:"network,omitempty"`
+	// Name of the interface the IP address is assigned to.
+	Interface *string `json:"interface,omitempty"`
+	// Short description of the address.
+	Comment *string `json:"comment,omitempty"`
+	// Whether the address is disabled.
+	Disabled *Boolean `json:"disabled,omitempty"`
+}
+
+// ToUpdate returns an update setting all settable fields of a `ip/address` record to
+// their values in r. Fields unset in r which ROS doesn't accept empty (eg.
+// addresses, port lists or enums) are left out, so that listed records can be
+// written back. List values are shared between r and the update.
+func (r *IpAddress) ToUpdate() *IpAddress_Update {
+	u := &IpAddress_Update{}
+	if r.Address.Address != nil {
+		u.Address = new(IPNet)
+		*u.Address = r.Address
+	}
+	if len(r.Network) != 0 {
+		u.Network = new(IP)
+		*u.Network = r.Network
+	}
+	u.Interface = new(string)
+	*u.Interface = r.Interface
+	u.Comment = new(string)
+	*u.Comment = r.Comment
+	u.Disabled = new(Boolean)
+	*u.Disabled = r.Disabled
+	return u
+}
+
+// DiffIpAddress returns an update which changes current into desired, setting only
+// the settable fields that differ between them. Read-only fields, and fields
+// left out by ToUpdate when unset in desired, are ignored. The update IsEmpty if
+// there is nothing to change.
+func DiffIpAddress(current, desired *IpAddress) *IpAddress_Update {
+	u := &IpAddress_Update{}
+	if (desired.Address.Address != nil) && !current.Address.Equal(desired.Address) {
+		u.Address = new(IPNet)
+		*u.Address = desired.Address
+	}
+	if (len(desired.Network) != 0) && !current.Network.Equal(desired.Network) {
+		u.Network = new(IP)
+		*u.Network = desired.Network
+	}
+	if current.Interface != desired.Interface {
+		u.Interface = new(string)
+		*u.Interface = desired.Interface
+	}
+	if current.Comment != desired.Comment {
+		u.Comment = new(string)
+		*u.Comment = desired.Comment
+	}
+	if current.Disabled != desired.Disabled {
+		u.Disabled = new(Boolean)
+		*u.Disabled = desired.Disabled
+	}
+	return u
+}
+
+// IsEmpty returns whether the update does not set any field, ie. applying it
+// would be a no-op.
+func (u *IpAddress_Update) IsEmpty() bool {
+	return u.Address == nil &&
+		u.Network == nil &&
+		u.Interface == nil &&
+		u.Comment == nil &&
+		u.Disabled == nil
+}
+
+// IpAddress_Field is the name of a `ip/address` record property, for use in .proplist
+// projections.
+type IpAddress_Field string
+
+const (
+	IpAddress_FieldID              IpAddress_Field = ".id"
+	IpAddress_FieldAddress         IpAddress_Field = "address"
+	IpAddress_FieldNetwork         IpAddress_Field = "network"
+	IpAddress_FieldInterface       IpAddress_Field = "interface"
+	IpAddress_FieldComment         IpAddress_Field = "comment"
+	IpAddress_FieldDisabled        IpAddress_Field = "disabled"
+	IpAddress_FieldActualInterface IpAddress_Field = "actual-interface"
+	IpAddress_FieldDynamic         IpAddress_Field = "dynamic"
+	IpAddress_FieldInvalid         IpAddress_Field = "invalid"
+)
+
+// IpAddress_Filter is an equality filter on `ip/address` records, evaluated by ROS.
+// Any unset field will not be filtered on.
+type IpAddress_Filter struct {
+	ID              *RecordID `json:".id,omitempty"`
+	Address         *IPNet    `json:"address,omitempty"`
+	Network         *IP       `json:"network,omitempty"`
+	Interface       *string   `json:"interface,omitempty"`
+	Comment         *string   `json:"comment,omitempty"`
+	Disabled        *Boolean  `json:"disabled,omitempty"`
+	ActualInterface *string   `json:"actual-interface,omitempty"`
+	Dynamic         *Boolean  `json:"dynamic,omitempty"`
+	Invalid         *Boolean  `json:"invalid,omitempty"`
+}
+
+// IpAddress_ListOptions limits the records and fields returned by IpAddressListWith.
+type IpAddress_ListOptions struct {
+	// Filter, if set, only returns records matching all set fields.
+	Filter *IpAddress_Filter
+	// Proplist, if set, only returns the given fields of every record.
+	Proplist []IpAddress_Field
+}
+
+// IpAddressList returns a list of all `ip/address` records.
+func (c *Client) IpAddressList(ctx context.Context) ([]IpAddress, error) {
+	return c.IpAddressListWith(ctx, nil)
+}
+
+// IpAddressListWith returns a list of all `ip/address` records, optionally filtered
+// and projected by ROS according to the given options, which may be nil.
+func (c *Client) IpAddressListWith(ctx context.Context, opts *IpAddress_ListOptions) ([]IpAddress, error) {
+	var filter *IpAddress_Filter
+	var proplist []string
+	if opts != nil {
+		filter = opts.Filter
+		for _, f := range opts.Proplist {
+			proplist = append(proplist, string(f))
+		}
+	}
+	query, err := listQuery(filter, proplist)
+	if err != nil {
+		return nil, fmt.Errorf("invalid options: %w", err)
+	}
+	body, err := c.doGET(ctx, "ip/address", "", query)
+	if err != nil {
+		return nil, fmt.Errorf("could not GET: %w", err)
+	}
+	defer body.Close()
+
+	var target []IpAddress
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return target, nil
+}
+
+// IpAddressFind returns all `ip/address` records matching the given filter, which may
+// be nil. The filter is sent as a print .query and evaluated by ROS. If
+// proplist is given, only the given fields of every record are returned.
+func (c *Client) IpAddressFind(ctx context.Context, filter *IpAddress_Filter, proplist ...IpAddress_Field) ([]IpAddress, error) {
+	var props []string
+	for _, f := range proplist {
+		props = append(props, string(f))
+	}
+	rdata, err := printQuery(filter, props)
+	if err != nil {
+		return nil, fmt.Errorf("invalid filter: %w", err)
+	}
+	body, err := c.doPOST(ctx, "ip/address", "print", rdata)
+	if err != nil {
+		return nil, fmt.Errorf("could not POST: %w", err)
+	}
+	defer body.Close()
+
+	var target []IpAddress
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return target, nil
+}
+
+// IpAddressPatch updates the given fields of a `ip/address` record by ID.
+func (c *Client) IpAddressPatch(ctx context.Context, id RecordID, u *IpAddress_Update) (*IpAddress, error) {
+	rdata, err := json.Marshal(u)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal update: %w", err)
+	}
+	body, err := c.doPATCH(ctx, "ip/address", id, rdata)
+	if err != nil {
+		return nil, fmt.Errorf("could not PATCH: %w", err)
+	}
+	defer body.Close()
+
+	var target IpAddress
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return &target, nil
+}
+
+// IpAddressGet returns a single `ip/address` record by ID.
+func (c *Client) IpAddressGet(ctx context.Context, id RecordID) (*IpAddress, error) {
+	body, err := c.doGET(ctx, "ip/address", id, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not GET: %w", err)
+	}
+	defer body.Close()
+
+	var target IpAddress
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return &target, nil
+}
+
+// IpAddressAdd creates a new `ip/address` record with the given fields set. Any unset
+// field will be populated by ROS with its default value.
+func (c *Client) IpAddressAdd(ctx context.Context, u *IpAddress_Update) (*IpAddress, error) {
+	rdata, err := json.Marshal(u)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal update: %w", err)
+	}
+	body, err := c.doPUT(ctx, "ip/address", rdata)
+	if err != nil {
+		return nil, fmt.Errorf("could not PUT: %w", err)
+	}
+	defer body.Close()
+
+	var target IpAddress
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return &target, nil
+}
+
+// IpAddressRemove deletes a `ip/address` record by ID.
+func (c *Client) IpAddressRemove(ctx context.Context, id RecordID) error {
+	body, err := c.doDELETE(ctx, "ip/address", id)
+	if err != nil {
+		return fmt.Errorf("could not DELETE: %w", err)
+	}
+	body.Close()
+	return nil
+}
+
+// IpAddress_Event is a change to a `ip/address` record observed by IpAddressWatch.
+type IpAddress_Event struct {
+	Type EventType
+	// Before is the record before the change, nil if Type is EventAdded.
+	Before *IpAddress
+	// After is the record after the change, nil if Type is EventRemoved.
+	After *IpAddress
+	// Err is the polling error if Type is EventError.
+	Err error
+}
+
+// IpAddressWatch polls the `ip/address` records every interval and sends an event for
+// every record added, modified or removed since the previous poll, starting
+// with an EventAdded for every existing record. Polling errors are sent as
+// EventError, and retried with backoff. The channel is closed once ctx is
+// canceled, or after a single EventError if interval is not positive.
+func (c *Client) IpAddressWatch(ctx context.Context, interval time.Duration) <-chan IpAddress_Event {
+	ch := make(chan IpAddress_Event)
+	list := func(ctx context.Context) ([]watchedRecord, error) {
+		records, err := c.IpAddressList(ctx)
+		if err != nil {
+			return nil, err
+		}
+		res := make([]watchedRecord, len(records))
+		for i := range records {
+			res[i] = watchedRecord{id: records[i].ID, record: &records[i]}
+		}
+		return res, nil
+	}
+	emit := func(t EventType, before, after interface{}, err error) bool {
+		ev := IpAddress_Event{Type: t, Err: err}
+		if before != nil {
+			ev.Before = before.(*IpAddress)
+		}
+		if after != nil {
+			ev.After = after.(*IpAddress)
+		}
+		select {
+		case ch <- ev:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+	go watch(ctx, interval, list, emit, func() { close(ch) })
+	return ch
+}
+
+// IpAddress_Schema describes the `ip/address` menu.
+var IpAddress_Schema = &MenuSchema{
+	Path:  "ip/address",
+	Table: true,
+	Key:   []string{"address", "interface"},
+	Properties: []*PropertySchema{
+		{Name: "address", Kind: KindIPPrefix},
+		{Name: "network", Kind: KindIP},
+		{Name: "interface", Kind: KindString},
+		{Name: "comment", Kind: KindString},
+		{Name: "disabled", Kind: KindBoolean},
+		{Name: "actual-interface", Kind: KindString, ReadOnly: true},
+		{Name: "dynamic", Kind: KindBoolean, ReadOnly: true},
+		{Name: "invalid", Kind: KindBoolean, ReadOnly: true},
+	},
+}
+
+func init() {
+	registerMenu(IpAddress_Schema)
+}
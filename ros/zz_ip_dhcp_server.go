@@ -0,0 +1,490 @@
+package ros
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Automatically generated by github.com/q3k/ros7api/gen, do not edit.
+
+// IpDhcpServer_Authoritative is the type of the `authoritative` property. Values not known to this library
+// (eg. introduced in newer ROS versions) are kept as-is, but reported
+// through UnknownEnumHandler.
+type IpDhcpServer_Authoritative string
+
+const (
+	// Requests for unknown leases are refused immediately.
+	IpDhcpServer_AuthoritativeYes IpDhcpServer_Authoritative = "yes"
+	// Requests for unknown leases are ignored.
+	IpDhcpServer_AuthoritativeNo IpDhcpServer_Authoritative = "no"
+	// Requests for unknown leases are refused if the client keeps asking for 2 seconds.
+	IpDhcpServer_AuthoritativeAfter2secDelay IpDhcpServer_Authoritative = "after-2sec-delay"
+	// Requests for unknown leases are refused if the client keeps asking for 10 seconds.
+	IpDhcpServer_AuthoritativeAfter10secDelay IpDhcpServer_Authoritative = "after-10sec-delay"
+)
+
+// Values returns all values of IpDhcpServer_Authoritative known to this library.
+func (IpDhcpServer_Authoritative) Values() []IpDhcpServer_Authoritative {
+	return []IpDhcpServer_Authoritative{
+		IpDhcpServer_AuthoritativeYes,
+		IpDhcpServer_AuthoritativeNo,
+		IpDhcpServer_AuthoritativeAfter2secDelay,
+		IpDhcpServer_AuthoritativeAfter10secDelay,
+	}
+}
+
+// IsKnown returns whether the value is known to this library.
+func (e IpDhcpServer_Authoritative) IsKnown() bool {
+	switch e {
+	case IpDhcpServer_AuthoritativeYes, IpDhcpServer_AuthoritativeNo, IpDhcpServer_AuthoritativeAfter2secDelay, IpDhcpServer_AuthoritativeAfter10secDelay:
+		return true
+	}
+	return false
+}
+
+func (e IpDhcpServer_Authoritative) String() string {
+	return string(e)
+}
+
+func (e *IpDhcpServer_Authoritative) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*e = IpDhcpServer_Authoritative(s)
+	if s != "" && !e.IsKnown() {
+		reportUnknownEnum("IpDhcpServer_Authoritative", s)
+	}
+	return nil
+}
+
+func (e IpDhcpServer_Authoritative) MarshalJSON() ([]byte, error) {
+	if e != "" && !e.IsKnown() {
+		reportUnknownEnum("IpDhcpServer_Authoritative", string(e))
+	}
+	return json.Marshal(string(e))
+}
+
+// IpDhcpServer represents a ROS `ip/dhcp-server` record, including read-only fields.
+//
+// DHCP servers, each handing out addresses on an interface.
+type IpDhcpServer struct {
+	Record
+
+	// Name of the server.
+	Name string `json:"name"`
+	// Interface the server listens on.
+	Interface string `json:"interface"`
+	// Pool dynamic leases are taken from, or static-only to only hand out static leases.
+	AddressPool string `json:"address-pool"`
+	// Time for which leases are granted, unless overridden by a static lease.
+	LeaseTime Duration `json:"lease-time"`
+	// How the server responds to requests for leases it doesn't know about, eg. after a server change.
+	Authoritative IpDhcpServer_Authoritative `json:"authoritative"`
+	// Whether to add static ARP entries for leases.
+	AddARP Boolean `json:"add-arp"`
+	// Whether to always broadcast replies, even to clients which accept unicast.
+	AlwaysBroadcast Boolean `json:"always-broadcast"`
+	// Whether to check that an address is unused before offering it.
+	ConflictDetection Boolean `json:"conflict-detection"`
+	// Address of the DHCP relay the server serves requests from, or 0.0.0.0 for direct requests.
+	Relay IP `json:"relay"`
+	// Script run when a lease is granted or released.
+	LeaseScript string `json:"lease-script"`
+	// Short description of the server.
+	Comment string `json:"comment"`
+	// Whether the server is disabled.
+	Disabled Boolean `json:"disabled"`
+	// Whether the server was added dynamically.
+	Dynamic Boolean `json:"dynamic"`
+	// Whether the server is invalid, eg. because its interface doesn't exist.
+	Invalid Boolean `json:"invalid"`
+}
+
+// IpDhcpServer_Update is an update to a ROS `ip/dhcp-server` record. Any unset field will not be updated.
+type IpDhcpServer_Update struct {
+	// Name of the server.
+	Name *string `json:"name,omitempty"`
+	// Interface the server listens on.
+	Interface *string `json:"interface,omitempty"`
+	// Pool dynamic leases are taken from, or static-only to only hand out static leases.
+	AddressPool *string `json:"address-pool,omitempty"`
+	// Time for which leases are granted, unless overridden by a static lease.
+	LeaseTime *Duration `json:"lease-time,omitempty"`
+	// How the server responds to requests for leases it doesn't know about, eg. after a server change.
+	Authoritative *IpDhcpServer_Authoritative `json:"authoritative,omitempty"`
+	// Whether to add static ARP entries for leases.
+	AddARP *Boolean `json:"add-arp,omitempty"`
+	// Whether to always broadcast replies, even to clients which accept unicast.
+	AlwaysBroadcast *Boolean `json:"always-broadcast,omitempty"`
+	// Whether to check that an address is unused before offering it.
+	ConflictDetection *Boolean `json:"conflict-detection,omitempty"`
+	// Address of the DHCP relay the server serves requests from, or 0.0.0.0 for direct requests.
+	Relay *IP `json:"relay,omitempty"`
+	// Script run when a lease is granted or released.
+	LeaseScript *string `json:"lease-script,omitempty"`
+	// Short description of the server.
+	Comment *string `json:"comment,omitempty"`
+	// Whether the server is disabled.
+	Disabled *Boolean `json:"disabled,omitempty"`
+}
+
+// ToUpdate returns an update setting all settable fields of a `ip/dhcp-server` record to
+// their values in r. Fields unset in r which ROS doesn't accept empty (eg.
+// addresses, port lists or enums) are left out, so that listed records can be
+// written back. List values are shared between r and the update.
+func (r *IpDhcpServer) ToUpdate() *IpDhcpServer_Update {
+	u := &IpDhcpServer_Update{}
+	u.Name = new(string)
+	*u.Name = r.Name
+	u.Interface = new(string)
+	*u.Interface = r.Interface
+	u.AddressPool = new(string)
+	*u.AddressPool = r.AddressPool
+	u.LeaseTime = new(Duration)
+	*u.LeaseTime = r.LeaseTime
+	if r.Authoritative != "" {
+		u.Authoritative = new(IpDhcpServer_Authoritative)
+		*u.Authoritative = r.Authoritative
+	}
+	u.AddARP = new(Boolean)
+	*u.AddARP = r.AddARP
+	u.AlwaysBroadcast = new(Boolean)
+	*u.AlwaysBroadcast = r.AlwaysBroadcast
+	u.ConflictDetection = new(Boolean)
+	*u.ConflictDetection = r.ConflictDetection
+	if len(r.Relay) != 0 {
+		u.Relay = new(IP)
+		*u.Relay = r.Relay
+	}
+	u.LeaseScript = new(string)
+	*u.LeaseScript = r.LeaseScript
+	u.Comment = new(string)
+	*u.Comment = r.Comment
+	u.Disabled = new(Boolean)
+	*u.Disabled = r.Disabled
+	return u
+}
+
+// DiffIpDhcpServer returns an update which changes current into desired, setting only
+// the settable fields that differ between them. Read-only fields, and fields
+// left out by ToUpdate when unset in desired, are ignored. The update IsEmpty if
+// there is nothing to change.
+func DiffIpDhcpServer(current, desired *IpDhcpServer) *IpDhcpServer_Update {
+	u := &IpDhcpServer_Update{}
+	if current.Name != desired.Name {
+		u.Name = new(string)
+		*u.Name = desired.Name
+	}
+	if current.Interface != desired.Interface {
+		u.Interface = new(string)
+		*u.Interface = desired.Interface
+	}
+	if current.AddressPool != desired.AddressPool {
+		u.AddressPool = new(string)
+		*u.AddressPool = desired.AddressPool
+	}
+	if current.LeaseTime != desired.LeaseTime {
+		u.LeaseTime = new(Duration)
+		*u.LeaseTime = desired.LeaseTime
+	}
+	if (desired.Authoritative != "") && current.Authoritative != desired.Authoritative {
+		u.Authoritative = new(IpDhcpServer_Authoritative)
+		*u.Authoritative = desired.Authoritative
+	}
+	if current.AddARP != desired.AddARP {
+		u.AddARP = new(Boolean)
+		*u.AddARP = desired.AddARP
+	}
+	if current.AlwaysBroadcast != desired.AlwaysBroadcast {
+		u.AlwaysBroadcast = new(Boolean)
+		*u.AlwaysBroadcast = desired.AlwaysBroadcast
+	}
+	if current.ConflictDetection != desired.ConflictDetection {
+		u.ConflictDetection = new(Boolean)
+		*u.ConflictDetection = desired.ConflictDetection
+	}
+	if (len(desired.Relay) != 0) && !current.Relay.Equal(desired.Relay) {
+		u.Relay = new(IP)
+		*u.Relay = desired.Relay
+	}
+	if current.LeaseScript != desired.LeaseScript {
+		u.LeaseScript = new(string)
+		*u.LeaseScript = desired.LeaseScript
+	}
+	if current.Comment != desired.Comment {
+		u.Comment = new(string)
+		*u.Comment = desired.Comment
+	}
+	if current.Disabled != desired.Disabled {
+		u.Disabled = new(Boolean)
+		*u.Disabled = desired.Disabled
+	}
+	return u
+}
+
+// IsEmpty returns whether the update does not set any field, ie. applying it
+// would be a no-op.
+func (u *IpDhcpServer_Update) IsEmpty() bool {
+	return u.Name == nil &&
+		u.Interface == nil &&
+		u.AddressPool == nil &&
+		u.LeaseTime == nil &&
+		u.Authoritative == nil &&
+		u.AddARP == nil &&
+		u.AlwaysBroadcast == nil &&
+		u.ConflictDetection == nil &&
+		u.Relay == nil &&
+		u.LeaseScript == nil &&
+		u.Comment == nil &&
+		u.Disabled == nil
+}
+
+// IpDhcpServer_Field is the name of a `ip/dhcp-server` record property, for use in .proplist
+// projections.
+type IpDhcpServer_Field string
+
+const (
+	IpDhcpServer_FieldID                IpDhcpServer_Field = ".id"
+	IpDhcpServer_FieldName              IpDhcpServer_Field = "name"
+	IpDhcpServer_FieldInterface         IpDhcpServer_Field = "interface"
+	IpDhcpServer_FieldAddressPool       IpDhcpServer_Field = "address-pool"
+	IpDhcpServer_FieldLeaseTime         IpDhcpServer_Field = "lease-time"
+	IpDhcpServer_FieldAuthoritative     IpDhcpServer_Field = "authoritative"
+	IpDhcpServer_FieldAddARP            IpDhcpServer_Field = "add-arp"
+	IpDhcpServer_FieldAlwaysBroadcast   IpDhcpServer_Field = "always-broadcast"
+	IpDhcpServer_FieldConflictDetection IpDhcpServer_Field = "conflict-detection"
+	IpDhcpServer_FieldRelay             IpDhcpServer_Field = "relay"
+	IpDhcpServer_FieldLeaseScript       IpDhcpServer_Field = "lease-script"
+	IpDhcpServer_FieldComment           IpDhcpServer_Field = "comment"
+	IpDhcpServer_FieldDisabled          IpDhcpServer_Field = "disabled"
+	IpDhcpServer_FieldDynamic           IpDhcpServer_Field = "dynamic"
+	IpDhcpServer_FieldInvalid           IpDhcpServer_Field = "invalid"
+)
+
+// IpDhcpServer_Filter is an equality filter on `ip/dhcp-server` records, evaluated by ROS.
+// Any unset field will not be filtered on.
+type IpDhcpServer_Filter struct {
+	ID                *RecordID                   `json:".id,omitempty"`
+	Name              *string                     `json:"name,omitempty"`
+	Interface         *string                     `json:"interface,omitempty"`
+	AddressPool       *string                     `json:"address-pool,omitempty"`
+	LeaseTime         *Duration                   `json:"lease-time,omitempty"`
+	Authoritative     *IpDhcpServer_Authoritative `json:"authoritative,omitempty"`
+	AddARP            *Boolean                    `json:"add-arp,omitempty"`
+	AlwaysBroadcast   *Boolean                    `json:"always-broadcast,omitempty"`
+	ConflictDetection *Boolean                    `json:"conflict-detection,omitempty"`
+	Relay             *IP                         `json:"relay,omitempty"`
+	LeaseScript       *string                     `json:"lease-script,omitempty"`
+	Comment           *string                     `json:"comment,omitempty"`
+	Disabled          *Boolean                    `json:"disabled,omitempty"`
+	Dynamic           *Boolean                    `json:"dynamic,omitempty"`
+	Invalid           *Boolean                    `json:"invalid,omitempty"`
+}
+
+// IpDhcpServer_ListOptions limits the records and fields returned by IpDhcpServerListWith.
+type IpDhcpServer_ListOptions struct {
+	// Filter, if set, only returns records matching all set fields.
+	Filter *IpDhcpServer_Filter
+	// Proplist, if set, only returns the given fields of every record.
+	Proplist []IpDhcpServer_Field
+}
+
+// IpDhcpServerList returns a list of all `ip/dhcp-server` records.
+func (c *Client) IpDhcpServerList(ctx context.Context) ([]IpDhcpServer, error) {
+	return c.IpDhcpServerListWith(ctx, nil)
+}
+
+// IpDhcpServerListWith returns a list of all `ip/dhcp-server` records, optionally filtered
+// and projected by ROS according to the given options, which may be nil.
+func (c *Client) IpDhcpServerListWith(ctx context.Context, opts *IpDhcpServer_ListOptions) ([]IpDhcpServer, error) {
+	var filter *IpDhcpServer_Filter
+	var proplist []string
+	if opts != nil {
+		filter = opts.Filter
+		for _, f := range opts.Proplist {
+			proplist = append(proplist, string(f))
+		}
+	}
+	query, err := listQuery(filter, proplist)
+	if err != nil {
+		return nil, fmt.Errorf("invalid options: %w", err)
+	}
+	body, err := c.doGET(ctx, "ip/dhcp-server", "", query)
+	if err != nil {
+		return nil, fmt.Errorf("could not GET: %w", err)
+	}
+	defer body.Close()
+
+	var target []IpDhcpServer
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return target, nil
+}
+
+// IpDhcpServerFind returns all `ip/dhcp-server` records matching the given filter, which may
+// be nil. The filter is sent as a print .query and evaluated by ROS. If
+// proplist is given, only the given fields of every record are returned.
+func (c *Client) IpDhcpServerFind(ctx context.Context, filter *IpDhcpServer_Filter, proplist ...IpDhcpServer_Field) ([]IpDhcpServer, error) {
+	var props []string
+	for _, f := range proplist {
+		props = append(props, string(f))
+	}
+	rdata, err := printQuery(filter, props)
+	if err != nil {
+		return nil, fmt.Errorf("invalid filter: %w", err)
+	}
+	body, err := c.doPOST(ctx, "ip/dhcp-server", "print", rdata)
+	if err != nil {
+		return nil, fmt.Errorf("could not POST: %w", err)
+	}
+	defer body.Close()
+
+	var target []IpDhcpServer
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return target, nil
+}
+
+// IpDhcpServerPatch updates the given fields of a `ip/dhcp-server` record by ID.
+func (c *Client) IpDhcpServerPatch(ctx context.Context, id RecordID, u *IpDhcpServer_Update) (*IpDhcpServer, error) {
+	rdata, err := json.Marshal(u)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal update: %w", err)
+	}
+	body, err := c.doPATCH(ctx, "ip/dhcp-server", id, rdata)
+	if err != nil {
+		return nil, fmt.Errorf("could not PATCH: %w", err)
+	}
+	defer body.Close()
+
+	var target IpDhcpServer
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return &target, nil
+}
+
+// IpDhcpServerGet returns a single `ip/dhcp-server` record by ID.
+func (c *Client) IpDhcpServerGet(ctx context.Context, id RecordID) (*IpDhcpServer, error) {
+	body, err := c.doGET(ctx, "ip/dhcp-server", id, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not GET: %w", err)
+	}
+	defer body.Close()
+
+	var target IpDhcpServer
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return &target, nil
+}
+
+// IpDhcpServerAdd creates a new `ip/dhcp-server` record with the given fields set. Any unset
+// field will be populated by ROS with its default value.
+func (c *Client) IpDhcpServerAdd(ctx context.Context, u *IpDhcpServer_Update) (*IpDhcpServer, error) {
+	rdata, err := json.Marshal(u)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal update: %w", err)
+	}
+	body, err := c.doPUT(ctx, "ip/dhcp-server", rdata)
+	if err != nil {
+		return nil, fmt.Errorf("could not PUT: %w", err)
+	}
+	defer body.Close()
+
+	var target IpDhcpServer
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return &target, nil
+}
+
+// IpDhcpServerRemove deletes a `ip/dhcp-server` record by ID.
+func (c *Client) IpDhcpServerRemove(ctx context.Context, id RecordID) error {
+	body, err := c.doDELETE(ctx, "ip/dhcp-server", id)
+	if err != nil {
+		return fmt.Errorf("could not DELETE: %w", err)
+	}
+	body.Close()
+	return nil
+}
+
+// IpDhcpServer_Event is a change to a `ip/dhcp-server` record observed by IpDhcpServerWatch.
+type IpDhcpServer_Event struct {
+	Type EventType
+	// Before is the record before the change, nil if Type is EventAdded.
+	Before *IpDhcpServer
+	// After is the record after the change, nil if Type is EventRemoved.
+	After *IpDhcpServer
+	// Err is the polling error if Type is EventError.
+	Err error
+}
+
+// IpDhcpServerWatch polls the `ip/dhcp-server` records every interval and sends an event for
+// every record added, modified or removed since the previous poll, starting
+// with an EventAdded for every existing record. Polling errors are sent as
+// EventError, and retried with backoff. The channel is closed once ctx is
+// canceled, or after a single EventError if interval is not positive.
+func (c *Client) IpDhcpServerWatch(ctx context.Context, interval time.Duration) <-chan IpDhcpServer_Event {
+	ch := make(chan IpDhcpServer_Event)
+	list := func(ctx context.Context) ([]watchedRecord, error) {
+		records, err := c.IpDhcpServerList(ctx)
+		if err != nil {
+			return nil, err
+		}
+		res := make([]watchedRecord, len(records))
+		for i := range records {
+			res[i] = watchedRecord{id: records[i].ID, record: &records[i]}
+		}
+		return res, nil
+	}
+	emit := func(t EventType, before, after interface{}, err error) bool {
+		ev := IpDhcpServer_Event{Type: t, Err: err}
+		if before != nil {
+			ev.Before = before.(*IpDhcpServer)
+		}
+		if after != nil {
+			ev.After = after.(*IpDhcpServer)
+		}
+		select {
+		case ch <- ev:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+	go watch(ctx, interval, list, emit, func() { close(ch) })
+	return ch
+}
+
+// IpDhcpServer_Schema describes the `ip/dhcp-server` menu.
+var IpDhcpServer_Schema = &MenuSchema{
+	Path:  "ip/dhcp-server",
+	Table: true,
+	Key:   []string{"name"},
+	Properties: []*PropertySchema{
+		{Name: "name", Kind: KindString},
+		{Name: "interface", Kind: KindString},
+		{Name: "address-pool", Kind: KindString},
+		{Name: "lease-time", Kind: KindDuration},
+		{Name: "authoritative", Kind: KindEnum, Variants: []string{"yes", "no", "after-2sec-delay", "after-10sec-delay"}},
+		{Name: "add-arp", Kind: KindBoolean},
+		{Name: "always-broadcast", Kind: KindBoolean},
+		{Name: "conflict-detection", Kind: KindBoolean},
+		{Name: "relay", Kind: KindIP},
+		{Name: "lease-script", Kind: KindString},
+		{Name: "comment", Kind: KindString},
+		{Name: "disabled", Kind: KindBoolean},
+		{Name: "dynamic", Kind: KindBoolean, ReadOnly: true},
+		{Name: "invalid", Kind: KindBoolean, ReadOnly: true},
+	},
+}
+
+func init() {
+	registerMenu(IpDhcpServer_Schema)
+}
@@ -0,0 +1,369 @@
+package ros
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Automatically generated by github.com/q3k/ros7api/gen, do not edit.
+
+// IpDhcpServerNetwork represents a ROS `ip/dhcp-server/network` record, including read-only fields.
+//
+// Networks served by DHCP servers, with the settings handed out to their clients.
+type IpDhcpServerNetwork struct {
+	Record
+
+	// The network, eg. 10.0.0.0/24. Clients with addresses in it get the settings below.
+	Address IPNet `json:"address"`
+	// Default gateways handed out to clients.
+	Gateway StringList `json:"gateway"`
+	// Prefix length handed out to clients, or 0 to use the one of address.
+	Netmask Number `json:"netmask"`
+	// DNS servers handed out to clients.
+	DNSServer StringList `json:"dns-server"`
+	// NTP servers handed out to clients.
+	NTPServer StringList `json:"ntp-server"`
+	// DNS domain handed out to clients.
+	Domain string `json:"domain"`
+	// Names of additional options, from ip/dhcp-server/option, handed out to clients.
+	DHCPOption StringList `json:"dhcp-option"`
+	// Short description of the network.
+	Comment string `json:"comment"`
+	// Whether the network was added dynamically.
+	Dynamic Boolean `json:"dynamic"`
+}
+
+// IpDhcpServerNetwork_Update is an update to a ROS `ip/dhcp-server/network` record. Any unset field will not be updated.
+type IpDhcpServerNetwork_Update struct {
+	// The network, eg. 10.0.0.0/24. Clients with addresses in it get the settings below.
+	Address *IPNet `json:"address,omitempty"`
+	// Default gateways handed out to clients.
+	Gateway *StringList `json:"gateway,omitempty"`
+	// Prefix length handed out to clients, or 0 to use the one of address.
+	Netmask *Number `json:"netmask,omitempty"`
+	// DNS servers handed out to clients.
+	DNSServer *StringList `json:"dns-server,omitempty"`
+	// NTP servers handed out to clients.
+	NTPServer *StringList `json:"ntp-server,omitempty"`
+	// DNS domain handed out to clients.
+	Domain *string `json:"domain,omitempty"`
+	// Names of additional options, from ip/dhcp-server/option, handed out to clients.
+	DHCPOption *StringList `json:"dhcp-option,omitempty"`
+	// Short description of the network.
+	Comment *string `json:"comment,omitempty"`
+}
+
+// ToUpdate returns an update setting all settable fields of a `ip/dhcp-server/network` record to
+// their values in r. Fields unset in r which ROS doesn't accept empty (eg.
+// addresses, port lists or enums) are left out, so that listed records can be
+// written back. List values are shared between r and the update.
+func (r *IpDhcpServerNetwork) ToUpdate() *IpDhcpServerNetwork_Update {
+	u := &IpDhcpServerNetwork_Update{}
+	if r.Address.Address != nil {
+		u.Address = new(IPNet)
+		*u.Address = r.Address
+	}
+	u.Gateway = new(StringList)
+	*u.Gateway = r.Gateway
+	u.Netmask = new(Number)
+	*u.Netmask = r.Netmask
+	u.DNSServer = new(StringList)
+	*u.DNSServer = r.DNSServer
+	u.NTPServer = new(StringList)
+	*u.NTPServer = r.NTPServer
+	u.Domain = new(string)
+	*u.Domain = r.Domain
+	u.DHCPOption = new(StringList)
+	*u.DHCPOption = r.DHCPOption
+	u.Comment = new(string)
+	*u.Comment = r.Comment
+	return u
+}
+
+// DiffIpDhcpServerNetwork returns an update which changes current into desired, setting only
+// the settable fields that differ between them. Read-only fields, and fields
+// left out by ToUpdate when unset in desired, are ignored. The update IsEmpty if
+// there is nothing to change.
+func DiffIpDhcpServerNetwork(current, desired *IpDhcpServerNetwork) *IpDhcpServerNetwork_Update {
+	u := &IpDhcpServerNetwork_Update{}
+	if (desired.Address.Address != nil) && !current.Address.Equal(desired.Address) {
+		u.Address = new(IPNet)
+		*u.Address = desired.Address
+	}
+	if !current.Gateway.Equal(desired.Gateway) {
+		u.Gateway = new(StringList)
+		*u.Gateway = desired.Gateway
+	}
+	if current.Netmask != desired.Netmask {
+		u.Netmask = new(Number)
+		*u.Netmask = desired.Netmask
+	}
+	if !current.DNSServer.Equal(desired.DNSServer) {
+		u.DNSServer = new(StringList)
+		*u.DNSServer = desired.DNSServer
+	}
+	if !current.NTPServer.Equal(desired.NTPServer) {
+		u.NTPServer = new(StringList)
+		*u.NTPServer = desired.NTPServer
+	}
+	if current.Domain != desired.Domain {
+		u.Domain = new(string)
+		*u.Domain = desired.Domain
+	}
+	if !current.DHCPOption.Equal(desired.DHCPOption) {
+		u.DHCPOption = new(StringList)
+		*u.DHCPOption = desired.DHCPOption
+	}
+	if current.Comment != desired.Comment {
+		u.Comment = new(string)
+		*u.Comment = desired.Comment
+	}
+	return u
+}
+
+// IsEmpty returns whether the update does not set any field, ie. applying it
+// would be a no-op.
+func (u *IpDhcpServerNetwork_Update) IsEmpty() bool {
+	return u.Address == nil &&
+		u.Gateway == nil &&
+		u.Netmask == nil &&
+		u.DNSServer == nil &&
+		u.NTPServer == nil &&
+		u.Domain == nil &&
+		u.DHCPOption == nil &&
+		u.Comment == nil
+}
+
+// IpDhcpServerNetwork_Field is the name of a `ip/dhcp-server/network` record property, for use in .proplist
+// projections.
+type IpDhcpServerNetwork_Field string
+
+const (
+	IpDhcpServerNetwork_FieldID         IpDhcpServerNetwork_Field = ".id"
+	IpDhcpServerNetwork_FieldAddress    IpDhcpServerNetwork_Field = "address"
+	IpDhcpServerNetwork_FieldGateway    IpDhcpServerNetwork_Field = "gateway"
+	IpDhcpServerNetwork_FieldNetmask    IpDhcpServerNetwork_Field = "netmask"
+	IpDhcpServerNetwork_FieldDNSServer  IpDhcpServerNetwork_Field = "dns-server"
+	IpDhcpServerNetwork_FieldNTPServer  IpDhcpServerNetwork_Field = "ntp-server"
+	IpDhcpServerNetwork_FieldDomain     IpDhcpServerNetwork_Field = "domain"
+	IpDhcpServerNetwork_FieldDHCPOption IpDhcpServerNetwork_Field = "dhcp-option"
+	IpDhcpServerNetwork_FieldComment    IpDhcpServerNetwork_Field = "comment"
+	IpDhcpServerNetwork_FieldDynamic    IpDhcpServerNetwork_Field = "dynamic"
+)
+
+// IpDhcpServerNetwork_Filter is an equality filter on `ip/dhcp-server/network` records, evaluated by ROS.
+// Any unset field will not be filtered on.
+type IpDhcpServerNetwork_Filter struct {
+	ID         *RecordID   `json:".id,omitempty"`
+	Address    *IPNet      `json:"address,omitempty"`
+	Gateway    *StringList `json:"gateway,omitempty"`
+	Netmask    *Number     `json:"netmask,omitempty"`
+	DNSServer  *StringList `json:"dns-server,omitempty"`
+	NTPServer  *StringList `json:"ntp-server,omitempty"`
+	Domain     *string     `json:"domain,omitempty"`
+	DHCPOption *StringList `json:"dhcp-option,omitempty"`
+	Comment    *string     `json:"comment,omitempty"`
+	Dynamic    *Boolean    `json:"dynamic,omitempty"`
+}
+
+// IpDhcpServerNetwork_ListOptions limits the records and fields returned by IpDhcpServerNetworkListWith.
+type IpDhcpServerNetwork_ListOptions struct {
+	// Filter, if set, only returns records matching all set fields.
+	Filter *IpDhcpServerNetwork_Filter
+	// Proplist, if set, only returns the given fields of every record.
+	Proplist []IpDhcpServerNetwork_Field
+}
+
+// IpDhcpServerNetworkList returns a list of all `ip/dhcp-server/network` records.
+func (c *Client) IpDhcpServerNetworkList(ctx context.Context) ([]IpDhcpServerNetwork, error) {
+	return c.IpDhcpServerNetworkListWith(ctx, nil)
+}
+
+// IpDhcpServerNetworkListWith returns a list of all `ip/dhcp-server/network` records, optionally filtered
+// and projected by ROS according to the given options, which may be nil.
+func (c *Client) IpDhcpServerNetworkListWith(ctx context.Context, opts *IpDhcpServerNetwork_ListOptions) ([]IpDhcpServerNetwork, error) {
+	var filter *IpDhcpServerNetwork_Filter
+	var proplist []string
+	if opts != nil {
+		filter = opts.Filter
+		for _, f := range opts.Proplist {
+			proplist = append(proplist, string(f))
+		}
+	}
+	query, err := listQuery(filter, proplist)
+	if err != nil {
+		return nil, fmt.Errorf("invalid options: %w", err)
+	}
+	body, err := c.doGET(ctx, "ip/dhcp-server/network", "", query)
+	if err != nil {
+		return nil, fmt.Errorf("could not GET: %w", err)
+	}
+	defer body.Close()
+
+	var target []IpDhcpServerNetwork
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return target, nil
+}
+
+// IpDhcpServerNetworkFind returns all `ip/dhcp-server/network` records matching the given filter, which may
+// be nil. The filter is sent as a print .query and evaluated by ROS. If
+// proplist is given, only the given fields of every record are returned.
+func (c *Client) IpDhcpServerNetworkFind(ctx context.Context, filter *IpDhcpServerNetwork_Filter, proplist ...IpDhcpServerNetwork_Field) ([]IpDhcpServerNetwork, error) {
+	var props []string
+	for _, f := range proplist {
+		props = append(props, string(f))
+	}
+	rdata, err := printQuery(filter, props)
+	if err != nil {
+		return nil, fmt.Errorf("invalid filter: %w", err)
+	}
+	body, err := c.doPOST(ctx, "ip/dhcp-server/network", "print", rdata)
+	if err != nil {
+		return nil, fmt.Errorf("could not POST: %w", err)
+	}
+	defer body.Close()
+
+	var target []IpDhcpServerNetwork
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return target, nil
+}
+
+// IpDhcpServerNetworkPatch updates the given fields of a `ip/dhcp-server/network` record by ID.
+func (c *Client) IpDhcpServerNetworkPatch(ctx context.Context, id RecordID, u *IpDhcpServerNetwork_Update) (*IpDhcpServerNetwork, error) {
+	rdata, err := json.Marshal(u)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal update: %w", err)
+	}
+	body, err := c.doPATCH(ctx, "ip/dhcp-server/network", id, rdata)
+	if err != nil {
+		return nil, fmt.Errorf("could not PATCH: %w", err)
+	}
+	defer body.Close()
+
+	var target IpDhcpServerNetwork
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return &target, nil
+}
+
+// IpDhcpServerNetworkGet returns a single `ip/dhcp-server/network` record by ID.
+func (c *Client) IpDhcpServerNetworkGet(ctx context.Context, id RecordID) (*IpDhcpServerNetwork, error) {
+	body, err := c.doGET(ctx, "ip/dhcp-server/network", id, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not GET: %w", err)
+	}
+	defer body.Close()
+
+	var target IpDhcpServerNetwork
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return &target, nil
+}
+
+// IpDhcpServerNetworkAdd creates a new `ip/dhcp-server/network` record with the given fields set. Any unset
+// field will be populated by ROS with its default value.
+func (c *Client) IpDhcpServerNetworkAdd(ctx context.Context, u *IpDhcpServerNetwork_Update) (*IpDhcpServerNetwork, error) {
+	rdata, err := json.Marshal(u)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal update: %w", err)
+	}
+	body, err := c.doPUT(ctx, "ip/dhcp-server/network", rdata)
+	if err != nil {
+		return nil, fmt.Errorf("could not PUT: %w", err)
+	}
+	defer body.Close()
+
+	var target IpDhcpServerNetwork
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return &target, nil
+}
+
+// IpDhcpServerNetworkRemove deletes a `ip/dhcp-server/network` record by ID.
+func (c *Client) IpDhcpServerNetworkRemove(ctx context.Context, id RecordID) error {
+	body, err := c.doDELETE(ctx, "ip/dhcp-server/network", id)
+	if err != nil {
+		return fmt.Errorf("could not DELETE: %w", err)
+	}
+	body.Close()
+	return nil
+}
+
+// IpDhcpServerNetwork_Event is a change to a `ip/dhcp-server/network` record observed by IpDhcpServerNetworkWatch.
+type IpDhcpServerNetwork_Event struct {
+	Type EventType
+	// Before is the record before the change, nil if Type is EventAdded.
+	Before *IpDhcpServerNetwork
+	// After is the record after the change, nil if Type is EventRemoved.
+	After *IpDhcpServerNetwork
+	// Err is the polling error if Type is EventError.
+	Err error
+}
+
+// IpDhcpServerNetworkWatch polls the `ip/dhcp-server/network` records every interval and sends an event for
+// every record added, modified or removed since the previous poll, starting
+// with an EventAdded for every existing record. Polling errors are sent as
+// EventError, and retried with backoff. The channel is closed once ctx is
+// canceled, or after a single EventError if interval is not positive.
+func (c *Client) IpDhcpServerNetworkWatch(ctx context.Context, interval time.Duration) <-chan IpDhcpServerNetwork_Event {
+	ch := make(chan IpDhcpServerNetwork_Event)
+	list := func(ctx context.Context) ([]watchedRecord, error) {
+		records, err := c.IpDhcpServerNetworkList(ctx)
+		if err != nil {
+			return nil, err
+		}
+		res := make([]watchedRecord, len(records))
+		for i := range records {
+			res[i] = watchedRecord{id: records[i].ID, record: &records[i]}
+		}
+		return res, nil
+	}
+	emit := func(t EventType, before, after interface{}, err error) bool {
+		ev := IpDhcpServerNetwork_Event{Type: t, Err: err}
+		if before != nil {
+			ev.Before = before.(*IpDhcpServerNetwork)
+		}
+		if after != nil {
+			ev.After = after.(*IpDhcpServerNetwork)
+		}
+		select {
+		case ch <- ev:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+	go watch(ctx, interval, list, emit, func() { close(ch) })
+	return ch
+}
+
+// IpDhcpServerNetwork_Schema describes the `ip/dhcp-server/network` menu.
+var IpDhcpServerNetwork_Schema = &MenuSchema{
+	Path:  "ip/dhcp-server/network",
+	Table: true,
+	Key:   []string{"address"},
+	Properties: []*PropertySchema{
+		{Name: "address", Kind: KindIPPrefix},
+		{Name: "gateway", Kind: KindStringList},
+		{Name: "netmask", Kind: KindNumber},
+		{Name: "dns-server", Kind: KindStringList},
+		{Name: "ntp-server", Kind: KindStringList},
+		{Name: "domain", Kind: KindString},
+		{Name: "dhcp-option", Kind: KindStringList},
+		{Name: "comment", Kind: KindString},
+		{Name: "dynamic", Kind: KindBoolean, ReadOnly: true},
+	},
+}
+
+func init() {
+	registerMenu(IpDhcpServerNetwork_Schema)
+}
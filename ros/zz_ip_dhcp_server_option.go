@@ -0,0 +1,325 @@
+package ros
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Automatically generated by github.com/q3k/ros7api/gen, do not edit.
+
+// IpDhcpServerOption represents a ROS `ip/dhcp-server/option` record, including read-only fields.
+//
+// Custom DHCP options, which can be handed out by networks.
+type IpDhcpServerOption struct {
+	Record
+
+	// Name of the option, as referred to by networks.
+	Name string `json:"name"`
+	// DHCP option code, eg. 66 for the TFTP server name.
+	Code Number `json:"code"`
+	// Value of the option, as a RouterOS DHCP option expression, eg. 0x0a000001.
+	Value string `json:"value"`
+	// Whether to send the option even if the client didn't request it.
+	Force Boolean `json:"force"`
+	// Short description of the option.
+	Comment string `json:"comment"`
+	// Value of the option as sent, in hexadecimal.
+	RawValue string `json:"raw-value"`
+}
+
+// IpDhcpServerOption_Update is an update to a ROS `ip/dhcp-server/option` record. Any unset field will not be updated.
+type IpDhcpServerOption_Update struct {
+	// Name of the option, as referred to by networks.
+	Name *string `json:"name,omitempty"`
+	// DHCP option code, eg. 66 for the TFTP server name.
+	Code *Number `json:"code,omitempty"`
+	// Value of the option, as a RouterOS DHCP option expression, eg. 0x0a000001.
+	Value *string `json:"value,omitempty"`
+	// Whether to send the option even if the client didn't request it.
+	Force *Boolean `json:"force,omitempty"`
+	// Short description of the option.
+	Comment *string `json:"comment,omitempty"`
+}
+
+// ToUpdate returns an update setting all settable fields of a `ip/dhcp-server/option` record to
+// their values in r. Fields unset in r which ROS doesn't accept empty (eg.
+// addresses, port lists or enums) are left out, so that listed records can be
+// written back. List values are shared between r and the update.
+func (r *IpDhcpServerOption) ToUpdate() *IpDhcpServerOption_Update {
+	u := &IpDhcpServerOption_Update{}
+	u.Name = new(string)
+	*u.Name = r.Name
+	u.Code = new(Number)
+	*u.Code = r.Code
+	u.Value = new(string)
+	*u.Value = r.Value
+	u.Force = new(Boolean)
+	*u.Force = r.Force
+	u.Comment = new(string)
+	*u.Comment = r.Comment
+	return u
+}
+
+// DiffIpDhcpServerOption returns an update which changes current into desired, setting only
+// the settable fields that differ between them. Read-only fields, and fields
+// left out by ToUpdate when unset in desired, are ignored. The update IsEmpty if
+// there is nothing to change.
+func DiffIpDhcpServerOption(current, desired *IpDhcpServerOption) *IpDhcpServerOption_Update {
+	u := &IpDhcpServerOption_Update{}
+	if current.Name != desired.Name {
+		u.Name = new(string)
+		*u.Name = desired.Name
+	}
+	if current.Code != desired.Code {
+		u.Code = new(Number)
+		*u.Code = desired.Code
+	}
+	if current.Value != desired.Value {
+		u.Value = new(string)
+		*u.Value = desired.Value
+	}
+	if current.Force != desired.Force {
+		u.Force = new(Boolean)
+		*u.Force = desired.Force
+	}
+	if current.Comment != desired.Comment {
+		u.Comment = new(string)
+		*u.Comment = desired.Comment
+	}
+	return u
+}
+
+// IsEmpty returns whether the update does not set any field, ie. applying it
+// would be a no-op.
+func (u *IpDhcpServerOption_Update) IsEmpty() bool {
+	return u.Name == nil &&
+		u.Code == nil &&
+		u.Value == nil &&
+		u.Force == nil &&
+		u.Comment == nil
+}
+
+// IpDhcpServerOption_Field is the name of a `ip/dhcp-server/option` record property, for use in .proplist
+// projections.
+type IpDhcpServerOption_Field string
+
+const (
+	IpDhcpServerOption_FieldID       IpDhcpServerOption_Field = ".id"
+	IpDhcpServerOption_FieldName     IpDhcpServerOption_Field = "name"
+	IpDhcpServerOption_FieldCode     IpDhcpServerOption_Field = "code"
+	IpDhcpServerOption_FieldValue    IpDhcpServerOption_Field = "value"
+	IpDhcpServerOption_FieldForce    IpDhcpServerOption_Field = "force"
+	IpDhcpServerOption_FieldComment  IpDhcpServerOption_Field = "comment"
+	IpDhcpServerOption_FieldRawValue IpDhcpServerOption_Field = "raw-value"
+)
+
+// IpDhcpServerOption_Filter is an equality filter on `ip/dhcp-server/option` records, evaluated by ROS.
+// Any unset field will not be filtered on.
+type IpDhcpServerOption_Filter struct {
+	ID       *RecordID `json:".id,omitempty"`
+	Name     *string   `json:"name,omitempty"`
+	Code     *Number   `json:"code,omitempty"`
+	Value    *string   `json:"value,omitempty"`
+	Force    *Boolean  `json:"force,omitempty"`
+	Comment  *string   `json:"comment,omitempty"`
+	RawValue *string   `json:"raw-value,omitempty"`
+}
+
+// IpDhcpServerOption_ListOptions limits the records and fields returned by IpDhcpServerOptionListWith.
+type IpDhcpServerOption_ListOptions struct {
+	// Filter, if set, only returns records matching all set fields.
+	Filter *IpDhcpServerOption_Filter
+	// Proplist, if set, only returns the given fields of every record.
+	Proplist []IpDhcpServerOption_Field
+}
+
+// IpDhcpServerOptionList returns a list of all `ip/dhcp-server/option` records.
+func (c *Client) IpDhcpServerOptionList(ctx context.Context) ([]IpDhcpServerOption, error) {
+	return c.IpDhcpServerOptionListWith(ctx, nil)
+}
+
+// IpDhcpServerOptionListWith returns a list of all `ip/dhcp-server/option` records, optionally filtered
+// and projected by ROS according to the given options, which may be nil.
+func (c *Client) IpDhcpServerOptionListWith(ctx context.Context, opts *IpDhcpServerOption_ListOptions) ([]IpDhcpServerOption, error) {
+	var filter *IpDhcpServerOption_Filter
+	var proplist []string
+	if opts != nil {
+		filter = opts.Filter
+		for _, f := range opts.Proplist {
+			proplist = append(proplist, string(f))
+		}
+	}
+	query, err := listQuery(filter, proplist)
+	if err != nil {
+		return nil, fmt.Errorf("invalid options: %w", err)
+	}
+	body, err := c.doGET(ctx, "ip/dhcp-server/option", "", query)
+	if err != nil {
+		return nil, fmt.Errorf("could not GET: %w", err)
+	}
+	defer body.Close()
+
+	var target []IpDhcpServerOption
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return target, nil
+}
+
+// IpDhcpServerOptionFind returns all `ip/dhcp-server/option` records matching the given filter, which may
+// be nil. The filter is sent as a print .query and evaluated by ROS. If
+// proplist is given, only the given fields of every record are returned.
+func (c *Client) IpDhcpServerOptionFind(ctx context.Context, filter *IpDhcpServerOption_Filter, proplist ...IpDhcpServerOption_Field) ([]IpDhcpServerOption, error) {
+	var props []string
+	for _, f := range proplist {
+		props = append(props, string(f))
+	}
+	rdata, err := printQuery(filter, props)
+	if err != nil {
+		return nil, fmt.Errorf("invalid filter: %w", err)
+	}
+	body, err := c.doPOST(ctx, "ip/dhcp-server/option", "print", rdata)
+	if err != nil {
+		return nil, fmt.Errorf("could not POST: %w", err)
+	}
+	defer body.Close()
+
+	var target []IpDhcpServerOption
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return target, nil
+}
+
+// IpDhcpServerOptionPatch updates the given fields of a `ip/dhcp-server/option` record by ID.
+func (c *Client) IpDhcpServerOptionPatch(ctx context.Context, id RecordID, u *IpDhcpServerOption_Update) (*IpDhcpServerOption, error) {
+	rdata, err := json.Marshal(u)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal update: %w", err)
+	}
+	body, err := c.doPATCH(ctx, "ip/dhcp-server/option", id, rdata)
+	if err != nil {
+		return nil, fmt.Errorf("could not PATCH: %w", err)
+	}
+	defer body.Close()
+
+	var target IpDhcpServerOption
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return &target, nil
+}
+
+// IpDhcpServerOptionGet returns a single `ip/dhcp-server/option` record by ID.
+func (c *Client) IpDhcpServerOptionGet(ctx context.Context, id RecordID) (*IpDhcpServerOption, error) {
+	body, err := c.doGET(ctx, "ip/dhcp-server/option", id, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not GET: %w", err)
+	}
+	defer body.Close()
+
+	var target IpDhcpServerOption
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return &target, nil
+}
+
+// IpDhcpServerOptionAdd creates a new `ip/dhcp-server/option` record with the given fields set. Any unset
+// field will be populated by ROS with its default value.
+func (c *Client) IpDhcpServerOptionAdd(ctx context.Context, u *IpDhcpServerOption_Update) (*IpDhcpServerOption, error) {
+	rdata, err := json.Marshal(u)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal update: %w", err)
+	}
+	body, err := c.doPUT(ctx, "ip/dhcp-server/option", rdata)
+	if err != nil {
+		return nil, fmt.Errorf("could not PUT: %w", err)
+	}
+	defer body.Close()
+
+	var target IpDhcpServerOption
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return &target, nil
+}
+
+// IpDhcpServerOptionRemove deletes a `ip/dhcp-server/option` record by ID.
+func (c *Client) IpDhcpServerOptionRemove(ctx context.Context, id RecordID) error {
+	body, err := c.doDELETE(ctx, "ip/dhcp-server/option", id)
+	if err != nil {
+		return fmt.Errorf("could not DELETE: %w", err)
+	}
+	body.Close()
+	return nil
+}
+
+// IpDhcpServerOption_Event is a change to a `ip/dhcp-server/option` record observed by IpDhcpServerOptionWatch.
+type IpDhcpServerOption_Event struct {
+	Type EventType
+	// Before is the record before the change, nil if Type is EventAdded.
+	Before *IpDhcpServerOption
+	// After is the record after the change, nil if Type is EventRemoved.
+	After *IpDhcpServerOption
+	// Err is the polling error if Type is EventError.
+	Err error
+}
+
+// IpDhcpServerOptionWatch polls the `ip/dhcp-server/option` records every interval and sends an event for
+// every record added, modified or removed since the previous poll, starting
+// with an EventAdded for every existing record. Polling errors are sent as
+// EventError, and retried with backoff. The channel is closed once ctx is
+// canceled, or after a single EventError if interval is not positive.
+func (c *Client) IpDhcpServerOptionWatch(ctx context.Context, interval time.Duration) <-chan IpDhcpServerOption_Event {
+	ch := make(chan IpDhcpServerOption_Event)
+	list := func(ctx context.Context) ([]watchedRecord, error) {
+		records, err := c.IpDhcpServerOptionList(ctx)
+		if err != nil {
+			return nil, err
+		}
+		res := make([]watchedRecord, len(records))
+		for i := range records {
+			res[i] = watchedRecord{id: records[i].ID, record: &records[i]}
+		}
+		return res, nil
+	}
+	emit := func(t EventType, before, after interface{}, err error) bool {
+		ev := IpDhcpServerOption_Event{Type: t, Err: err}
+		if before != nil {
+			ev.Before = before.(*IpDhcpServerOption)
+		}
+		if after != nil {
+			ev.After = after.(*IpDhcpServerOption)
+		}
+		select {
+		case ch <- ev:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+	go watch(ctx, interval, list, emit, func() { close(ch) })
+	return ch
+}
+
+// IpDhcpServerOption_Schema describes the `ip/dhcp-server/option` menu.
+var IpDhcpServerOption_Schema = &MenuSchema{
+	Path:  "ip/dhcp-server/option",
+	Table: true,
+	Key:   []string{"name"},
+	Properties: []*PropertySchema{
+		{Name: "name", Kind: KindString},
+		{Name: "code", Kind: KindNumber},
+		{Name: "value", Kind: KindString},
+		{Name: "force", Kind: KindBoolean},
+		{Name: "comment", Kind: KindString},
+		{Name: "raw-value", Kind: KindString, ReadOnly: true},
+	},
+}
+
+func init() {
+	registerMenu(IpDhcpServerOption_Schema)
+}
@@ -0,0 +1,185 @@
+package ros
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// Automatically generated by github.com/q3k/ros7api/gen, do not edit.
+
+// IpDns represents the ROS `ip/dns` singleton record, including read-only fields.
+//
+// DNS client and cache settings.
+type IpDns struct {
+	// Specifies whether to allow network requests.
+	AllowRemoteRequests Boolean `json:"allow-remote-requests"`
+	// Maximum time-to-live for cache records. In other words, cache records will expire unconditionally after cache-max-ttl time. Shorter TTL received from DNS servers are respected.
+	CacheMaxTTL Duration `json:"cache-max-ttl"`
+	// Specifies the size of DNS cache, in KiB.
+	CacheSize Number `json:"cache-size"`
+	// Specifies how much concurrent queries are allowed.
+	MaxConcurrentQueries Number `json:"max-concurrent-queries"`
+	// Maximum size of allowed UDP packet.
+	MaxUDPPacketSize Number `json:"max-udp-packet-size"`
+	// List of DNS server IPv4/IPv6 addresses.
+	Servers StringList `json:"servers"`
+	// DNS over HTTPS (DoH) server URL.
+	UseDoHServer string `json:"use-doh-server"`
+	// Specifies whether to validate the DoH server, when one is being used. Will use the /certificate list in order to verify server validity.
+	VerifyDoHCert Boolean `json:"verify-doh-cert"`
+	// Shows the currently used cache size, in KiB.
+	CacheUsed Number `json:"cache-used"`
+	// List of dynamically added DNS server from different services, for example, DHCP.
+	DynamicServers StringList `json:"dynamic-servers"`
+}
+
+// IpDns_Update is an update to a ROS `ip/dns` record. Any unset field will not be updated.
+type IpDns_Update struct {
+	// Specifies whether to allow network requests.
+	AllowRemoteRequests *Boolean `json:"allow-remote-requests,omitempty"`
+	// Maximum time-to-live for cache records. In other words, cache records will expire unconditionally after cache-max-ttl time. Shorter TTL received from DNS servers are respected.
+	CacheMaxTTL *Duration `json:"cache-max-ttl,omitempty"`
+	// Specifies the size of DNS cache, in KiB.
+	CacheSize *Number `json:"cache-size,omitempty"`
+	// Specifies how much concurrent queries are allowed.
+	MaxConcurrentQueries *Number `json:"max-concurrent-queries,omitempty"`
+	// Maximum size of allowed UDP packet.
+	MaxUDPPacketSize *Number `json:"max-udp-packet-size,omitempty"`
+	// List of DNS server IPv4/IPv6 addresses.
+	Servers *StringList `json:"servers,omitempty"`
+	// DNS over HTTPS (DoH) server URL.
+	UseDoHServer *string `json:"use-doh-server,omitempty"`
+	// Specifies whether to validate the DoH server, when one is being used. Will use the /certificate list in order to verify server validity.
+	VerifyDoHCert *Boolean `json:"verify-doh-cert,omitempty"`
+}
+
+// ToUpdate returns an update setting all settable fields of a `ip/dns` record to
+// their values in r. Fields unset in r which ROS doesn't accept empty (eg.
+// addresses, port lists or enums) are left out, so that listed records can be
+// written back. List values are shared between r and the update.
+func (r *IpDns) ToUpdate() *IpDns_Update {
+	u := &IpDns_Update{}
+	u.AllowRemoteRequests = new(Boolean)
+	*u.AllowRemoteRequests = r.AllowRemoteRequests
+	u.CacheMaxTTL = new(Duration)
+	*u.CacheMaxTTL = r.CacheMaxTTL
+	u.CacheSize = new(Number)
+	*u.CacheSize = r.CacheSize
+	u.MaxConcurrentQueries = new(Number)
+	*u.MaxConcurrentQueries = r.MaxConcurrentQueries
+	u.MaxUDPPacketSize = new(Number)
+	*u.MaxUDPPacketSize = r.MaxUDPPacketSize
+	u.Servers = new(StringList)
+	*u.Servers = r.Servers
+	u.UseDoHServer = new(string)
+	*u.UseDoHServer = r.UseDoHServer
+	u.VerifyDoHCert = new(Boolean)
+	*u.VerifyDoHCert = r.VerifyDoHCert
+	return u
+}
+
+// DiffIpDns returns an update which changes current into desired, setting only
+// the settable fields that differ between them. Read-only fields, and fields
+// left out by ToUpdate when unset in desired, are ignored. The update IsEmpty if
+// there is nothing to change.
+func DiffIpDns(current, desired *IpDns) *IpDns_Update {
+	u := &IpDns_Update{}
+	if current.AllowRemoteRequests != desired.AllowRemoteRequests {
+		u.AllowRemoteRequests = new(Boolean)
+		*u.AllowRemoteRequests = desired.AllowRemoteRequests
+	}
+	if current.CacheMaxTTL != desired.CacheMaxTTL {
+		u.CacheMaxTTL = new(Duration)
+		*u.CacheMaxTTL = desired.CacheMaxTTL
+	}
+	if current.CacheSize != desired.CacheSize {
+		u.CacheSize = new(Number)
+		*u.CacheSize = desired.CacheSize
+	}
+	if current.MaxConcurrentQueries != desired.MaxConcurrentQueries {
+		u.MaxConcurrentQueries = new(Number)
+		*u.MaxConcurrentQueries = desired.MaxConcurrentQueries
+	}
+	if current.MaxUDPPacketSize != desired.MaxUDPPacketSize {
+		u.MaxUDPPacketSize = new(Number)
+		*u.MaxUDPPacketSize = desired.MaxUDPPacketSize
+	}
+	if !current.Servers.Equal(desired.Servers) {
+		u.Servers = new(StringList)
+		*u.Servers = desired.Servers
+	}
+	if current.UseDoHServer != desired.UseDoHServer {
+		u.UseDoHServer = new(string)
+		*u.UseDoHServer = desired.UseDoHServer
+	}
+	if current.VerifyDoHCert != desired.VerifyDoHCert {
+		u.VerifyDoHCert = new(Boolean)
+		*u.VerifyDoHCert = desired.VerifyDoHCert
+	}
+	return u
+}
+
+// IsEmpty returns whether the update does not set any field, ie. applying it
+// would be a no-op.
+func (u *IpDns_Update) IsEmpty() bool {
+	return u.AllowRemoteRequests == nil &&
+		u.CacheMaxTTL == nil &&
+		u.CacheSize == nil &&
+		u.MaxConcurrentQueries == nil &&
+		u.MaxUDPPacketSize == nil &&
+		u.Servers == nil &&
+		u.UseDoHServer == nil &&
+		u.VerifyDoHCert == nil
+}
+
+// IpDnsGet returns the `ip/dns` singleton record.
+func (c *Client) IpDnsGet(ctx context.Context) (*IpDns, error) {
+	body, err := c.doGET(ctx, "ip/dns", "", nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not GET: %w", err)
+	}
+	defer body.Close()
+
+	var target IpDns
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return &target, nil
+}
+
+// IpDnsSet updates the given fields of the `ip/dns` singleton record.
+func (c *Client) IpDnsSet(ctx context.Context, u *IpDns_Update) error {
+	rdata, err := json.Marshal(u)
+	if err != nil {
+		return fmt.Errorf("could not marshal update: %w", err)
+	}
+	body, err := c.doPOST(ctx, "ip/dns", "set", rdata)
+	if err != nil {
+		return fmt.Errorf("could not POST: %w", err)
+	}
+	body.Close()
+	return nil
+}
+
+// IpDns_Schema describes the `ip/dns` menu.
+var IpDns_Schema = &MenuSchema{
+	Path:      "ip/dns",
+	Singleton: true,
+	Properties: []*PropertySchema{
+		{Name: "allow-remote-requests", Kind: KindBoolean},
+		{Name: "cache-max-ttl", Kind: KindDuration},
+		{Name: "cache-size", Kind: KindNumber},
+		{Name: "max-concurrent-queries", Kind: KindNumber},
+		{Name: "max-udp-packet-size", Kind: KindNumber},
+		{Name: "servers", Kind: KindStringList},
+		{Name: "use-doh-server", Kind: KindString},
+		{Name: "verify-doh-cert", Kind: KindBoolean},
+		{Name: "cache-used", Kind: KindNumber, ReadOnly: true},
+		{Name: "dynamic-servers", Kind: KindStringList, ReadOnly: true},
+	},
+}
+
+func init() {
+	registerMenu(IpDns_Schema)
+}
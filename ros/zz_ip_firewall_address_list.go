@@ -0,0 +1,330 @@
+package ros
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Automatically generated by github.com/q3k/ros7api/gen, do not edit.
+
+// IpFirewallAddressList represents a ROS `ip/firewall/address-list` record, including read-only fields.
+//
+// IPv4 firewall address lists, named sets of addresses which can be matched by firewall rules.
+type IpFirewallAddressList struct {
+	Record
+
+	// Name of the address list.
+	List string `json:"list"`
+	// Address, prefix, range or DNS name on the list, eg. 10.0.0.0/8.
+	Address string `json:"address"`
+	// How long the address is kept on the list. Addresses without a timeout are kept forever.
+	Timeout Duration `json:"timeout"`
+	// Short description of the entry.
+	Comment string `json:"comment"`
+	// Whether the entry is disabled.
+	Disabled Boolean `json:"disabled"`
+	// When the entry was added.
+	CreationTime string `json:"creation-time"`
+	// Whether the entry was added dynamically, eg. by a firewall rule or with a timeout.
+	Dynamic Boolean `json:"dynamic"`
+}
+
+// IpFirewallAddressList_Update is an update to a ROS `ip/firewall/address-list` record. Any unset field will not be updated.
+type IpFirewallAddressList_Update struct {
+	// Name of the address list.
+	List *string `json:"list,omitempty"`
+	// Address, prefix, range or DNS name on the list, eg. 10.0.0.0/8.
+	Address *string `json:"address,omitempty"`
+	// How long the address is kept on the list. Addresses without a timeout are kept forever.
+	Timeout *Duration `json:"timeout,omitempty"`
+	// Short description of the entry.
+	Comment *string `json:"comment,omitempty"`
+	// Whether the entry is disabled.
+	Disabled *Boolean `json:"disabled,omitempty"`
+}
+
+// ToUpdate returns an update setting all settable fields of a `ip/firewall/address-list` record to
+// their values in r. Fields unset in r which ROS doesn't accept empty (eg.
+// addresses, port lists or enums) are left out, so that listed records can be
+// written back. List values are shared between r and the update.
+func (r *IpFirewallAddressList) ToUpdate() *IpFirewallAddressList_Update {
+	u := &IpFirewallAddressList_Update{}
+	u.List = new(string)
+	*u.List = r.List
+	u.Address = new(string)
+	*u.Address = r.Address
+	u.Timeout = new(Duration)
+	*u.Timeout = r.Timeout
+	u.Comment = new(string)
+	*u.Comment = r.Comment
+	u.Disabled = new(Boolean)
+	*u.Disabled = r.Disabled
+	return u
+}
+
+// DiffIpFirewallAddressList returns an update which changes current into desired, setting only
+// the settable fields that differ between them. Read-only fields, and fields
+// left out by ToUpdate when unset in desired, are ignored. The update IsEmpty if
+// there is nothing to change.
+func DiffIpFirewallAddressList(current, desired *IpFirewallAddressList) *IpFirewallAddressList_Update {
+	u := &IpFirewallAddressList_Update{}
+	if current.List != desired.List {
+		u.List = new(string)
+		*u.List = desired.List
+	}
+	if current.Address != desired.Address {
+		u.Address = new(string)
+		*u.Address = desired.Address
+	}
+	if current.Timeout != desired.Timeout {
+		u.Timeout = new(Duration)
+		*u.Timeout = desired.Timeout
+	}
+	if current.Comment != desired.Comment {
+		u.Comment = new(string)
+		*u.Comment = desired.Comment
+	}
+	if current.Disabled != desired.Disabled {
+		u.Disabled = new(Boolean)
+		*u.Disabled = desired.Disabled
+	}
+	return u
+}
+
+// IsEmpty returns whether the update does not set any field, ie. applying it
+// would be a no-op.
+func (u *IpFirewallAddressList_Update) IsEmpty() bool {
+	return u.List == nil &&
+		u.Address == nil &&
+		u.Timeout == nil &&
+		u.Comment == nil &&
+		u.Disabled == nil
+}
+
+// IpFirewallAddressList_Field is the name of a `ip/firewall/address-list` record property, for use in .proplist
+// projections.
+type IpFirewallAddressList_Field string
+
+const (
+	IpFirewallAddressList_FieldID           IpFirewallAddressList_Field = ".id"
+	IpFirewallAddressList_FieldList         IpFirewallAddressList_Field = "list"
+	IpFirewallAddressList_FieldAddress      IpFirewallAddressList_Field = "address"
+	IpFirewallAddressList_FieldTimeout      IpFirewallAddressList_Field = "timeout"
+	IpFirewallAddressList_FieldComment      IpFirewallAddressList_Field = "comment"
+	IpFirewallAddressList_FieldDisabled     IpFirewallAddressList_Field = "disabled"
+	IpFirewallAddressList_FieldCreationTime IpFirewallAddressList_Field = "creation-time"
+	IpFirewallAddressList_FieldDynamic      IpFirewallAddressList_Field = "dynamic"
+)
+
+// IpFirewallAddressList_Filter is an equality filter on `ip/firewall/address-list` records, evaluated by ROS.
+// Any unset field will not be filtered on.
+type IpFirewallAddressList_Filter struct {
+	ID           *RecordID `json:".id,omitempty"`
+	List         *string   `json:"list,omitempty"`
+	Address      *string   `json:"address,omitempty"`
+	Timeout      *Duration `json:"timeout,omitempty"`
+	Comment      *string   `json:"comment,omitempty"`
+	Disabled     *Boolean  `json:"disabled,omitempty"`
+	CreationTime *string   `json:"creation-time,omitempty"`
+	Dynamic      *Boolean  `json:"dynamic,omitempty"`
+}
+
+// IpFirewallAddressList_ListOptions limits the records and fields returned by IpFirewallAddressListListWith.
+type IpFirewallAddressList_ListOptions struct {
+	// Filter, if set, only returns records matching all set fields.
+	Filter *IpFirewallAddressList_Filter
+	// Proplist, if set, only returns the given fields of every record.
+	Proplist []IpFirewallAddressList_Field
+}
+
+// IpFirewallAddressListList returns a list of all `ip/firewall/address-list` records.
+func (c *Client) IpFirewallAddressListList(ctx context.Context) ([]IpFirewallAddressList, error) {
+	return c.IpFirewallAddressListListWith(ctx, nil)
+}
+
+// IpFirewallAddressListListWith returns a list of all `ip/firewall/address-list` records, optionally filtered
+// and projected by ROS according to the given options, which may be nil.
+func (c *Client) IpFirewallAddressListListWith(ctx context.Context, opts *IpFirewallAddressList_ListOptions) ([]IpFirewallAddressList, error) {
+	var filter *IpFirewallAddressList_Filter
+	var proplist []string
+	if opts != nil {
+		filter = opts.Filter
+		for _, f := range opts.Proplist {
+			proplist = append(proplist, string(f))
+		}
+	}
+	query, err := listQuery(filter, proplist)
+	if err != nil {
+		return nil, fmt.Errorf("invalid options: %w", err)
+	}
+	body, err := c.doGET(ctx, "ip/firewall/address-list", "", query)
+	if err != nil {
+		return nil, fmt.Errorf("could not GET: %w", err)
+	}
+	defer body.Close()
+
+	var target []IpFirewallAddressList
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return target, nil
+}
+
+// IpFirewallAddressListFind returns all `ip/firewall/address-list` records matching the given filter, which may
+// be nil. The filter is sent as a print .query and evaluated by ROS. If
+// proplist is given, only the given fields of every record are returned.
+func (c *Client) IpFirewallAddressListFind(ctx context.Context, filter *IpFirewallAddressList_Filter, proplist ...IpFirewallAddressList_Field) ([]IpFirewallAddressList, error) {
+	var props []string
+	for _, f := range proplist {
+		props = append(props, string(f))
+	}
+	rdata, err := printQuery(filter, props)
+	if err != nil {
+		return nil, fmt.Errorf("invalid filter: %w", err)
+	}
+	body, err := c.doPOST(ctx, "ip/firewall/address-list", "print", rdata)
+	if err != nil {
+		return nil, fmt.Errorf("could not POST: %w", err)
+	}
+	defer body.Close()
+
+	var target []IpFirewallAddressList
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return target, nil
+}
+
+// IpFirewallAddressListPatch updates the given fields of a `ip/firewall/address-list` record by ID.
+func (c *Client) IpFirewallAddressListPatch(ctx context.Context, id RecordID, u *IpFirewallAddressList_Update) (*IpFirewallAddressList, error) {
+	rdata, err := json.Marshal(u)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal update: %w", err)
+	}
+	body, err := c.doPATCH(ctx, "ip/firewall/address-list", id, rdata)
+	if err != nil {
+		return nil, fmt.Errorf("could not PATCH: %w", err)
+	}
+	defer body.Close()
+
+	var target IpFirewallAddressList
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return &target, nil
+}
+
+// IpFirewallAddressListGet returns a single `ip/firewall/address-list` record by ID.
+func (c *Client) IpFirewallAddressListGet(ctx context.Context, id RecordID) (*IpFirewallAddressList, error) {
+	body, err := c.doGET(ctx, "ip/firewall/address-list", id, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not GET: %w", err)
+	}
+	defer body.Close()
+
+	var target IpFirewallAddressList
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return &target, nil
+}
+
+// IpFirewallAddressListAdd creates a new `ip/firewall/address-list` record with the given fields set. Any unset
+// field will be populated by ROS with its default value.
+func (c *Client) IpFirewallAddressListAdd(ctx context.Context, u *IpFirewallAddressList_Update) (*IpFirewallAddressList, error) {
+	rdata, err := json.Marshal(u)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal update: %w", err)
+	}
+	body, err := c.doPUT(ctx, "ip/firewall/address-list", rdata)
+	if err != nil {
+		return nil, fmt.Errorf("could not PUT: %w", err)
+	}
+	defer body.Close()
+
+	var target IpFirewallAddressList
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return &target, nil
+}
+
+// IpFirewallAddressListRemove deletes a `ip/firewall/address-list` record by ID.
+func (c *Client) IpFirewallAddressListRemove(ctx context.Context, id RecordID) error {
+	body, err := c.doDELETE(ctx, "ip/firewall/address-list", id)
+	if err != nil {
+		return fmt.Errorf("could not DELETE: %w", err)
+	}
+	body.Close()
+	return nil
+}
+
+// IpFirewallAddressList_Event is a change to a `ip/firewall/address-list` record observed by IpFirewallAddressListWatch.
+type IpFirewallAddressList_Event struct {
+	Type EventType
+	// Before is the record before the change, nil if Type is EventAdded.
+	Before *IpFirewallAddressList
+	// After is the record after the change, nil if Type is EventRemoved.
+	After *IpFirewallAddressList
+	// Err is the polling error if Type is EventError.
+	Err error
+}
+
+// IpFirewallAddressListWatch polls the `ip/firewall/address-list` records every interval and sends an event for
+// every record added, modified or removed since the previous poll, starting
+// with an EventAdded for every existing record. Polling errors are sent as
+// EventError, and retried with backoff. The channel is closed once ctx is
+// canceled, or after a single EventError if interval is not positive.
+func (c *Client) IpFirewallAddressListWatch(ctx context.Context, interval time.Duration) <-chan IpFirewallAddressList_Event {
+	ch := make(chan IpFirewallAddressList_Event)
+	list := func(ctx context.Context) ([]watchedRecord, error) {
+		records, err := c.IpFirewallAddressListList(ctx)
+		if err != nil {
+			return nil, err
+		}
+		res := make([]watchedRecord, len(records))
+		for i := range records {
+			res[i] = watchedRecord{id: records[i].ID, record: &records[i]}
+		}
+		return res, nil
+	}
+	emit := func(t EventType, before, after interface{}, err error) bool {
+		ev := IpFirewallAddressList_Event{Type: t, Err: err}
+		if before != nil {
+			ev.Before = before.(*IpFirewallAddressList)
+		}
+		if after != nil {
+			ev.After = after.(*IpFirewallAddressList)
+		}
+		select {
+		case ch <- ev:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+	go watch(ctx, interval, list, emit, func() { close(ch) })
+	return ch
+}
+
+// IpFirewallAddressList_Schema describes the `ip/firewall/address-list` menu.
+var IpFirewallAddressList_Schema = &MenuSchema{
+	Path:  "ip/firewall/address-list",
+	Table: true,
+	Key:   []string{"list", "address"},
+	Properties: []*PropertySchema{
+		{Name: "list", Kind: KindString},
+		{Name: "address", Kind: KindString},
+		{Name: "timeout", Kind: KindDuration},
+		{Name: "comment", Kind: KindString},
+		{Name: "disabled", Kind: KindBoolean},
+		{Name: "creation-time", Kind: KindString, ReadOnly: true},
+		{Name: "dynamic", Kind: KindBoolean, ReadOnly: true},
+	},
+}
+
+func init() {
+	registerMenu(IpFirewallAddressList_Schema)
+}
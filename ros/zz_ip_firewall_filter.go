@@ -0,0 +1,863 @@
+package ros
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Automatically generated by github.com/q3k/ros7api/gen, do not edit.
+
+// IpFirewallFilter_Chain is the type of the `chain` property. Besides the values below, any
+// other value is accepted.
+type IpFirewallFilter_Chain string
+
+const (
+	// Packets addressed to the router.
+	IpFirewallFilter_ChainInput IpFirewallFilter_Chain = "input"
+	// Packets routed through the router.
+	IpFirewallFilter_ChainForward IpFirewallFilter_Chain = "forward"
+	// Packets originating from the router.
+	IpFirewallFilter_ChainOutput IpFirewallFilter_Chain = "output"
+)
+
+// Values returns all values of IpFirewallFilter_Chain known to this library.
+func (IpFirewallFilter_Chain) Values() []IpFirewallFilter_Chain {
+	return []IpFirewallFilter_Chain{
+		IpFirewallFilter_ChainInput,
+		IpFirewallFilter_ChainForward,
+		IpFirewallFilter_ChainOutput,
+	}
+}
+
+// IsKnown returns whether the value is known to this library.
+func (e IpFirewallFilter_Chain) IsKnown() bool {
+	switch e {
+	case IpFirewallFilter_ChainInput, IpFirewallFilter_ChainForward, IpFirewallFilter_ChainOutput:
+		return true
+	}
+	return false
+}
+
+func (e IpFirewallFilter_Chain) String() string {
+	return string(e)
+}
+
+func (e *IpFirewallFilter_Chain) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*e = IpFirewallFilter_Chain(s)
+	return nil
+}
+
+func (e IpFirewallFilter_Chain) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(e))
+}
+
+// IpFirewallFilter_Action is the type of the `action` property. Values not known to this library
+// (eg. introduced in newer ROS versions) are kept as-is, but reported
+// through UnknownEnumHandler.
+type IpFirewallFilter_Action string
+
+const (
+	// Stop processing the table and accept the packet.
+	IpFirewallFilter_ActionAccept IpFirewallFilter_Action = "accept"
+	// Add the destination address to the address list given by address-list.
+	IpFirewallFilter_ActionAddDstToAddressList IpFirewallFilter_Action = "add-dst-to-address-list"
+	// Add the source address to the address list given by address-list.
+	IpFirewallFilter_ActionAddSrcToAddressList IpFirewallFilter_Action = "add-src-to-address-list"
+	// Silently drop the packet.
+	IpFirewallFilter_ActionDrop IpFirewallFilter_Action = "drop"
+	// Bypass the firewall for further packets of the connection.
+	IpFirewallFilter_ActionFasttrackConnection IpFirewallFilter_Action = "fasttrack-connection"
+	// Continue in the chain given by jump-target.
+	IpFirewallFilter_ActionJump IpFirewallFilter_Action = "jump"
+	// Log the packet and continue with the next rule.
+	IpFirewallFilter_ActionLog IpFirewallFilter_Action = "log"
+	// Only count the packet and continue with the next rule.
+	IpFirewallFilter_ActionPassthrough IpFirewallFilter_Action = "passthrough"
+	// Drop the packet and reply with an ICMP or TCP reset packet, as given by reject-with.
+	IpFirewallFilter_ActionReject IpFirewallFilter_Action = "reject"
+	// Return to the rule after the jump which led to this chain.
+	IpFirewallFilter_ActionReturn IpFirewallFilter_Action = "return"
+	// Capture and hold TCP connections, replying to SYN packets with SYN/ACK.
+	IpFirewallFilter_ActionTarpit IpFirewallFilter_Action = "tarpit"
+)
+
+// Values returns all values of IpFirewallFilter_Action known to this library.
+func (IpFirewallFilter_Action) Values() []IpFirewallFilter_Action {
+	return []IpFirewallFilter_Action{
+		IpFirewallFilter_ActionAccept,
+		IpFirewallFilter_ActionAddDstToAddressList,
+		IpFirewallFilter_ActionAddSrcToAddressList,
+		IpFirewallFilter_ActionDrop,
+		IpFirewallFilter_ActionFasttrackConnection,
+		IpFirewallFilter_ActionJump,
+		IpFirewallFilter_ActionLog,
+		IpFirewallFilter_ActionPassthrough,
+		IpFirewallFilter_ActionReject,
+		IpFirewallFilter_ActionReturn,
+		IpFirewallFilter_ActionTarpit,
+	}
+}
+
+// IsKnown returns whether the value is known to this library.
+func (e IpFirewallFilter_Action) IsKnown() bool {
+	switch e {
+	case IpFirewallFilter_ActionAccept, IpFirewallFilter_ActionAddDstToAddressList, IpFirewallFilter_ActionAddSrcToAddressList, IpFirewallFilter_ActionDrop, IpFirewallFilter_ActionFasttrackConnection, IpFirewallFilter_ActionJump, IpFirewallFilter_ActionLog, IpFirewallFilter_ActionPassthrough, IpFirewallFilter_ActionReject, IpFirewallFilter_ActionReturn, IpFirewallFilter_ActionTarpit:
+		return true
+	}
+	return false
+}
+
+func (e IpFirewallFilter_Action) String() string {
+	return string(e)
+}
+
+func (e *IpFirewallFilter_Action) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*e = IpFirewallFilter_Action(s)
+	if s != "" && !e.IsKnown() {
+		reportUnknownEnum("IpFirewallFilter_Action", s)
+	}
+	return nil
+}
+
+func (e IpFirewallFilter_Action) MarshalJSON() ([]byte, error) {
+	if e != "" && !e.IsKnown() {
+		reportUnknownEnum("IpFirewallFilter_Action", string(e))
+	}
+	return json.Marshal(string(e))
+}
+
+// IpFirewallFilter represents a ROS `ip/firewall/filter` record, including read-only fields.
+//
+// IPv4 firewall filter rules, which decide whether packets are accepted or dropped. Rules are evaluated in order.
+type IpFirewallFilter struct {
+	Record
+
+	// Chain the rule belongs to: one of the built-in chains, or a user-defined one which is jumped to.
+	Chain IpFirewallFilter_Chain `json:"chain"`
+	// Action taken on matched packets.
+	Action IpFirewallFilter_Action `json:"action"`
+	// Reply sent for the reject action, eg. icmp-network-unreachable or tcp-reset.
+	RejectWith string `json:"reject-with"`
+	// Source address, prefix or range to match, optionally negated with a ! prefix, eg. 10.0.0.0/8.
+	SrcAddress string `json:"src-address"`
+	// Destination address, prefix or range to match, optionally negated with a ! prefix, eg. 10.0.0.0/8.
+	DstAddress string `json:"dst-address"`
+	// Address list the source address must be on, optionally negated with a ! prefix.
+	SrcAddressList string `json:"src-address-list"`
+	// Address list the destination address must be on, optionally negated with a ! prefix.
+	DstAddressList string `json:"dst-address-list"`
+	// IP protocol to match. Protocols not listed can be given by name or number.
+	Protocol FirewallRule_Protocol `json:"protocol"`
+	// Source ports and port ranges to match, eg. 1024-65535. Requires protocol to be tcp, udp, udp-lite or sctp.
+	SrcPort PortList `json:"src-port"`
+	// Destination ports and port ranges to match, eg. 80,443. Requires protocol to be tcp, udp, udp-lite or sctp.
+	DstPort PortList `json:"dst-port"`
+	// Ports and port ranges to match against either the source or the destination port.
+	Port PortList `json:"port"`
+	// Interface the packet entered the router through, optionally negated with a ! prefix.
+	InInterface string `json:"in-interface"`
+	// Interface the packet leaves the router through, optionally negated with a ! prefix.
+	OutInterface string `json:"out-interface"`
+	// Interface list the input interface must be on, optionally negated with a ! prefix.
+	InInterfaceList string `json:"in-interface-list"`
+	// Interface list the output interface must be on, optionally negated with a ! prefix.
+	OutInterfaceList string `json:"out-interface-list"`
+	// Packet mark to match, as set by mangle rules.
+	PacketMark string `json:"packet-mark"`
+	// Routing mark to match, as set by mangle rules.
+	RoutingMark string `json:"routing-mark"`
+	// TCP flags to match, each optionally negated with a ! prefix, eg. syn,!ack.
+	TcpFlags string `json:"tcp-flags"`
+	// ICMP type and code to match, eg. 8:0.
+	ICMPOptions string `json:"icmp-options"`
+	// Rate limit above which packets stop matching, as count,time,burst, eg. 10,1s,5.
+	Limit string `json:"limit"`
+	// Whether to log matched packets.
+	Log Boolean `json:"log"`
+	// Prefix of the log messages of matched packets.
+	LogPrefix string `json:"log-prefix"`
+	// Chain to continue in, for the jump action.
+	JumpTarget string `json:"jump-target"`
+	// Address list to add the address to, for the add-src-to-address-list and add-dst-to-address-list actions.
+	AddressList string `json:"address-list"`
+	// How long the address is kept on the address list: a duration, none-dynamic or none-static.
+	AddressListTimeout string `json:"address-list-timeout"`
+	// Short description of the rule.
+	Comment string `json:"comment"`
+	// Whether the rule is disabled.
+	Disabled Boolean `json:"disabled"`
+	// Number of bytes matched by the rule.
+	Bytes Number `json:"bytes"`
+	// Number of packets matched by the rule.
+	Packets Number `json:"packets"`
+	// Whether the rule was added dynamically, eg. by a service.
+	Dynamic Boolean `json:"dynamic"`
+	// Whether the rule is invalid, eg. because it refers to an interface that doesn't exist.
+	Invalid Boolean `json:"invalid"`
+	// Connection tracking states to match, eg. established,related.
+	ConnectionState FirewallConntrack_ConnectionStateList `json:"connection-state"`
+	// NAT states of the connection to match.
+	ConnectionNatState FirewallConntrack_ConnectionNatStateList `json:"connection-nat-state"`
+	// Connection mark to match, as set by mangle rules.
+	ConnectionMark string `json:"connection-mark"`
+}
+
+// IpFirewallFilter_Update is an update to a ROS `ip/firewall/filter` record. Any unset field will not be updated.
+type IpFirewallFilter_Update struct {
+	// Chain the rule belongs to: one of the built-in chains, or a user-defined one which is jumped to.
+	Chain *IpFirewallFilter_Chain `json:"chain,omitempty"`
+	// Action taken on matched packets.
+	Action *IpFirewallFilter_Action `json:"action,omitempty"`
+	// Reply sent for the reject action, eg. icmp-network-unreachable or tcp-reset.
+	RejectWith *string `json:"reject-with,omitempty"`
+	// Source address, prefix or range to match, optionally negated with a ! prefix, eg. 10.0.0.0/8.
+	SrcAddress *string `json:"src-address,omitempty"`
+	// Destination address, prefix or range to match, optionally negated with a ! prefix, eg. 10.0.0.0/8.
+	DstAddress *string `json:"dst-address,omitempty"`
+	// Address list the source address must be on, optionally negated with a ! prefix.
+	SrcAddressList *string `json:"src-address-list,omitempty"`
+	// Address list the destination address must be on, optionally negated with a ! prefix.
+	DstAddressList *string `json:"dst-address-list,omitempty"`
+	// IP protocol to match. Protocols not listed can be given by name or number.
+	Protocol *FirewallRule_Protocol `json:"protocol,omitempty"`
+	// Source ports and port ranges to match, eg. 1024-65535. Requires protocol to be tcp, udp, udp-lite or sctp.
+	SrcPort *PortList `json:"src-port,omitempty"`
+	// Destination ports and port ranges to match, eg. 80,443. Requires protocol to be tcp, udp, udp-lite or sctp.
+	DstPort *PortList `json:"dst-port,omitempty"`
+	// Ports and port ranges to match against either the source or the destination port.
+	Port *PortList `json:"port,omitempty"`
+	// Interface the packet entered the router through, optionally negated with a ! prefix.
+	InInterface *string `json:"in-interface,omitempty"`
+	// Interface the packet leaves the router through, optionally negated with a ! prefix.
+	OutInterface *string `json:"out-interface,omitempty"`
+	// Interface list the input interface must be on, optionally negated with a ! prefix.
+	InInterfaceList *string `json:"in-interface-list,omitempty"`
+	// Interface list the output interface must be on, optionally negated with a ! prefix.
+	OutInterfaceList *string `json:"out-interface-list,omitempty"`
+	// Packet mark to match, as set by mangle rules.
+	PacketMark *string `json:"packet-mark,omitempty"`
+	// Routing mark to match, as set by mangle rules.
+	RoutingMark *string `json:"routing-mark,omitempty"`
+	// TCP flags to match, each optionally negated with a ! prefix, eg. syn,!ack.
+	TcpFlags *string `json:"tcp-flags,omitempty"`
+	// ICMP type and code to match, eg. 8:0.
+	ICMPOptions *string `json:"icmp-options,omitempty"`
+	// Rate limit above which packets stop matching, as count,time,burst, eg. 10,1s,5.
+	Limit *string `json:"limit,omitempty"`
+	// Whether to log matched packets.
+	Log *Boolean `json:"log,omitempty"`
+	// Prefix of the log messages of matched packets.
+	LogPrefix *string `json:"log-prefix,omitempty"`
+	// Chain to continue in, for the jump action.
+	JumpTarget *string `json:"jump-target,omitempty"`
+	// Address list to add the address to, for the add-src-to-address-list and add-dst-to-address-list actions.
+	AddressList *string `json:"address-list,omitempty"`
+	// How long the address is kept on the address list: a duration, none-dynamic or none-static.
+	AddressListTimeout *string `json:"address-list-timeout,omitempty"`
+	// Short description of the rule.
+	Comment *string `json:"comment,omitempty"`
+	// Whether the rule is disabled.
+	Disabled *Boolean `json:"disabled,omitempty"`
+	// Connection tracking states to match, eg. established,related.
+	ConnectionState *FirewallConntrack_ConnectionStateList `json:"connection-state,omitempty"`
+	// NAT states of the connection to match.
+	ConnectionNatState *FirewallConntrack_ConnectionNatStateList `json:"connection-nat-state,omitempty"`
+	// Connection mark to match, as set by mangle rules.
+	ConnectionMark *string `json:"connection-mark,omitempty"`
+}
+
+// ToUpdate returns an update setting all settable fields of a `ip/firewall/filter` record to
+// their values in r. Fields unset in r which ROS doesn't accept empty (eg.
+// addresses, port lists or enums) are left out, so that listed records can be
+// written back. List values are shared between r and the update.
+func (r *IpFirewallFilter) ToUpdate() *IpFirewallFilter_Update {
+	u := &IpFirewallFilter_Update{}
+	if r.Chain != "" {
+		u.Chain = new(IpFirewallFilter_Chain)
+		*u.Chain = r.Chain
+	}
+	if r.Action != "" {
+		u.Action = new(IpFirewallFilter_Action)
+		*u.Action = r.Action
+	}
+	u.RejectWith = new(string)
+	*u.RejectWith = r.RejectWith
+	u.SrcAddress = new(string)
+	*u.SrcAddress = r.SrcAddress
+	u.DstAddress = new(string)
+	*u.DstAddress = r.DstAddress
+	u.SrcAddressList = new(string)
+	*u.SrcAddressList = r.SrcAddressList
+	u.DstAddressList = new(string)
+	*u.DstAddressList = r.DstAddressList
+	if r.Protocol != "" {
+		u.Protocol = new(FirewallRule_Protocol)
+		*u.Protocol = r.Protocol
+	}
+	if !r.SrcPort.Equal(PortList{}) {
+		u.SrcPort = new(PortList)
+		*u.SrcPort = r.SrcPort
+	}
+	if !r.DstPort.Equal(PortList{}) {
+		u.DstPort = new(PortList)
+		*u.DstPort = r.DstPort
+	}
+	if !r.Port.Equal(PortList{}) {
+		u.Port = new(PortList)
+		*u.Port = r.Port
+	}
+	u.InInterface = new(string)
+	*u.InInterface = r.InInterface
+	u.OutInterface = new(string)
+	*u.OutInterface = r.OutInterface
+	u.InInterfaceList = new(string)
+	*u.InInterfaceList = r.InInterfaceList
+	u.OutInterfaceList = new(string)
+	*u.OutInterfaceList = r.OutInterfaceList
+	u.PacketMark = new(string)
+	*u.PacketMark = r.PacketMark
+	u.RoutingMark = new(string)
+	*u.RoutingMark = r.RoutingMark
+	u.TcpFlags = new(string)
+	*u.TcpFlags = r.TcpFlags
+	u.ICMPOptions = new(string)
+	*u.ICMPOptions = r.ICMPOptions
+	u.Limit = new(string)
+	*u.Limit = r.Limit
+	u.Log = new(Boolean)
+	*u.Log = r.Log
+	u.LogPrefix = new(string)
+	*u.LogPrefix = r.LogPrefix
+	u.JumpTarget = new(string)
+	*u.JumpTarget = r.JumpTarget
+	u.AddressList = new(string)
+	*u.AddressList = r.AddressList
+	u.AddressListTimeout = new(string)
+	*u.AddressListTimeout = r.AddressListTimeout
+	u.Comment = new(string)
+	*u.Comment = r.Comment
+	u.Disabled = new(Boolean)
+	*u.Disabled = r.Disabled
+	if r.ConnectionState.Not || len(r.ConnectionState.Values) != 0 {
+		u.ConnectionState = new(FirewallConntrack_ConnectionStateList)
+		*u.ConnectionState = r.ConnectionState
+	}
+	if r.ConnectionNatState.Not || len(r.ConnectionNatState.Values) != 0 {
+		u.ConnectionNatState = new(FirewallConntrack_ConnectionNatStateList)
+		*u.ConnectionNatState = r.ConnectionNatState
+	}
+	u.ConnectionMark = new(string)
+	*u.ConnectionMark = r.ConnectionMark
+	return u
+}
+
+// DiffIpFirewallFilter returns an update which changes current into desired, setting only
+// the settable fields that differ between them. Read-only fields, and fields
+// left out by ToUpdate when unset in desired, are ignored. The update IsEmpty if
+// there is nothing to change.
+func DiffIpFirewallFilter(current, desired *IpFirewallFilter) *IpFirewallFilter_Update {
+	u := &IpFirewallFilter_Update{}
+	if (desired.Chain != "") && current.Chain != desired.Chain {
+		u.Chain = new(IpFirewallFilter_Chain)
+		*u.Chain = desired.Chain
+	}
+	if (desired.Action != "") && current.Action != desired.Action {
+		u.Action = new(IpFirewallFilter_Action)
+		*u.Action = desired.Action
+	}
+	if current.RejectWith != desired.RejectWith {
+		u.RejectWith = new(string)
+		*u.RejectWith = desired.RejectWith
+	}
+	if current.SrcAddress != desired.SrcAddress {
+		u.SrcAddress = new(string)
+		*u.SrcAddress = desired.SrcAddress
+	}
+	if current.DstAddress != desired.DstAddress {
+		u.DstAddress = new(string)
+		*u.DstAddress = desired.DstAddress
+	}
+	if current.SrcAddressList != desired.SrcAddressList {
+		u.SrcAddressList = new(string)
+		*u.SrcAddressList = desired.SrcAddressList
+	}
+	if current.DstAddressList != desired.DstAddressList {
+		u.DstAddressList = new(string)
+		*u.DstAddressList = desired.DstAddressList
+	}
+	if (desired.Protocol != "") && current.Protocol != desired.Protocol {
+		u.Protocol = new(FirewallRule_Protocol)
+		*u.Protocol = desired.Protocol
+	}
+	if (!desired.SrcPort.Equal(PortList{})) && !current.SrcPort.Equal(desired.SrcPort) {
+		u.SrcPort = new(PortList)
+		*u.SrcPort = desired.SrcPort
+	}
+	if (!desired.DstPort.Equal(PortList{})) && !current.DstPort.Equal(desired.DstPort) {
+		u.DstPort = new(PortList)
+		*u.DstPort = desired.DstPort
+	}
+	if (!desired.Port.Equal(PortList{})) && !current.Port.Equal(desired.Port) {
+		u.Port = new(PortList)
+		*u.Port = desired.Port
+	}
+	if current.InInterface != desired.InInterface {
+		u.InInterface = new(string)
+		*u.InInterface = desired.InInterface
+	}
+	if current.OutInterface != desired.OutInterface {
+		u.OutInterface = new(string)
+		*u.OutInterface = desired.OutInterface
+	}
+	if current.InInterfaceList != desired.InInterfaceList {
+		u.InInterfaceList = new(string)
+		*u.InInterfaceList = desired.InInterfaceList
+	}
+	if current.OutInterfaceList != desired.OutInterfaceList {
+		u.OutInterfaceList = new(string)
+		*u.OutInterfaceList = desired.OutInterfaceList
+	}
+	if current.PacketMark != desired.PacketMark {
+		u.PacketMark = new(string)
+		*u.PacketMark = desired.PacketMark
+	}
+	if current.RoutingMark != desired.RoutingMark {
+		u.RoutingMark = new(string)
+		*u.RoutingMark = desired.RoutingMark
+	}
+	if current.TcpFlags != desired.TcpFlags {
+		u.TcpFlags = new(string)
+		*u.TcpFlags = desired.TcpFlags
+	}
+	if current.ICMPOptions != desired.ICMPOptions {
+		u.ICMPOptions = new(string)
+		*u.ICMPOptions = desired.ICMPOptions
+	}
+	if current.Limit != desired.Limit {
+		u.Limit = new(string)
+		*u.Limit = desired.Limit
+	}
+	if current.Log != desired.Log {
+		u.Log = new(Boolean)
+		*u.Log = desired.Log
+	}
+	if current.LogPrefix != desired.LogPrefix {
+		u.LogPrefix = new(string)
+		*u.LogPrefix = desired.LogPrefix
+	}
+	if current.JumpTarget != desired.JumpTarget {
+		u.JumpTarget = new(string)
+		*u.JumpTarget = desired.JumpTarget
+	}
+	if current.AddressList != desired.AddressList {
+		u.AddressList = new(string)
+		*u.AddressList = desired.AddressList
+	}
+	if current.AddressListTimeout != desired.AddressListTimeout {
+		u.AddressListTimeout = new(string)
+		*u.AddressListTimeout = desired.AddressListTimeout
+	}
+	if current.Comment != desired.Comment {
+		u.Comment = new(string)
+		*u.Comment = desired.Comment
+	}
+	if current.Disabled != desired.Disabled {
+		u.Disabled = new(Boolean)
+		*u.Disabled = desired.Disabled
+	}
+	if (desired.ConnectionState.Not || len(desired.ConnectionState.Values) != 0) && !current.ConnectionState.Equal(desired.ConnectionState) {
+		u.ConnectionState = new(FirewallConntrack_ConnectionStateList)
+		*u.ConnectionState = desired.ConnectionState
+	}
+	if (desired.ConnectionNatState.Not || len(desired.ConnectionNatState.Values) != 0) && !current.ConnectionNatState.Equal(desired.ConnectionNatState) {
+		u.ConnectionNatState = new(FirewallConntrack_ConnectionNatStateList)
+		*u.ConnectionNatState = desired.ConnectionNatState
+	}
+	if current.ConnectionMark != desired.ConnectionMark {
+		u.ConnectionMark = new(string)
+		*u.ConnectionMark = desired.ConnectionMark
+	}
+	return u
+}
+
+// IsEmpty returns whether the update does not set any field, ie. applying it
+// would be a no-op.
+func (u *IpFirewallFilter_Update) IsEmpty() bool {
+	return u.Chain == nil &&
+		u.Action == nil &&
+		u.RejectWith == nil &&
+		u.SrcAddress == nil &&
+		u.DstAddress == nil &&
+		u.SrcAddressList == nil &&
+		u.DstAddressList == nil &&
+		u.Protocol == nil &&
+		u.SrcPort == nil &&
+		u.DstPort == nil &&
+		u.Port == nil &&
+		u.InInterface == nil &&
+		u.OutInterface == nil &&
+		u.InInterfaceList == nil &&
+		u.OutInterfaceList == nil &&
+		u.PacketMark == nil &&
+		u.RoutingMark == nil &&
+		u.TcpFlags == nil &&
+		u.ICMPOptions == nil &&
+		u.Limit == nil &&
+		u.Log == nil &&
+		u.LogPrefix == nil &&
+		u.JumpTarget == nil &&
+		u.AddressList == nil &&
+		u.AddressListTimeout == nil &&
+		u.Comment == nil &&
+		u.Disabled == nil &&
+		u.ConnectionState == nil &&
+		u.ConnectionNatState == nil &&
+		u.ConnectionMark == nil
+}
+
+// IpFirewallFilter_Field is the name of a `ip/firewall/filter` record property, for use in .proplist
+// projections.
+type IpFirewallFilter_Field string
+
+const (
+	IpFirewallFilter_FieldID                 IpFirewallFilter_Field = ".id"
+	IpFirewallFilter_FieldChain              IpFirewallFilter_Field = "chain"
+	IpFirewallFilter_FieldAction             IpFirewallFilter_Field = "action"
+	IpFirewallFilter_FieldRejectWith         IpFirewallFilter_Field = "reject-with"
+	IpFirewallFilter_FieldSrcAddress         IpFirewallFilter_Field = "src-address"
+	IpFirewallFilter_FieldDstAddress         IpFirewallFilter_Field = "dst-address"
+	IpFirewallFilter_FieldSrcAddressList     IpFirewallFilter_Field = "src-address-list"
+	IpFirewallFilter_FieldDstAddressList     IpFirewallFilter_Field = "dst-address-list"
+	IpFirewallFilter_FieldProtocol           IpFirewallFilter_Field = "protocol"
+	IpFirewallFilter_FieldSrcPort            IpFirewallFilter_Field = "src-port"
+	IpFirewallFilter_FieldDstPort            IpFirewallFilter_Field = "dst-port"
+	IpFirewallFilter_FieldPort               IpFirewallFilter_Field = "port"
+	IpFirewallFilter_FieldInInterface        IpFirewallFilter_Field = "in-interface"
+	IpFirewallFilter_FieldOutInterface       IpFirewallFilter_Field = "out-interface"
+	IpFirewallFilter_FieldInInterfaceList    IpFirewallFilter_Field = "in-interface-list"
+	IpFirewallFilter_FieldOutInterfaceList   IpFirewallFilter_Field = "out-interface-list"
+	IpFirewallFilter_FieldPacketMark         IpFirewallFilter_Field = "packet-mark"
+	IpFirewallFilter_FieldRoutingMark        IpFirewallFilter_Field = "routing-mark"
+	IpFirewallFilter_FieldTcpFlags           IpFirewallFilter_Field = "tcp-flags"
+	IpFirewallFilter_FieldICMPOptions        IpFirewallFilter_Field = "icmp-options"
+	IpFirewallFilter_FieldLimit              IpFirewallFilter_Field = "limit"
+	IpFirewallFilter_FieldLog                IpFirewallFilter_Field = "log"
+	IpFirewallFilter_FieldLogPrefix          IpFirewallFilter_Field = "log-prefix"
+	IpFirewallFilter_FieldJumpTarget         IpFirewallFilter_Field = "jump-target"
+	IpFirewallFilter_FieldAddressList        IpFirewallFilter_Field = "address-list"
+	IpFirewallFilter_FieldAddressListTimeout IpFirewallFilter_Field = "address-list-timeout"
+	IpFirewallFilter_FieldComment            IpFirewallFilter_Field = "comment"
+	IpFirewallFilter_FieldDisabled           IpFirewallFilter_Field = "disabled"
+	IpFirewallFilter_FieldBytes              IpFirewallFilter_Field = "bytes"
+	IpFirewallFilter_FieldPackets            IpFirewallFilter_Field = "packets"
+	IpFirewallFilter_FieldDynamic            IpFirewallFilter_Field = "dynamic"
+	IpFirewallFilter_FieldInvalid            IpFirewallFilter_Field = "invalid"
+	IpFirewallFilter_FieldConnectionState    IpFirewallFilter_Field = "connection-state"
+	IpFirewallFilter_FieldConnectionNatState IpFirewallFilter_Field = "connection-nat-state"
+	IpFirewallFilter_FieldConnectionMark     IpFirewallFilter_Field = "connection-mark"
+)
+
+// IpFirewallFilter_Filter is an equality filter on `ip/firewall/filter` records, evaluated by ROS.
+// Any unset field will not be filtered on.
+type IpFirewallFilter_Filter struct {
+	ID                 *RecordID                                 `json:".id,omitempty"`
+	Chain              *IpFirewallFilter_Chain                   `json:"chain,omitempty"`
+	Action             *IpFirewallFilter_Action                  `json:"action,omitempty"`
+	RejectWith         *string                                   `json:"reject-with,omitempty"`
+	SrcAddress         *string                                   `json:"src-address,omitempty"`
+	DstAddress         *string                                   `json:"dst-address,omitempty"`
+	SrcAddressList     *string                                   `json:"src-address-list,omitempty"`
+	DstAddressList     *string                                   `json:"dst-address-list,omitempty"`
+	Protocol           *FirewallRule_Protocol                    `json:"protocol,omitempty"`
+	SrcPort            *PortList                                 `json:"src-port,omitempty"`
+	DstPort            *PortList                                 `json:"dst-port,omitempty"`
+	Port               *PortList                                 `json:"port,omitempty"`
+	InInterface        *string                                   `json:"in-interface,omitempty"`
+	OutInterface       *string                                   `json:"out-interface,omitempty"`
+	InInterfaceList    *string                                   `json:"in-interface-list,omitempty"`
+	OutInterfaceList   *string                                   `json:"out-interface-list,omitempty"`
+	PacketMark         *string                                   `json:"packet-mark,omitempty"`
+	RoutingMark        *string                                   `json:"routing-mark,omitempty"`
+	TcpFlags           *string                                   `json:"tcp-flags,omitempty"`
+	ICMPOptions        *string                                   `json:"icmp-options,omitempty"`
+	Limit              *string                                   `json:"limit,omitempty"`
+	Log                *Boolean                                  `json:"log,omitempty"`
+	LogPrefix          *string                                   `json:"log-prefix,omitempty"`
+	JumpTarget         *string                                   `json:"jump-target,omitempty"`
+	AddressList        *string                                   `json:"address-list,omitempty"`
+	AddressListTimeout *string                                   `json:"address-list-timeout,omitempty"`
+	Comment            *string                                   `json:"comment,omitempty"`
+	Disabled           *Boolean                                  `json:"disabled,omitempty"`
+	Bytes              *Number                                   `json:"bytes,omitempty"`
+	Packets            *Number                                   `json:"packets,omitempty"`
+	Dynamic            *Boolean                                  `json:"dynamic,omitempty"`
+	Invalid            *Boolean                                  `json:"invalid,omitempty"`
+	ConnectionState    *FirewallConntrack_ConnectionStateList    `json:"connection-state,omitempty"`
+	ConnectionNatState *FirewallConntrack_ConnectionNatStateList `json:"connection-nat-state,omitempty"`
+	ConnectionMark     *string                                   `json:"connection-mark,omitempty"`
+}
+
+// IpFirewallFilter_ListOptions limits the records and fields returned by IpFirewallFilterListWith.
+type IpFirewallFilter_ListOptions struct {
+	// Filter, if set, only returns records matching all set fields.
+	Filter *IpFirewallFilter_Filter
+	// Proplist, if set, only returns the given fields of every record.
+	Proplist []IpFirewallFilter_Field
+}
+
+// IpFirewallFilterList returns a list of all `ip/firewall/filter` records.
+func (c *Client) IpFirewallFilterList(ctx context.Context) ([]IpFirewallFilter, error) {
+	return c.IpFirewallFilterListWith(ctx, nil)
+}
+
+// IpFirewallFilterListWith returns a list of all `ip/firewall/filter` records, optionally filtered
+// and projected by ROS according to the given options, which may be nil.
+func (c *Client) IpFirewallFilterListWith(ctx context.Context, opts *IpFirewallFilter_ListOptions) ([]IpFirewallFilter, error) {
+	var filter *IpFirewallFilter_Filter
+	var proplist []string
+	if opts != nil {
+		filter = opts.Filter
+		for _, f := range opts.Proplist {
+			proplist = append(proplist, string(f))
+		}
+	}
+	query, err := listQuery(filter, proplist)
+	if err != nil {
+		return nil, fmt.Errorf("invalid options: %w", err)
+	}
+	body, err := c.doGET(ctx, "ip/firewall/filter", "", query)
+	if err != nil {
+		return nil, fmt.Errorf("could not GET: %w", err)
+	}
+	defer body.Close()
+
+	var target []IpFirewallFilter
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return target, nil
+}
+
+// IpFirewallFilterFind returns all `ip/firewall/filter` records matching the given filter, which may
+// be nil. The filter is sent as a print .query and evaluated by ROS. If
+// proplist is given, only the given fields of every record are returned.
+func (c *Client) IpFirewallFilterFind(ctx context.Context, filter *IpFirewallFilter_Filter, proplist ...IpFirewallFilter_Field) ([]IpFirewallFilter, error) {
+	var props []string
+	for _, f := range proplist {
+		props = append(props, string(f))
+	}
+	rdata, err := printQuery(filter, props)
+	if err != nil {
+		return nil, fmt.Errorf("invalid filter: %w", err)
+	}
+	body, err := c.doPOST(ctx, "ip/firewall/filter", "print", rdata)
+	if err != nil {
+		return nil, fmt.Errorf("could not POST: %w", err)
+	}
+	defer body.Close()
+
+	var target []IpFirewallFilter
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return target, nil
+}
+
+// IpFirewallFilterPatch updates the given fields of a `ip/firewall/filter` record by ID.
+func (c *Client) IpFirewallFilterPatch(ctx context.Context, id RecordID, u *IpFirewallFilter_Update) (*IpFirewallFilter, error) {
+	rdata, err := json.Marshal(u)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal update: %w", err)
+	}
+	body, err := c.doPATCH(ctx, "ip/firewall/filter", id, rdata)
+	if err != nil {
+		return nil, fmt.Errorf("could not PATCH: %w", err)
+	}
+	defer body.Close()
+
+	var target IpFirewallFilter
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return &target, nil
+}
+
+// IpFirewallFilterGet returns a single `ip/firewall/filter` record by ID.
+func (c *Client) IpFirewallFilterGet(ctx context.Context, id RecordID) (*IpFirewallFilter, error) {
+	body, err := c.doGET(ctx, "ip/firewall/filter", id, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not GET: %w", err)
+	}
+	defer body.Close()
+
+	var target IpFirewallFilter
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return &target, nil
+}
+
+// IpFirewallFilterAdd creates a new `ip/firewall/filter` record with the given fields set. Any unset
+// field will be populated by ROS with its default value.
+func (c *Client) IpFirewallFilterAdd(ctx context.Context, u *IpFirewallFilter_Update) (*IpFirewallFilter, error) {
+	rdata, err := json.Marshal(u)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal update: %w", err)
+	}
+	body, err := c.doPUT(ctx, "ip/firewall/filter", rdata)
+	if err != nil {
+		return nil, fmt.Errorf("could not PUT: %w", err)
+	}
+	defer body.Close()
+
+	var target IpFirewallFilter
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return &target, nil
+}
+
+// IpFirewallFilterRemove deletes a `ip/firewall/filter` record by ID.
+func (c *Client) IpFirewallFilterRemove(ctx context.Context, id RecordID) error {
+	body, err := c.doDELETE(ctx, "ip/firewall/filter", id)
+	if err != nil {
+		return fmt.Errorf("could not DELETE: %w", err)
+	}
+	body.Close()
+	return nil
+}
+
+// IpFirewallFilterAddBefore creates a new `ip/firewall/filter` record with the given fields set,
+// placed before the record with the given ID. Any unset field will be
+// populated by ROS with its default value.
+func (c *Client) IpFirewallFilterAddBefore(ctx context.Context, u *IpFirewallFilter_Update, before RecordID) (*IpFirewallFilter, error) {
+	rdata, err := json.Marshal(struct {
+		*IpFirewallFilter_Update
+		PlaceBefore RecordID `json:"place-before"`
+	}{u, before})
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal update: %w", err)
+	}
+	body, err := c.doPUT(ctx, "ip/firewall/filter", rdata)
+	if err != nil {
+		return nil, fmt.Errorf("could not PUT: %w", err)
+	}
+	defer body.Close()
+
+	var target IpFirewallFilter
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return &target, nil
+}
+
+// IpFirewallFilterMove moves the `ip/firewall/filter` records with the given IDs, in the given
+// order, before the record with ID before, or to the end if before is empty.
+func (c *Client) IpFirewallFilterMove(ctx context.Context, ids []RecordID, before RecordID) error {
+	return c.MoveRows(ctx, "ip/firewall/filter", ids, before)
+}
+
+// IpFirewallFilter_Event is a change to a `ip/firewall/filter` record observed by IpFirewallFilterWatch.
+type IpFirewallFilter_Event struct {
+	Type EventType
+	// Before is the record before the change, nil if Type is EventAdded.
+	Before *IpFirewallFilter
+	// After is the record after the change, nil if Type is EventRemoved.
+	After *IpFirewallFilter
+	// Err is the polling error if Type is EventError.
+	Err error
+}
+
+// IpFirewallFilterWatch polls the `ip/firewall/filter` records every interval and sends an event for
+// every record added, modified or removed since the previous poll, starting
+// with an EventAdded for every existing record. Polling errors are sent as
+// EventError, and retried with backoff. The channel is closed once ctx is
+// canceled, or after a single EventError if interval is not positive.
+func (c *Client) IpFirewallFilterWatch(ctx context.Context, interval time.Duration) <-chan IpFirewallFilter_Event {
+	ch := make(chan IpFirewallFilter_Event)
+	list := func(ctx context.Context) ([]watchedRecord, error) {
+		records, err := c.IpFirewallFilterList(ctx)
+		if err != nil {
+			return nil, err
+		}
+		res := make([]watchedRecord, len(records))
+		for i := range records {
+			res[i] = watchedRecord{id: records[i].ID, record: &records[i]}
+		}
+		return res, nil
+	}
+	emit := func(t EventType, before, after interface{}, err error) bool {
+		ev := IpFirewallFilter_Event{Type: t, Err: err}
+		if before != nil {
+			ev.Before = before.(*IpFirewallFilter)
+		}
+		if after != nil {
+			ev.After = after.(*IpFirewallFilter)
+		}
+		select {
+		case ch <- ev:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+	go watch(ctx, interval, list, emit, func() { close(ch) })
+	return ch
+}
+
+// IpFirewallFilter_Schema describes the `ip/firewall/filter` menu.
+var IpFirewallFilter_Schema = &MenuSchema{
+	Path:    "ip/firewall/filter",
+	Table:   true,
+	Ordered: true,
+	Properties: []*PropertySchema{
+		{Name: "chain", Kind: KindEnum, Open: true, Variants: []string{"input", "forward", "output"}},
+		{Name: "action", Kind: KindEnum, Variants: []string{"accept", "add-dst-to-address-list", "add-src-to-address-list", "drop", "fasttrack-connection", "jump", "log", "passthrough", "reject", "return", "tarpit"}},
+		{Name: "reject-with", Kind: KindString},
+		{Name: "src-address", Kind: KindString},
+		{Name: "dst-address", Kind: KindString},
+		{Name: "src-address-list", Kind: KindString},
+		{Name: "dst-address-list", Kind: KindString},
+		{Name: "protocol", Kind: KindEnum, Open: true, Variants: []string{"tcp", "udp", "icmp", "icmpv6", "gre", "ipsec-esp", "ipsec-ah", "ospf", "vrrp", "sctp", "udp-lite", "igmp"}},
+		{Name: "src-port", Kind: KindPortList},
+		{Name: "dst-port", Kind: KindPortList},
+		{Name: "port", Kind: KindPortList},
+		{Name: "in-interface", Kind: KindString},
+		{Name: "out-interface", Kind: KindString},
+		{Name: "in-interface-list", Kind: KindString},
+		{Name: "out-interface-list", Kind: KindString},
+		{Name: "packet-mark", Kind: KindString},
+		{Name: "routing-mark", Kind: KindString},
+		{Name: "tcp-flags", Kind: KindString},
+		{Name: "icmp-options", Kind: KindString},
+		{Name: "limit", Kind: KindString},
+		{Name: "log", Kind: KindBoolean},
+		{Name: "log-prefix", Kind: KindString},
+		{Name: "jump-target", Kind: KindString},
+		{Name: "address-list", Kind: KindString},
+		{Name: "address-list-timeout", Kind: KindString},
+		{Name: "comment", Kind: KindString},
+		{Name: "disabled", Kind: KindBoolean},
+		{Name: "bytes", Kind: KindNumber, ReadOnly: true},
+		{Name: "packets", Kind: KindNumber, ReadOnly: true},
+		{Name: "dynamic", Kind: KindBoolean, ReadOnly: true},
+		{Name: "invalid", Kind: KindBoolean, ReadOnly: true},
+		{Name: "connection-state", Kind: KindEnumList, Variants: []string{"established", "related", "new", "invalid", "untracked"}},
+		{Name: "connection-nat-state", Kind: KindEnumList, Variants: []string{"srcnat", "dstnat"}},
+		{Name: "connection-mark", Kind: KindString},
+	},
+}
+
+func init() {
+	registerMenu(IpFirewallFilter_Schema)
+}
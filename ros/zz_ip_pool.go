@@ -0,0 +1,308 @@
+package ros
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Automatically generated by github.com/q3k/ros7api/gen, do not edit.
+
+// IpPool represents a ROS `ip/pool` record, including read-only fields.
+//
+// IP address pools, from which addresses are handed out by eg. DHCP servers.
+type IpPool struct {
+	Record
+
+	// Name of the pool.
+	Name string `json:"name"`
+	// Address ranges and single addresses in the pool, eg. 10.0.0.10-10.0.0.200.
+	Ranges IPRangeList `json:"ranges"`
+	// Pool to take addresses from once this one is exhausted.
+	NextPool string `json:"next-pool"`
+	// Short description of the pool.
+	Comment string `json:"comment"`
+}
+
+// IpPool_Update is an update to a ROS `ip/pool` record. Any unset field will not be updated.
+type IpPool_Update struct {
+	// Name of the pool.
+	Name *string `json:"name,omitempty"`
+	// Address ranges and single addresses in the pool, eg. 10.0.0.10-10.0.0.200.
+	Ranges *IPRangeList `json:"ranges,omitempty"`
+	// Pool to take addresses from once this one is exhausted.
+	NextPool *string `json:"next-pool,omitempty"`
+	// Short description of the pool.
+	Comment *string `json:"comment,omitempty"`
+}
+
+// ToUpdate returns an update setting all settable fields of a `ip/pool` record to
+// their values in r. Fields unset in r which ROS doesn't accept empty (eg.
+// addresses, port lists or enums) are left out, so that listed records can be
+// written back. List values are shared between r and the update.
+func (r *IpPool) ToUpdate() *IpPool_Update {
+	u := &IpPool_Update{}
+	u.Name = new(string)
+	*u.Name = r.Name
+	if len(r.Ranges) != 0 {
+		u.Ranges = new(IPRangeList)
+		*u.Ranges = r.Ranges
+	}
+	u.NextPool = new(string)
+	*u.NextPool = r.NextPool
+	u.Comment = new(string)
+	*u.Comment = r.Comment
+	return u
+}
+
+// DiffIpPool returns an update which changes current into desired, setting only
+// the settable fields that differ between them. Read-only fields, and fields
+// left out by ToUpdate when unset in desired, are ignored. The update IsEmpty if
+// there is nothing to change.
+func DiffIpPool(current, desired *IpPool) *IpPool_Update {
+	u := &IpPool_Update{}
+	if current.Name != desired.Name {
+		u.Name = new(string)
+		*u.Name = desired.Name
+	}
+	if (len(desired.Ranges) != 0) && !current.Ranges.Equal(desired.Ranges) {
+		u.Ranges = new(IPRangeList)
+		*u.Ranges = desired.Ranges
+	}
+	if current.NextPool != desired.NextPool {
+		u.NextPool = new(string)
+		*u.NextPool = desired.NextPool
+	}
+	if current.Comment != desired.Comment {
+		u.Comment = new(string)
+		*u.Comment = desired.Comment
+	}
+	return u
+}
+
+// IsEmpty returns whether the update does not set any field, ie. applying it
+// would be a no-op.
+func (u *IpPool_Update) IsEmpty() bool {
+	return u.Name == nil &&
+		u.Ranges == nil &&
+		u.NextPool == nil &&
+		u.Comment == nil
+}
+
+// IpPool_Field is the name of a `ip/pool` record property, for use in .proplist
+// projections.
+type IpPool_Field string
+
+const (
+	IpPool_FieldID       IpPool_Field = ".id"
+	IpPool_FieldName     IpPool_Field = "name"
+	IpPool_FieldRanges   IpPool_Field = "ranges"
+	IpPool_FieldNextPool IpPool_Field = "next-pool"
+	IpPool_FieldComment  IpPool_Field = "comment"
+)
+
+// IpPool_Filter is an equality filter on `ip/pool` records, evaluated by ROS.
+// Any unset field will not be filtered on.
+type IpPool_Filter struct {
+	ID       *RecordID    `json:".id,omitempty"`
+	Name     *string      `json:"name,omitempty"`
+	Ranges   *IPRangeList `json:"ranges,omitempty"`
+	NextPool *string      `json:"next-pool,omitempty"`
+	Comment  *string      `json:"comment,omitempty"`
+}
+
+// IpPool_ListOptions limits the records and fields returned by IpPoolListWith.
+type IpPool_ListOptions struct {
+	// Filter, if set, only returns records matching all set fields.
+	Filter *IpPool_Filter
+	// Proplist, if set, only returns the given fields of every record.
+	Proplist []IpPool_Field
+}
+
+// IpPoolList returns a list of all `ip/pool` records.
+func (c *Client) IpPoolList(ctx context.Context) ([]IpPool, error) {
+	return c.IpPoolListWith(ctx, nil)
+}
+
+// IpPoolListWith returns a list of all `ip/pool` records, optionally filtered
+// and projected by ROS according to the given options, which may be nil.
+func (c *Client) IpPoolListWith(ctx context.Context, opts *IpPool_ListOptions) ([]IpPool, error) {
+	var filter *IpPool_Filter
+	var proplist []string
+	if opts != nil {
+		filter = opts.Filter
+		for _, f := range opts.Proplist {
+			proplist = append(proplist, string(f))
+		}
+	}
+	query, err := listQuery(filter, proplist)
+	if err != nil {
+		return nil, fmt.Errorf("invalid options: %w", err)
+	}
+	body, err := c.doGET(ctx, "ip/pool", "", query)
+	if err != nil {
+		return nil, fmt.Errorf("could not GET: %w", err)
+	}
+	defer body.Close()
+
+	var target []IpPool
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return target, nil
+}
+
+// IpPoolFind returns all `ip/pool` records matching the given filter, which may
+// be nil. The filter is sent as a print .query and evaluated by ROS. If
+// proplist is given, only the given fields of every record are returned.
+func (c *Client) IpPoolFind(ctx context.Context, filter *IpPool_Filter, proplist ...IpPool_Field) ([]IpPool, error) {
+	var props []string
+	for _, f := range proplist {
+		props = append(props, string(f))
+	}
+	rdata, err := printQuery(filter, props)
+	if err != nil {
+		return nil, fmt.Errorf("invalid filter: %w", err)
+	}
+	body, err := c.doPOST(ctx, "ip/pool", "print", rdata)
+	if err != nil {
+		return nil, fmt.Errorf("could not POST: %w", err)
+	}
+	defer body.Close()
+
+	var target []IpPool
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return target, nil
+}
+
+// IpPoolPatch updates the given fields of a `ip/pool` record by ID.
+func (c *Client) IpPoolPatch(ctx context.Context, id RecordID, u *IpPool_Update) (*IpPool, error) {
+	rdata, err := json.Marshal(u)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal update: %w", err)
+	}
+	body, err := c.doPATCH(ctx, "ip/pool", id, rdata)
+	if err != nil {
+		return nil, fmt.Errorf("could not PATCH: %w", err)
+	}
+	defer body.Close()
+
+	var target IpPool
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return &target, nil
+}
+
+// IpPoolGet returns a single `ip/pool` record by ID.
+func (c *Client) IpPoolGet(ctx context.Context, id RecordID) (*IpPool, error) {
+	body, err := c.doGET(ctx, "ip/pool", id, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not GET: %w", err)
+	}
+	defer body.Close()
+
+	var target IpPool
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return &target, nil
+}
+
+// IpPoolAdd creates a new `ip/pool` record with the given fields set. Any unset
+// field will be populated by ROS with its default value.
+func (c *Client) IpPoolAdd(ctx context.Context, u *IpPool_Update) (*IpPool, error) {
+	rdata, err := json.Marshal(u)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal update: %w", err)
+	}
+	body, err := c.doPUT(ctx, "ip/pool", rdata)
+	if err != nil {
+		return nil, fmt.Errorf("could not PUT: %w", err)
+	}
+	defer body.Close()
+
+	var target IpPool
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return &target, nil
+}
+
+// IpPoolRemove deletes a `ip/pool` record by ID.
+func (c *Client) IpPoolRemove(ctx context.Context, id RecordID) error {
+	body, err := c.doDELETE(ctx, "ip/pool", id)
+	if err != nil {
+		return fmt.Errorf("could not DELETE: %w", err)
+	}
+	body.Close()
+	return nil
+}
+
+// IpPool_Event is a change to a `ip/pool` record observed by IpPoolWatch.
+type IpPool_Event struct {
+	Type EventType
+	// Before is the record before the change, nil if Type is EventAdded.
+	Before *IpPool
+	// After is the record after the change, nil if Type is EventRemoved.
+	After *IpPool
+	// Err is the polling error if Type is EventError.
+	Err error
+}
+
+// IpPoolWatch polls the `ip/pool` records every interval and sends an event for
+// every record added, modified or removed since the previous poll, starting
+// with an EventAdded for every existing record. Polling errors are sent as
+// EventError, and retried with backoff. The channel is closed once ctx is
+// canceled, or after a single EventError if interval is not positive.
+func (c *Client) IpPoolWatch(ctx context.Context, interval time.Duration) <-chan IpPool_Event {
+	ch := make(chan IpPool_Event)
+	list := func(ctx context.Context) ([]watchedRecord, error) {
+		records, err := c.IpPoolList(ctx)
+		if err != nil {
+			return nil, err
+		}
+		res := make([]watchedRecord, len(records))
+		for i := range records {
+			res[i] = watchedRecord{id: records[i].ID, record: &records[i]}
+		}
+		return res, nil
+	}
+	emit := func(t EventType, before, after interface{}, err error) bool {
+		ev := IpPool_Event{Type: t, Err: err}
+		if before != nil {
+			ev.Before = before.(*IpPool)
+		}
+		if after != nil {
+			ev.After = after.(*IpPool)
+		}
+		select {
+		case ch <- ev:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+	go watch(ctx, interval, list, emit, func() { close(ch) })
+	return ch
+}
+
+// IpPool_Schema describes the `ip/pool` menu.
+var IpPool_Schema = &MenuSchema{
+	Path:  "ip/pool",
+	Table: true,
+	Key:   []string{"name"},
+	Properties: []*PropertySchema{
+		{Name: "name", Kind: KindString},
+		{Name: "ranges", Kind: KindIPRangeList},
+		{Name: "next-pool", Kind: KindString},
+		{Name: "comment", Kind: KindString},
+	},
+}
+
+func init() {
+	registerMenu(IpPool_Schema)
+}
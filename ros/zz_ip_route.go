@@ -0,0 +1,507 @@
+package ros
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Automatically generated by github.com/q3k/ros7api/gen, do not edit.
+
+// IpRoute_CheckGateway is the type of the `check-gateway` property. Values not known to this library
+// (eg. introduced in newer ROS versions) are kept as-is, but reported
+// through UnknownEnumHandler.
+type IpRoute_CheckGateway string
+
+const (
+	// Gateway reachability is not checked.
+	IpRoute_CheckGatewayNone IpRoute_CheckGateway = "none"
+	// Gateway reachability is checked with ARP requests.
+	IpRoute_CheckGatewayArp IpRoute_CheckGateway = "arp"
+	// Gateway reachability is checked with ICMP echo requests.
+	IpRoute_CheckGatewayPing IpRoute_CheckGateway = "ping"
+	// Gateway reachability is checked with BFD.
+	IpRoute_CheckGatewayBfd IpRoute_CheckGateway = "bfd"
+	// Gateway reachability is checked with multihop BFD.
+	IpRoute_CheckGatewayBfdMultihop IpRoute_CheckGateway = "bfd-multihop"
+)
+
+// Values returns all values of IpRoute_CheckGateway known to this library.
+func (IpRoute_CheckGateway) Values() []IpRoute_CheckGateway {
+	return []IpRoute_CheckGateway{
+		IpRoute_CheckGatewayNone,
+		IpRoute_CheckGatewayArp,
+		IpRoute_CheckGatewayPing,
+		IpRoute_CheckGatewayBfd,
+		IpRoute_CheckGatewayBfdMultihop,
+	}
+}
+
+// IsKnown returns whether the value is known to this library.
+func (e IpRoute_CheckGateway) IsKnown() bool {
+	switch e {
+	case IpRoute_CheckGatewayNone, IpRoute_CheckGatewayArp, IpRoute_CheckGatewayPing, IpRoute_CheckGatewayBfd, IpRoute_CheckGatewayBfdMultihop:
+		return true
+	}
+	return false
+}
+
+func (e IpRoute_CheckGateway) String() string {
+	return string(e)
+}
+
+func (e *IpRoute_CheckGateway) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*e = IpRoute_CheckGateway(s)
+	if s != "" && !e.IsKnown() {
+		reportUnknownEnum("IpRoute_CheckGateway", s)
+	}
+	return nil
+}
+
+func (e IpRoute_CheckGateway) MarshalJSON() ([]byte, error) {
+	if e != "" && !e.IsKnown() {
+		reportUnknownEnum("IpRoute_CheckGateway", string(e))
+	}
+	return json.Marshal(string(e))
+}
+
+// IpRoute represents a ROS `ip/route` record, including read-only fields.
+//
+// IPv4 routes, both static and added dynamically by routing protocols.
+type IpRoute struct {
+	Record
+
+	// Destination prefix of the route, eg. 10.0.0.0/8.
+	DstAddress IPNet `json:"dst-address"`
+	// Gateways of the route, each an IP address, an interface, or an IP address reachable through an interface, eg. 10.0.0.1. ECMP routes have more than one. Empty for routes without a gateway, eg. blackholes.
+	Gateway GatewayList `json:"gateway"`
+	// Administrative distance of the route, used to pick between routes to the same destination. Lower is preferred.
+	Distance Number `json:"distance"`
+	// Scope of the route, used when resolving recursive gateways of other routes.
+	Scope Number `json:"scope"`
+	// Maximum scope of the routes through which the gateway of this route can be resolved.
+	TargetScope Number `json:"target-scope"`
+	// Routing table the route belongs to, eg. main.
+	RoutingTable string `json:"routing-table"`
+	// VRF interface through which the gateway is resolved, for routes leaking between VRFs.
+	VRFInterface string `json:"vrf-interface"`
+	// Whether packets matching the route are silently dropped.
+	Blackhole Boolean `json:"blackhole"`
+	// How to check that the gateway is reachable. The route is made inactive while it isn't.
+	CheckGateway IpRoute_CheckGateway `json:"check-gateway"`
+	// Source address preferred for packets originating from the router and sent through the route.
+	PrefSrc IP `json:"pref-src"`
+	// Short description of the route.
+	Comment string `json:"comment"`
+	// Whether the route is disabled.
+	Disabled Boolean `json:"disabled"`
+	// Whether the route is active, ie. used for forwarding.
+	Active Boolean `json:"active"`
+	// Whether the route was added dynamically, eg. by a routing protocol or for a connected network.
+	Dynamic Boolean `json:"dynamic"`
+	// Whether the route is one of several equal cost routes to the same destination.
+	ECMP Boolean `json:"ecmp"`
+	// Gateways the route is resolved to, ie. the directly connected next hops and their interfaces. Empty if unresolved.
+	ImmediateGW GatewayList `json:"immediate-gw"`
+}
+
+// IpRoute_Update is an update to a ROS `ip/route` record. Any unset field will not be updated.
+type IpRoute_Update struct {
+	// Destination prefix of the route, eg. 10.0.0.0/8.
+	DstAddress *IPNet `json:"dst-address,omitempty"`
+	// Gateways of the route, each an IP address, an interface, or an IP address reachable through an interface, eg. 10.0.0.1. ECMP routes have more than one. Empty for routes without a gateway, eg. blackholes.
+	Gateway *GatewayList `json:"gateway,omitempty"`
+	// Administrative distance of the route, used to pick between routes to the same destination. Lower is preferred.
+	Distance *Number `json:"distance,omitempty"`
+	// Scope of the route, used when resolving recursive gateways of other routes.
+	Scope *Number `json:"scope,omitempty"`
+	// Maximum scope of the routes through which the gateway of this route can be resolved.
+	TargetScope *Number `json:"target-scope,omitempty"`
+	// Routing table the route belongs to, eg. main.
+	RoutingTable *string `json:"routing-table,omitempty"`
+	// VRF interface through which the gateway is resolved, for routes leaking between VRFs.
+	VRFInterface *string `json:"vrf-interface,omitempty"`
+	// Whether packets matching the route are silently dropped.
+	Blackhole *Boolean `json:"blackhole,omitempty"`
+	// How to check that the gateway is reachable. The route is made inactive while it isn't.
+	CheckGateway *IpRoute_CheckGateway `json:"check-gateway,omitempty"`
+	// Source address preferred for packets originating from the router and sent through the route.
+	PrefSrc *IP `json:"pref-src,omitempty"`
+	// Short description of the route.
+	Comment *string `json:"comment,omitempty"`
+	// Whether the route is disabled.
+	Disabled *Boolean `json:"disabled,omitempty"`
+}
+
+// ToUpdate returns an update setting all settable fields of a `ip/route` record to
+// their values in r. Fields unset in r which ROS doesn't accept empty (eg.
+// addresses, port lists or enums) are left out, so that listed records can be
+// written back. List values are shared between r and the update.
+func (r *IpRoute) ToUpdate() *IpRoute_Update {
+	u := &IpRoute_Update{}
+	if r.DstAddress.Address != nil {
+		u.DstAddress = new(IPNet)
+		*u.DstAddress = r.DstAddress
+	}
+	if len(r.Gateway) != 0 {
+		u.Gateway = new(GatewayList)
+		*u.Gateway = r.Gateway
+	}
+	u.Distance = new(Number)
+	*u.Distance = r.Distance
+	u.Scope = new(Number)
+	*u.Scope = r.Scope
+	u.TargetScope = new(Number)
+	*u.TargetScope = r.TargetScope
+	u.RoutingTable = new(string)
+	*u.RoutingTable = r.RoutingTable
+	u.VRFInterface = new(string)
+	*u.VRFInterface = r.VRFInterface
+	u.Blackhole = new(Boolean)
+	*u.Blackhole = r.Blackhole
+	if r.CheckGateway != "" {
+		u.CheckGateway = new(IpRoute_CheckGateway)
+		*u.CheckGateway = r.CheckGateway
+	}
+	if len(r.PrefSrc) != 0 {
+		u.PrefSrc = new(IP)
+		*u.PrefSrc = r.PrefSrc
+	}
+	u.Comment = new(string)
+	*u.Comment = r.Comment
+	u.Disabled = new(Boolean)
+	*u.Disabled = r.Disabled
+	return u
+}
+
+// DiffIpRoute returns an update which changes current into desired, setting only
+// the settable fields that differ between them. Read-only fields, and fields
+// left out by ToUpdate when unset in desired, are ignored. The update IsEmpty if
+// there is nothing to change.
+func DiffIpRoute(current, desired *IpRoute) *IpRoute_Update {
+	u := &IpRoute_Update{}
+	if (desired.DstAddress.Address != nil) && !current.DstAddress.Equal(desired.DstAddress) {
+		u.DstAddress = new(IPNet)
+		*u.DstAddress = desired.DstAddress
+	}
+	if (len(desired.Gateway) != 0) && !current.Gateway.Equal(desired.Gateway) {
+		u.Gateway = new(GatewayList)
+		*u.Gateway = desired.Gateway
+	}
+	if current.Distance != desired.Distance {
+		u.Distance = new(Number)
+		*u.Distance = desired.Distance
+	}
+	if current.Scope != desired.Scope {
+		u.Scope = new(Number)
+		*u.Scope = desired.Scope
+	}
+	if current.TargetScope != desired.TargetScope {
+		u.TargetScope = new(Number)
+		*u.TargetScope = desired.TargetScope
+	}
+	if current.RoutingTable != desired.RoutingTable {
+		u.RoutingTable = new(string)
+		*u.RoutingTable = desired.RoutingTable
+	}
+	if current.VRFInterface != desired.VRFInterface {
+		u.VRFInterface = new(string)
+		*u.VRFInterface = desired.VRFInterface
+	}
+	if current.Blackhole != desired.Blackhole {
+		u.Blackhole = new(Boolean)
+		*u.Blackhole = desired.Blackhole
+	}
+	if (desired.CheckGateway != "") && current.CheckGateway != desired.CheckGateway {
+		u.CheckGateway = new(IpRoute_CheckGateway)
+		*u.CheckGateway = desired.CheckGateway
+	}
+	if (len(desired.PrefSrc) != 0) && !current.PrefSrc.Equal(desired.PrefSrc) {
+		u.PrefSrc = new(IP)
+		*u.PrefSrc = desired.PrefSrc
+	}
+	if current.Comment != desired.Comment {
+		u.Comment = new(string)
+		*u.Comment = desired.Comment
+	}
+	if current.Disabled != desired.Disabled {
+		u.Disabled = new(Boolean)
+		*u.Disabled = desired.Disabled
+	}
+	return u
+}
+
+// IsEmpty returns whether the update does not set any field, ie. applying it
+// would be a no-op.
+func (u *IpRoute_Update) IsEmpty() bool {
+	return u.DstAddress == nil &&
+		u.Gateway == nil &&
+		u.Distance == nil &&
+		u.Scope == nil &&
+		u.TargetScope == nil &&
+		u.RoutingTable == nil &&
+		u.VRFInterface == nil &&
+		u.Blackhole == nil &&
+		u.CheckGateway == nil &&
+		u.PrefSrc == nil &&
+		u.Comment == nil &&
+		u.Disabled == nil
+}
+
+// IpRoute_Field is the name of a `ip/route` record property, for use in .proplist
+// projections.
+type IpRoute_Field string
+
+const (
+	IpRoute_FieldID           IpRoute_Field = ".id"
+	IpRoute_FieldDstAddress   IpRoute_Field = "dst-address"
+	IpRoute_FieldGateway      IpRoute_Field = "gateway"
+	IpRoute_FieldDistance     IpRoute_Field = "distance"
+	IpRoute_FieldScope        IpRoute_Field = "scope"
+	IpRoute_FieldTargetScope  IpRoute_Field = "target-scope"
+	IpRoute_FieldRoutingTable IpRoute_Field = "routing-table"
+	IpRoute_FieldVRFInterface IpRoute_Field = "vrf-interface"
+	IpRoute_FieldBlackhole    IpRoute_Field = "blackhole"
+	IpRoute_FieldCheckGateway IpRoute_Field = "check-gateway"
+	IpRoute_FieldPrefSrc      IpRoute_Field = "pref-src"
+	IpRoute_FieldComment      IpRoute_Field = "comment"
+	IpRoute_FieldDisabled     IpRoute_Field = "disabled"
+	IpRoute_FieldActive       IpRoute_Field = "active"
+	IpRoute_FieldDynamic      IpRoute_Field = "dynamic"
+	IpRoute_FieldECMP         IpRoute_Field = "ecmp"
+	IpRoute_FieldImmediateGW  IpRoute_Field = "immediate-gw"
+)
+
+// IpRoute_Filter is an equality filter on `ip/route` records, evaluated by ROS.
+// Any unset field will not be filtered on.
+type IpRoute_Filter struct {
+	ID           *RecordID             `json:".id,omitempty"`
+	DstAddress   *IPNet                `json:"dst-address,omitempty"`
+	Gateway      *GatewayList          `json:"gateway,omitempty"`
+	Distance     *Number               `json:"distance,omitempty"`
+	Scope        *Number               `json:"scope,omitempty"`
+	TargetScope  *Number               `json:"target-scope,omitempty"`
+	RoutingTable *string               `json:"routing-table,omitempty"`
+	VRFInterface *string               `json:"vrf-interface,omitempty"`
+	Blackhole    *Boolean              `json:"blackhole,omitempty"`
+	CheckGateway *IpRoute_CheckGateway `json:"check-gateway,omitempty"`
+	PrefSrc      *IP                   `json:"pref-src,omitempty"`
+	Comment      *string               `json:"comment,omitempty"`
+	Disabled     *Boolean              `json:"disabled,omitempty"`
+	Active       *Boolean              `json:"active,omitempty"`
+	Dynamic      *Boolean              `json:"dynamic,omitempty"`
+	ECMP         *Boolean              `json:"ecmp,omitempty"`
+	ImmediateGW  *GatewayList          `json:"immediate-gw,omitempty"`
+}
+
+// IpRoute_ListOptions limits the records and fields returned by IpRouteListWith.
+type IpRoute_ListOptions struct {
+	// Filter, if set, only returns records matching all set fields.
+	Filter *IpRoute_Filter
+	// Proplist, if set, only returns the given fields of every record.
+	Proplist []IpRoute_Field
+}
+
+// IpRouteList returns a list of all `ip/route` records.
+func (c *Client) IpRouteList(ctx context.Context) ([]IpRoute, error) {
+	return c.IpRouteListWith(ctx, nil)
+}
+
+// IpRouteListWith returns a list of all `ip/route` records, optionally filtered
+// and projected by ROS according to the given options, which may be nil.
+func (c *Client) IpRouteListWith(ctx context.Context, opts *IpRoute_ListOptions) ([]IpRoute, error) {
+	var filter *IpRoute_Filter
+	var proplist []string
+	if opts != nil {
+		filter = opts.Filter
+		for _, f := range opts.Proplist {
+			proplist = append(proplist, string(f))
+		}
+	}
+	query, err := listQuery(filter, proplist)
+	if err != nil {
+		return nil, fmt.Errorf("invalid options: %w", err)
+	}
+	body, err := c.doGET(ctx, "ip/route", "", query)
+	if err != nil {
+		return nil, fmt.Errorf("could not GET: %w", err)
+	}
+	defer body.Close()
+
+	var target []IpRoute
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return target, nil
+}
+
+// IpRouteFind returns all `ip/route` records matching the given filter, which may
+// be nil. The filter is sent as a print .query and evaluated by ROS. If
+// proplist is given, only the given fields of every record are returned.
+func (c *Client) IpRouteFind(ctx context.Context, filter *IpRoute_Filter, proplist ...IpRoute_Field) ([]IpRoute, error) {
+	var props []string
+	for _, f := range proplist {
+		props = append(props, string(f))
+	}
+	rdata, err := printQuery(filter, props)
+	if err != nil {
+		return nil, fmt.Errorf("invalid filter: %w", err)
+	}
+	body, err := c.doPOST(ctx, "ip/route", "print", rdata)
+	if err != nil {
+		return nil, fmt.Errorf("could not POST: %w", err)
+	}
+	defer body.Close()
+
+	var target []IpRoute
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return target, nil
+}
+
+// IpRoutePatch updates the given fields of a `ip/route` record by ID.
+func (c *Client) IpRoutePatch(ctx context.Context, id RecordID, u *IpRoute_Update) (*IpRoute, error) {
+	rdata, err := json.Marshal(u)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal update: %w", err)
+	}
+	body, err := c.doPATCH(ctx, "ip/route", id, rdata)
+	if err != nil {
+		return nil, fmt.Errorf("could not PATCH: %w", err)
+	}
+	defer body.Close()
+
+	var target IpRoute
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return &target, nil
+}
+
+// IpRouteGet returns a single `ip/route` record by ID.
+func (c *Client) IpRouteGet(ctx context.Context, id RecordID) (*IpRoute, error) {
+	body, err := c.doGET(ctx, "ip/route", id, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not GET: %w", err)
+	}
+	defer body.Close()
+
+	var target IpRoute
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return &target, nil
+}
+
+// IpRouteAdd creates a new `ip/route` record with the given fields set. Any unset
+// field will be populated by ROS with its default value.
+func (c *Client) IpRouteAdd(ctx context.Context, u *IpRoute_Update) (*IpRoute, error) {
+	rdata, err := json.Marshal(u)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal update: %w", err)
+	}
+	body, err := c.doPUT(ctx, "ip/route", rdata)
+	if err != nil {
+		return nil, fmt.Errorf("could not PUT: %w", err)
+	}
+	defer body.Close()
+
+	var target IpRoute
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return &target, nil
+}
+
+// IpRouteRemove deletes a `ip/route` record by ID.
+func (c *Client) IpRouteRemove(ctx context.Context, id RecordID) error {
+	body, err := c.doDELETE(ctx, "ip/route", id)
+	if err != nil {
+		return fmt.Errorf("could not DELETE: %w", err)
+	}
+	body.Close()
+	return nil
+}
+
+// IpRoute_Event is a change to a `ip/route` record observed by IpRouteWatch.
+type IpRoute_Event struct {
+	Type EventType
+	// Before is the record before the change, nil if Type is EventAdded.
+	Before *IpRoute
+	// After is the record after the change, nil if Type is EventRemoved.
+	After *IpRoute
+	// Err is the polling error if Type is EventError.
+	Err error
+}
+
+// IpRouteWatch polls the `ip/route` records every interval and sends an event for
+// every record added, modified or removed since the previous poll, starting
+// with an EventAdded for every existing record. Polling errors are sent as
+// EventError, and retried with backoff. The channel is closed once ctx is
+// canceled, or after a single EventError if interval is not positive.
+func (c *Client) IpRouteWatch(ctx context.Context, interval time.Duration) <-chan IpRoute_Event {
+	ch := make(chan IpRoute_Event)
+	list := func(ctx context.Context) ([]watchedRecord, error) {
+		records, err := c.IpRouteList(ctx)
+		if err != nil {
+			return nil, err
+		}
+		res := make([]watchedRecord, len(records))
+		for i := range records {
+			res[i] = watchedRecord{id: records[i].ID, record: &records[i]}
+		}
+		return res, nil
+	}
+	emit := func(t EventType, before, after interface{}, err error) bool {
+		ev := IpRoute_Event{Type: t, Err: err}
+		if before != nil {
+			ev.Before = before.(*IpRoute)
+		}
+		if after != nil {
+			ev.After = after.(*IpRoute)
+		}
+		select {
+		case ch <- ev:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+	go watch(ctx, interval, list, emit, func() { close(ch) })
+	return ch
+}
+
+// IpRoute_Schema describes the `ip/route` menu.
+var IpRoute_Schema = &MenuSchema{
+	Path:  "ip/route",
+	Table: true,
+	Key:   []string{"dst-address", "gateway", "routing-table"},
+	Properties: []*PropertySchema{
+		{Name: "dst-address", Kind: KindIPPrefix},
+		{Name: "gateway", Kind: KindGatewayList},
+		{Name: "distance", Kind: KindNumber},
+		{Name: "scope", Kind: KindNumber},
+		{Name: "target-scope", Kind: KindNumber},
+		{Name: "routing-table", Kind: KindString},
+		{Name: "vrf-interface", Kind: KindString},
+		{Name: "blackhole", Kind: KindBoolean},
+		{Name: "check-gateway", Kind: KindEnum, Variants: []string{"none", "arp", "ping", "bfd", "bfd-multihop"}},
+		{Name: "pref-src", Kind: KindIP},
+		{Name: "comment", Kind: KindString},
+		{Name: "disabled", Kind: KindBoolean},
+		{Name: "active", Kind: KindBoolean, ReadOnly: true},
+		{Name: "dynamic", Kind: KindBoolean, ReadOnly: true},
+		{Name: "ecmp", Kind: KindBoolean, ReadOnly: true},
+		{Name: "immediate-gw", Kind: KindGatewayList, ReadOnly: true},
+	},
+}
+
+func init() {
+	registerMenu(IpRoute_Schema)
+}
@@ -0,0 +1,384 @@
+package ros
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Automatically generated by github.com/q3k/ros7api/gen, do not edit.
+
+// Ipv6Address represents a ROS `ipv6/address` record, including read-only fields.
+//
+// IPv6 addresses assigned to interfaces. Link-local addresses are added dynamically to every interface.
+type Ipv6Address struct {
+	Record
+
+	// IPv6 address with prefix length, eg. 2001:db8::1/64. With eui-64 or from-pool, only the prefix part is used.
+	Address IPNet `json:"address"`
+	// Name of the interface the IPv6 address is assigned to.
+	Interface string `json:"interface"`
+	// Whether to advertise the prefix of the address in router advertisements, for stateless address autoconfiguration of hosts on the interface.
+	Advertise Boolean `json:"advertise"`
+	// Whether to generate the host part of the address from the interface MAC address, as per EUI-64.
+	EUI64 Boolean `json:"eui-64"`
+	// Name of the IPv6 pool the prefix of the address is taken from.
+	FromPool string `json:"from-pool"`
+	// Whether to skip duplicate address detection for this address.
+	NoDAD Boolean `json:"no-dad"`
+	// Short description of the address.
+	Comment string `json:"comment"`
+	// Whether the address is disabled.
+	Disabled Boolean `json:"disabled"`
+	// Name of the interface the address is actually bound to, eg. the bridge when interface is one of its ports.
+	ActualInterface string `json:"actual-interface"`
+	// Whether the address was added dynamically, eg. a link-local address or one obtained by a DHCPv6 client.
+	Dynamic Boolean `json:"dynamic"`
+	// Whether the address is invalid, eg. because its interface does not exist.
+	Invalid Boolean `json:"invalid"`
+	// Whether the address is a link-local address (fe80::/10).
+	LinkLocal Boolean `json:"link-local"`
+}
+
+// Ipv6Address_Update is an update to a ROS `ipv6/address` record. Any unset field will not be updated.
+type Ipv6Address_Update struct {
+	// IPv6 address with prefix length, eg. 2001:db8::1/64. With eui-64 or from-pool, only the prefix part is used.
+	Address *IPNet `json:"address,omitempty"`
+	// Name of the interface the IPv6 address is assigned to.
+	Interface *string `json:"interface,omitempty"`
+	// Whether to advertise the prefix of the address in router advertisements, for stateless address autoconfiguration of hosts on the interface.
+	Advertise *Boolean `json:"advertise,omitempty"`
+	// Whether to generate the host part of the address from the interface MAC address, as per EUI-64.
+	EUI64 *Boolean `json:"eui-64,omitempty"`
+	// Name of the IPv6 pool the prefix of the address is taken from.
+	FromPool *string `json:"from-pool,omitempty"`
+	// Whether to skip duplicate address detection for this address.
+	NoDAD *Boolean `json:"no-dad,omitempty"`
+	// Short description of the address.
+	Comment *string `json:"comment,omitempty"`
+	// Whether the address is disabled.
+	Disabled *Boolean `json:"disabled,omitempty"`
+}
+
+// ToUpdate returns an update setting all settable fields of a `ipv6/address` record to
+// their values in r. Fields unset in r which ROS doesn't accept empty (eg.
+// addresses, port lists or enums) are left out, so that listed records can be
+// written back. List values are shared between r and the update.
+func (r *Ipv6Address) ToUpdate() *Ipv6Address_Update {
+	u := &Ipv6Address_Update{}
+	if r.Address.Address != nil {
+		u.Address = new(IPNet)
+		*u.Address = r.Address
+	}
+	u.Interface = new(string)
+	*u.Interface = r.Interface
+	u.Advertise = new(Boolean)
+	*u.Advertise = r.Advertise
+	u.EUI64 = new(Boolean)
+	*u.EUI64 = r.EUI64
+	u.FromPool = new(string)
+	*u.FromPool = r.FromPool
+	u.NoDAD = new(Boolean)
+	*u.NoDAD = r.NoDAD
+	u.Comment = new(string)
+	*u.Comment = r.Comment
+	u.Disabled = new(Boolean)
+	*u.Disabled = r.Disabled
+	return u
+}
+
+// DiffIpv6Address returns an update which changes current into desired, setting only
+// the settable fields that differ between them. Read-only fields, and fields
+// left out by ToUpdate when unset in desired, are ignored. The update IsEmpty if
+// there is nothing to change.
+func DiffIpv6Address(current, desired *Ipv6Address) *Ipv6Address_Update {
+	u := &Ipv6Address_Update{}
+	if (desired.Address.Address != nil) && !current.Address.Equal(desired.Address) {
+		u.Address = new(IPNet)
+		*u.Address = desired.Address
+	}
+	if current.Interface != desired.Interface {
+		u.Interface = new(string)
+		*u.Interface = desired.Interface
+	}
+	if current.Advertise != desired.Advertise {
+		u.Advertise = new(Boolean)
+		*u.Advertise = desired.Advertise
+	}
+	if current.EUI64 != desired.EUI64 {
+		u.EUI64 = new(Boolean)
+		*u.EUI64 = desired.EUI64
+	}
+	if current.FromPool != desired.FromPool {
+		u.FromPool = new(string)
+		*u.FromPool = desired.FromPool
+	}
+	if current.NoDAD != desired.NoDAD {
+		u.NoDAD = new(Boolean)
+		*u.NoDAD = desired.NoDAD
+	}
+	if current.Comment != desired.Comment {
+		u.Comment = new(string)
+		*u.Comment = desired.Comment
+	}
+	if current.Disabled != desired.Disabled {
+		u.Disabled = new(Boolean)
+		*u.Disabled = desired.Disabled
+	}
+	return u
+}
+
+// IsEmpty returns whether the update does not set any field, ie. applying it
+// would be a no-op.
+func (u *Ipv6Address_Update) IsEmpty() bool {
+	return u.Address == nil &&
+		u.Interface == nil &&
+		u.Advertise == nil &&
+		u.EUI64 == nil &&
+		u.FromPool == nil &&
+		u.NoDAD == nil &&
+		u.Comment == nil &&
+		u.Disabled == nil
+}
+
+// Ipv6Address_Field is the name of a `ipv6/address` record property, for use in .proplist
+// projections.
+type Ipv6Address_Field string
+
+const (
+	Ipv6Address_FieldID              Ipv6Address_Field = ".id"
+	Ipv6Address_FieldAddress         Ipv6Address_Field = "address"
+	Ipv6Address_FieldInterface       Ipv6Address_Field = "interface"
+	Ipv6Address_FieldAdvertise       Ipv6Address_Field = "advertise"
+	Ipv6Address_FieldEUI64           Ipv6Address_Field = "eui-64"
+	Ipv6Address_FieldFromPool        Ipv6Address_Field = "from-pool"
+	Ipv6Address_FieldNoDAD           Ipv6Address_Field = "no-dad"
+	Ipv6Address_FieldComment         Ipv6Address_Field = "comment"
+	Ipv6Address_FieldDisabled        Ipv6Address_Field = "disabled"
+	Ipv6Address_FieldActualInterface Ipv6Address_Field = "actual-interface"
+	Ipv6Address_FieldDynamic         Ipv6Address_Field = "dynamic"
+	Ipv6Address_FieldInvalid         Ipv6Address_Field = "invalid"
+	Ipv6Address_FieldLinkLocal       Ipv6Address_Field = "link-local"
+)
+
+// Ipv6Address_Filter is an equality filter on `ipv6/address` records, evaluated by ROS.
+// Any unset field will not be filtered on.
+type Ipv6Address_Filter struct {
+	ID              *RecordID `json:".id,omitempty"`
+	Address         *IPNet    `json:"address,omitempty"`
+	Interface       *string   `json:"interface,omitempty"`
+	Advertise       *Boolean  `json:"advertise,omitempty"`
+	EUI64           *Boolean  `json:"eui-64,omitempty"`
+	FromPool        *string   `json:"from-pool,omitempty"`
+	NoDAD           *Boolean  `json:"no-dad,omitempty"`
+	Comment         *string   `json:"comment,omitempty"`
+	Disabled        *Boolean  `json:"disabled,omitempty"`
+	ActualInterface *string   `json:"actual-interface,omitempty"`
+	Dynamic         *Boolean  `json:"dynamic,omitempty"`
+	Invalid         *Boolean  `json:"invalid,omitempty"`
+	LinkLocal       *Boolean  `json:"link-local,omitempty"`
+}
+
+// Ipv6Address_ListOptions limits the records and fields returned by Ipv6AddressListWith.
+type Ipv6Address_ListOptions struct {
+	// Filter, if set, only returns records matching all set fields.
+	Filter *Ipv6Address_Filter
+	// Proplist, if set, only returns the given fields of every record.
+	Proplist []Ipv6Address_Field
+}
+
+// Ipv6AddressList returns a list of all `ipv6/address` records.
+func (c *Client) Ipv6AddressList(ctx context.Context) ([]Ipv6Address, error) {
+	return c.Ipv6AddressListWith(ctx, nil)
+}
+
+// Ipv6AddressListWith returns a list of all `ipv6/address` records, optionally filtered
+// and projected by ROS according to the given options, which may be nil.
+func (c *Client) Ipv6AddressListWith(ctx context.Context, opts *Ipv6Address_ListOptions) ([]Ipv6Address, error) {
+	var filter *Ipv6Address_Filter
+	var proplist []string
+	if opts != nil {
+		filter = opts.Filter
+		for _, f := range opts.Proplist {
+			proplist = append(proplist, string(f))
+		}
+	}
+	query, err := listQuery(filter, proplist)
+	if err != nil {
+		return nil, fmt.Errorf("invalid options: %w", err)
+	}
+	body, err := c.doGET(ctx, "ipv6/address", "", query)
+	if err != nil {
+		return nil, fmt.Errorf("could not GET: %w", err)
+	}
+	defer body.Close()
+
+	var target []Ipv6Address
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return target, nil
+}
+
+// Ipv6AddressFind returns all `ipv6/address` records matching the given filter, which may
+// be nil. The filter is sent as a print .query and evaluated by ROS. If
+// proplist is given, only the given fields of every record are returned.
+func (c *Client) Ipv6AddressFind(ctx context.Context, filter *Ipv6Address_Filter, proplist ...Ipv6Address_Field) ([]Ipv6Address, error) {
+	var props []string
+	for _, f := range proplist {
+		props = append(props, string(f))
+	}
+	rdata, err := printQuery(filter, props)
+	if err != nil {
+		return nil, fmt.Errorf("invalid filter: %w", err)
+	}
+	body, err := c.doPOST(ctx, "ipv6/address", "print", rdata)
+	if err != nil {
+		return nil, fmt.Errorf("could not POST: %w", err)
+	}
+	defer body.Close()
+
+	var target []Ipv6Address
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return target, nil
+}
+
+// Ipv6AddressPatch updates the given fields of a `ipv6/address` record by ID.
+func (c *Client) Ipv6AddressPatch(ctx context.Context, id RecordID, u *Ipv6Address_Update) (*Ipv6Address, error) {
+	rdata, err := json.Marshal(u)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal update: %w", err)
+	}
+	body, err := c.doPATCH(ctx, "ipv6/address", id, rdata)
+	if err != nil {
+		return nil, fmt.Errorf("could not PATCH: %w", err)
+	}
+	defer body.Close()
+
+	var target Ipv6Address
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return &target, nil
+}
+
+// Ipv6AddressGet returns a single `ipv6/address` record by ID.
+func (c *Client) Ipv6AddressGet(ctx context.Context, id RecordID) (*Ipv6Address, error) {
+	body, err := c.doGET(ctx, "ipv6/address", id, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not GET: %w", err)
+	}
+	defer body.Close()
+
+	var target Ipv6Address
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return &target, nil
+}
+
+// Ipv6AddressAdd creates a new `ipv6/address` record with the given fields set. Any unset
+// field will be populated by ROS with its default value.
+func (c *Client) Ipv6AddressAdd(ctx context.Context, u *Ipv6Address_Update) (*Ipv6Address, error) {
+	rdata, err := json.Marshal(u)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal update: %w", err)
+	}
+	body, err := c.doPUT(ctx, "ipv6/address", rdata)
+	if err != nil {
+		return nil, fmt.Errorf("could not PUT: %w", err)
+	}
+	defer body.Close()
+
+	var target Ipv6Address
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return &target, nil
+}
+
+// Ipv6AddressRemove deletes a `ipv6/address` record by ID.
+func (c *Client) Ipv6AddressRemove(ctx context.Context, id RecordID) error {
+	body, err := c.doDELETE(ctx, "ipv6/address", id)
+	if err != nil {
+		return fmt.Errorf("could not DELETE: %w", err)
+	}
+	body.Close()
+	return nil
+}
+
+// Ipv6Address_Event is a change to a `ipv6/address` record observed by Ipv6AddressWatch.
+type Ipv6Address_Event struct {
+	Type EventType
+	// Before is the record before the change, nil if Type is EventAdded.
+	Before *Ipv6Address
+	// After is the record after the change, nil if Type is EventRemoved.
+	After *Ipv6Address
+	// Err is the polling error if Type is EventError.
+	Err error
+}
+
+// Ipv6AddressWatch polls the `ipv6/address` records every interval and sends an event for
+// every record added, modified or removed since the previous poll, starting
+// with an EventAdded for every existing record. Polling errors are sent as
+// EventError, and retried with backoff. The channel is closed once ctx is
+// canceled, or after a single EventError if interval is not positive.
+func (c *Client) Ipv6AddressWatch(ctx context.Context, interval time.Duration) <-chan Ipv6Address_Event {
+	ch := make(chan Ipv6Address_Event)
+	list := func(ctx context.Context) ([]watchedRecord, error) {
+		records, err := c.Ipv6AddressList(ctx)
+		if err != nil {
+			return nil, err
+		}
+		res := make([]watchedRecord, len(records))
+		for i := range records {
+			res[i] = watchedRecord{id: records[i].ID, record: &records[i]}
+		}
+		return res, nil
+	}
+	emit := func(t EventType, before, after interface{}, err error) bool {
+		ev := Ipv6Address_Event{Type: t, Err: err}
+		if before != nil {
+			ev.Before = before.(*Ipv6Address)
+		}
+		if after != nil {
+			ev.After = after.(*Ipv6Address)
+		}
+		select {
+		case ch <- ev:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+	go watch(ctx, interval, list, emit, func() { close(ch) })
+	return ch
+}
+
+// Ipv6Address_Schema describes the `ipv6/address` menu.
+var Ipv6Address_Schema = &MenuSchema{
+	Path:  "ipv6/address",
+	Table: true,
+	Key:   []string{"address", "interface"},
+	Properties: []*PropertySchema{
+		{Name: "address", Kind: KindIPPrefix},
+		{Name: "interface", Kind: KindString},
+		{Name: "advertise", Kind: KindBoolean},
+		{Name: "eui-64", Kind: KindBoolean},
+		{Name: "from-pool", Kind: KindString},
+		{Name: "no-dad", Kind: KindBoolean},
+		{Name: "comment", Kind: KindString},
+		{Name: "disabled", Kind: KindBoolean},
+		{Name: "actual-interface", Kind: KindString, ReadOnly: true},
+		{Name: "dynamic", Kind: KindBoolean, ReadOnly: true},
+		{Name: "invalid", Kind: KindBoolean, ReadOnly: true},
+		{Name: "link-local", Kind: KindBoolean, ReadOnly: true},
+	},
+}
+
+func init() {
+	registerMenu(Ipv6Address_Schema)
+}
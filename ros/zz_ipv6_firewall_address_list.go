@@ -0,0 +1,330 @@
+package ros
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Automatically generated by github.com/q3k/ros7api/gen, do not edit.
+
+// Ipv6FirewallAddressList represents a ROS `ipv6/firewall/address-list` record, including read-only fields.
+//
+// IPv6 firewall address lists, named sets of addresses which can be matched by firewall rules.
+type Ipv6FirewallAddressList struct {
+	Record
+
+	// Name of the address list.
+	List string `json:"list"`
+	// Address, prefix, range or DNS name on the list, eg. 2001:db8::/32.
+	Address string `json:"address"`
+	// How long the address is kept on the list. Addresses without a timeout are kept forever.
+	Timeout Duration `json:"timeout"`
+	// Short description of the entry.
+	Comment string `json:"comment"`
+	// Whether the entry is disabled.
+	Disabled Boolean `json:"disabled"`
+	// When the entry was added.
+	CreationTime string `json:"creation-time"`
+	// Whether the entry was added dynamically, eg. by a firewall rule or with a timeout.
+	Dynamic Boolean `json:"dynamic"`
+}
+
+// Ipv6FirewallAddressList_Update is an update to a ROS `ipv6/firewall/address-list` record. Any unset field will not be updated.
+type Ipv6FirewallAddressList_Update struct {
+	// Name of the address list.
+	List *string `json:"list,omitempty"`
+	// Address, prefix, range or DNS name on the list, eg. 2001:db8::/32.
+	Address *string `json:"address,omitempty"`
+	// How long the address is kept on the list. Addresses without a timeout are kept forever.
+	Timeout *Duration `json:"timeout,omitempty"`
+	// Short description of the entry.
+	Comment *string `json:"comment,omitempty"`
+	// Whether the entry is disabled.
+	Disabled *Boolean `json:"disabled,omitempty"`
+}
+
+// ToUpdate returns an update setting all settable fields of a `ipv6/firewall/address-list` record to
+// their values in r. Fields unset in r which ROS doesn't accept empty (eg.
+// addresses, port lists or enums) are left out, so that listed records can be
+// written back. List values are shared between r and the update.
+func (r *Ipv6FirewallAddressList) ToUpdate() *Ipv6FirewallAddressList_Update {
+	u := &Ipv6FirewallAddressList_Update{}
+	u.List = new(string)
+	*u.List = r.List
+	u.Address = new(string)
+	*u.Address = r.Address
+	u.Timeout = new(Duration)
+	*u.Timeout = r.Timeout
+	u.Comment = new(string)
+	*u.Comment = r.Comment
+	u.Disabled = new(Boolean)
+	*u.Disabled = r.Disabled
+	return u
+}
+
+// DiffIpv6FirewallAddressList returns an update which changes current into desired, setting only
+// the settable fields that differ between them. Read-only fields, and fields
+// left out by ToUpdate when unset in desired, are ignored. The update IsEmpty if
+// there is nothing to change.
+func DiffIpv6FirewallAddressList(current, desired *Ipv6FirewallAddressList) *Ipv6FirewallAddressList_Update {
+	u := &Ipv6FirewallAddressList_Update{}
+	if current.List != desired.List {
+		u.List = new(string)
+		*u.List = desired.List
+	}
+	if current.Address != desired.Address {
+		u.Address = new(string)
+		*u.Address = desired.Address
+	}
+	if current.Timeout != desired.Timeout {
+		u.Timeout = new(Duration)
+		*u.Timeout = desired.Timeout
+	}
+	if current.Comment != desired.Comment {
+		u.Comment = new(string)
+		*u.Comment = desired.Comment
+	}
+	if current.Disabled != desired.Disabled {
+		u.Disabled = new(Boolean)
+		*u.Disabled = desired.Disabled
+	}
+	return u
+}
+
+// IsEmpty returns whether the update does not set any field, ie. applying it
+// would be a no-op.
+func (u *Ipv6FirewallAddressList_Update) IsEmpty() bool {
+	return u.List == nil &&
+		u.Address == nil &&
+		u.Timeout == nil &&
+		u.Comment == nil &&
+		u.Disabled == nil
+}
+
+// Ipv6FirewallAddressList_Field is the name of a `ipv6/firewall/address-list` record property, for use in .proplist
+// projections.
+type Ipv6FirewallAddressList_Field string
+
+const (
+	Ipv6FirewallAddressList_FieldID           Ipv6FirewallAddressList_Field = ".id"
+	Ipv6FirewallAddressList_FieldList         Ipv6FirewallAddressList_Field = "list"
+	Ipv6FirewallAddressList_FieldAddress      Ipv6FirewallAddressList_Field = "address"
+	Ipv6FirewallAddressList_FieldTimeout      Ipv6FirewallAddressList_Field = "timeout"
+	Ipv6FirewallAddressList_FieldComment      Ipv6FirewallAddressList_Field = "comment"
+	Ipv6FirewallAddressList_FieldDisabled     Ipv6FirewallAddressList_Field = "disabled"
+	Ipv6FirewallAddressList_FieldCreationTime Ipv6FirewallAddressList_Field = "creation-time"
+	Ipv6FirewallAddressList_FieldDynamic      Ipv6FirewallAddressList_Field = "dynamic"
+)
+
+// Ipv6FirewallAddressList_Filter is an equality filter on `ipv6/firewall/address-list` records, evaluated by ROS.
+// Any unset field will not be filtered on.
+type Ipv6FirewallAddressList_Filter struct {
+	ID           *RecordID `json:".id,omitempty"`
+	List         *string   `json:"list,omitempty"`
+	Address      *string   `json:"address,omitempty"`
+	Timeout      *Duration `json:"timeout,omitempty"`
+	Comment      *string   `json:"comment,omitempty"`
+	Disabled     *Boolean  `json:"disabled,omitempty"`
+	CreationTime *string   `json:"creation-time,omitempty"`
+	Dynamic      *Boolean  `json:"dynamic,omitempty"`
+}
+
+// Ipv6FirewallAddressList_ListOptions limits the records and fields returned by Ipv6FirewallAddressListListWith.
+type Ipv6FirewallAddressList_ListOptions struct {
+	// Filter, if set, only returns records matching all set fields.
+	Filter *Ipv6FirewallAddressList_Filter
+	// Proplist, if set, only returns the given fields of every record.
+	Proplist []Ipv6FirewallAddressList_Field
+}
+
+// Ipv6FirewallAddressListList returns a list of all `ipv6/firewall/address-list` records.
+func (c *Client) Ipv6FirewallAddressListList(ctx context.Context) ([]Ipv6FirewallAddressList, error) {
+	return c.Ipv6FirewallAddressListListWith(ctx, nil)
+}
+
+// Ipv6FirewallAddressListListWith returns a list of all `ipv6/firewall/address-list` records, optionally filtered
+// and projected by ROS according to the given options, which may be nil.
+func (c *Client) Ipv6FirewallAddressListListWith(ctx context.Context, opts *Ipv6FirewallAddressList_ListOptions) ([]Ipv6FirewallAddressList, error) {
+	var filter *Ipv6FirewallAddressList_Filter
+	var proplist []string
+	if opts != nil {
+		filter = opts.Filter
+		for _, f := range opts.Proplist {
+			proplist = append(proplist, string(f))
+		}
+	}
+	query, err := listQuery(filter, proplist)
+	if err != nil {
+		return nil, fmt.Errorf("invalid options: %w", err)
+	}
+	body, err := c.doGET(ctx, "ipv6/firewall/address-list", "", query)
+	if err != nil {
+		return nil, fmt.Errorf("could not GET: %w", err)
+	}
+	defer body.Close()
+
+	var target []Ipv6FirewallAddressList
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return target, nil
+}
+
+// Ipv6FirewallAddressListFind returns all `ipv6/firewall/address-list` records matching the given filter, which may
+// be nil. The filter is sent as a print .query and evaluated by ROS. If
+// proplist is given, only the given fields of every record are returned.
+func (c *Client) Ipv6FirewallAddressListFind(ctx context.Context, filter *Ipv6FirewallAddressList_Filter, proplist ...Ipv6FirewallAddressList_Field) ([]Ipv6FirewallAddressList, error) {
+	var props []string
+	for _, f := range proplist {
+		props = append(props, string(f))
+	}
+	rdata, err := printQuery(filter, props)
+	if err != nil {
+		return nil, fmt.Errorf("invalid filter: %w", err)
+	}
+	body, err := c.doPOST(ctx, "ipv6/firewall/address-list", "print", rdata)
+	if err != nil {
+		return nil, fmt.Errorf("could not POST: %w", err)
+	}
+	defer body.Close()
+
+	var target []Ipv6FirewallAddressList
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return target, nil
+}
+
+// Ipv6FirewallAddressListPatch updates the given fields of a `ipv6/firewall/address-list` record by ID.
+func (c *Client) Ipv6FirewallAddressListPatch(ctx context.Context, id RecordID, u *Ipv6FirewallAddressList_Update) (*Ipv6FirewallAddressList, error) {
+	rdata, err := json.Marshal(u)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal update: %w", err)
+	}
+	body, err := c.doPATCH(ctx, "ipv6/firewall/address-list", id, rdata)
+	if err != nil {
+		return nil, fmt.Errorf("could not PATCH: %w", err)
+	}
+	defer body.Close()
+
+	var target Ipv6FirewallAddressList
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return &target, nil
+}
+
+// Ipv6FirewallAddressListGet returns a single `ipv6/firewall/address-list` record by ID.
+func (c *Client) Ipv6FirewallAddressListGet(ctx context.Context, id RecordID) (*Ipv6FirewallAddressList, error) {
+	body, err := c.doGET(ctx, "ipv6/firewall/address-list", id, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not GET: %w", err)
+	}
+	defer body.Close()
+
+	var target Ipv6FirewallAddressList
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return &target, nil
+}
+
+// Ipv6FirewallAddressListAdd creates a new `ipv6/firewall/address-list` record with the given fields set. Any unset
+// field will be populated by ROS with its default value.
+func (c *Client) Ipv6FirewallAddressListAdd(ctx context.Context, u *Ipv6FirewallAddressList_Update) (*Ipv6FirewallAddressList, error) {
+	rdata, err := json.Marshal(u)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal update: %w", err)
+	}
+	body, err := c.doPUT(ctx, "ipv6/firewall/address-list", rdata)
+	if err != nil {
+		return nil, fmt.Errorf("could not PUT: %w", err)
+	}
+	defer body.Close()
+
+	var target Ipv6FirewallAddressList
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return &target, nil
+}
+
+// Ipv6FirewallAddressListRemove deletes a `ipv6/firewall/address-list` record by ID.
+func (c *Client) Ipv6FirewallAddressListRemove(ctx context.Context, id RecordID) error {
+	body, err := c.doDELETE(ctx, "ipv6/firewall/address-list", id)
+	if err != nil {
+		return fmt.Errorf("could not DELETE: %w", err)
+	}
+	body.Close()
+	return nil
+}
+
+// Ipv6FirewallAddressList_Event is a change to a `ipv6/firewall/address-list` record observed by Ipv6FirewallAddressListWatch.
+type Ipv6FirewallAddressList_Event struct {
+	Type EventType
+	// Before is the record before the change, nil if Type is EventAdded.
+	Before *Ipv6FirewallAddressList
+	// After is the record after the change, nil if Type is EventRemoved.
+	After *Ipv6FirewallAddressList
+	// Err is the polling error if Type is EventError.
+	Err error
+}
+
+// Ipv6FirewallAddressListWatch polls the `ipv6/firewall/address-list` records every interval and sends an event for
+// every record added, modified or removed since the previous poll, starting
+// with an EventAdded for every existing record. Polling errors are sent as
+// EventError, and retried with backoff. The channel is closed once ctx is
+// canceled, or after a single EventError if interval is not positive.
+func (c *Client) Ipv6FirewallAddressListWatch(ctx context.Context, interval time.Duration) <-chan Ipv6FirewallAddressList_Event {
+	ch := make(chan Ipv6FirewallAddressList_Event)
+	list := func(ctx context.Context) ([]watchedRecord, error) {
+		records, err := c.Ipv6FirewallAddressListList(ctx)
+		if err != nil {
+			return nil, err
+		}
+		res := make([]watchedRecord, len(records))
+		for i := range records {
+			res[i] = watchedRecord{id: records[i].ID, record: &records[i]}
+		}
+		return res, nil
+	}
+	emit := func(t EventType, before, after interface{}, err error) bool {
+		ev := Ipv6FirewallAddressList_Event{Type: t, Err: err}
+		if before != nil {
+			ev.Before = before.(*Ipv6FirewallAddressList)
+		}
+		if after != nil {
+			ev.After = after.(*Ipv6FirewallAddressList)
+		}
+		select {
+		case ch <- ev:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+	go watch(ctx, interval, list, emit, func() { close(ch) })
+	return ch
+}
+
+// Ipv6FirewallAddressList_Schema describes the `ipv6/firewall/address-list` menu.
+var Ipv6FirewallAddressList_Schema = &MenuSchema{
+	Path:  "ipv6/firewall/address-list",
+	Table: true,
+	Key:   []string{"list", "address"},
+	Properties: []*PropertySchema{
+		{Name: "list", Kind: KindString},
+		{Name: "address", Kind: KindString},
+		{Name: "timeout", Kind: KindDuration},
+		{Name: "comment", Kind: KindString},
+		{Name: "disabled", Kind: KindBoolean},
+		{Name: "creation-time", Kind: KindString, ReadOnly: true},
+		{Name: "dynamic", Kind: KindBoolean, ReadOnly: true},
+	},
+}
+
+func init() {
+	registerMenu(Ipv6FirewallAddressList_Schema)
+}
@@ -0,0 +1,860 @@
+package ros
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Automatically generated by github.com/q3k/ros7api/gen, do not edit.
+
+// Ipv6FirewallFilter_Chain is the type of the `chain` property. Besides the values below, any
+// other value is accepted.
+type Ipv6FirewallFilter_Chain string
+
+const (
+	// Packets addressed to the router.
+	Ipv6FirewallFilter_ChainInput Ipv6FirewallFilter_Chain = "input"
+	// Packets routed through the router.
+	Ipv6FirewallFilter_ChainForward Ipv6FirewallFilter_Chain = "forward"
+	// Packets originating from the router.
+	Ipv6FirewallFilter_ChainOutput Ipv6FirewallFilter_Chain = "output"
+)
+
+// Values returns all values of Ipv6FirewallFilter_Chain known to this library.
+func (Ipv6FirewallFilter_Chain) Values() []Ipv6FirewallFilter_Chain {
+	return []Ipv6FirewallFilter_Chain{
+		Ipv6FirewallFilter_ChainInput,
+		Ipv6FirewallFilter_ChainForward,
+		Ipv6FirewallFilter_ChainOutput,
+	}
+}
+
+// IsKnown returns whether the value is known to this library.
+func (e Ipv6FirewallFilter_Chain) IsKnown() bool {
+	switch e {
+	case Ipv6FirewallFilter_ChainInput, Ipv6FirewallFilter_ChainForward, Ipv6FirewallFilter_ChainOutput:
+		return true
+	}
+	return false
+}
+
+func (e Ipv6FirewallFilter_Chain) String() string {
+	return string(e)
+}
+
+func (e *Ipv6FirewallFilter_Chain) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*e = Ipv6FirewallFilter_Chain(s)
+	return nil
+}
+
+func (e Ipv6FirewallFilter_Chain) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(e))
+}
+
+// Ipv6FirewallFilter_Action is the type of the `action` property. Values not known to this library
+// (eg. introduced in newer ROS versions) are kept as-is, but reported
+// through UnknownEnumHandler.
+type Ipv6FirewallFilter_Action string
+
+const (
+	// Stop processing the table and accept the packet.
+	Ipv6FirewallFilter_ActionAccept Ipv6FirewallFilter_Action = "accept"
+	// Add the destination address to the address list given by address-list.
+	Ipv6FirewallFilter_ActionAddDstToAddressList Ipv6FirewallFilter_Action = "add-dst-to-address-list"
+	// Add the source address to the address list given by address-list.
+	Ipv6FirewallFilter_ActionAddSrcToAddressList Ipv6FirewallFilter_Action = "add-src-to-address-list"
+	// Silently drop the packet.
+	Ipv6FirewallFilter_ActionDrop Ipv6FirewallFilter_Action = "drop"
+	// Bypass the firewall for further packets of the connection.
+	Ipv6FirewallFilter_ActionFasttrackConnection Ipv6FirewallFilter_Action = "fasttrack-connection"
+	// Continue in the chain given by jump-target.
+	Ipv6FirewallFilter_ActionJump Ipv6FirewallFilter_Action = "jump"
+	// Log the packet and continue with the next rule.
+	Ipv6FirewallFilter_ActionLog Ipv6FirewallFilter_Action = "log"
+	// Only count the packet and continue with the next rule.
+	Ipv6FirewallFilter_ActionPassthrough Ipv6FirewallFilter_Action = "passthrough"
+	// Drop the packet and reply with an ICMP or TCP reset packet, as given by reject-with.
+	Ipv6FirewallFilter_ActionReject Ipv6FirewallFilter_Action = "reject"
+	// Return to the rule after the jump which led to this chain.
+	Ipv6FirewallFilter_ActionReturn Ipv6FirewallFilter_Action = "return"
+)
+
+// Values returns all values of Ipv6FirewallFilter_Action known to this library.
+func (Ipv6FirewallFilter_Action) Values() []Ipv6FirewallFilter_Action {
+	return []Ipv6FirewallFilter_Action{
+		Ipv6FirewallFilter_ActionAccept,
+		Ipv6FirewallFilter_ActionAddDstToAddressList,
+		Ipv6FirewallFilter_ActionAddSrcToAddressList,
+		Ipv6FirewallFilter_ActionDrop,
+		Ipv6FirewallFilter_ActionFasttrackConnection,
+		Ipv6FirewallFilter_ActionJump,
+		Ipv6FirewallFilter_ActionLog,
+		Ipv6FirewallFilter_ActionPassthrough,
+		Ipv6FirewallFilter_ActionReject,
+		Ipv6FirewallFilter_ActionReturn,
+	}
+}
+
+// IsKnown returns whether the value is known to this library.
+func (e Ipv6FirewallFilter_Action) IsKnown() bool {
+	switch e {
+	case Ipv6FirewallFilter_ActionAccept, Ipv6FirewallFilter_ActionAddDstToAddressList, Ipv6FirewallFilter_ActionAddSrcToAddressList, Ipv6FirewallFilter_ActionDrop, Ipv6FirewallFilter_ActionFasttrackConnection, Ipv6FirewallFilter_ActionJump, Ipv6FirewallFilter_ActionLog, Ipv6FirewallFilter_ActionPassthrough, Ipv6FirewallFilter_ActionReject, Ipv6FirewallFilter_ActionReturn:
+		return true
+	}
+	return false
+}
+
+func (e Ipv6FirewallFilter_Action) String() string {
+	return string(e)
+}
+
+func (e *Ipv6FirewallFilter_Action) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*e = Ipv6FirewallFilter_Action(s)
+	if s != "" && !e.IsKnown() {
+		reportUnknownEnum("Ipv6FirewallFilter_Action", s)
+	}
+	return nil
+}
+
+func (e Ipv6FirewallFilter_Action) MarshalJSON() ([]byte, error) {
+	if e != "" && !e.IsKnown() {
+		reportUnknownEnum("Ipv6FirewallFilter_Action", string(e))
+	}
+	return json.Marshal(string(e))
+}
+
+// Ipv6FirewallFilter represents a ROS `ipv6/firewall/filter` record, including read-only fields.
+//
+// IPv6 firewall filter rules, which decide whether packets are accepted or dropped. Rules are evaluated in order.
+type Ipv6FirewallFilter struct {
+	Record
+
+	// Chain the rule belongs to: one of the built-in chains, or a user-defined one which is jumped to.
+	Chain Ipv6FirewallFilter_Chain `json:"chain"`
+	// Action taken on matched packets.
+	Action Ipv6FirewallFilter_Action `json:"action"`
+	// Reply sent for the reject action, eg. icmp-network-unreachable or tcp-reset.
+	RejectWith string `json:"reject-with"`
+	// Source address, prefix or range to match, optionally negated with a ! prefix, eg. 10.0.0.0/8.
+	SrcAddress string `json:"src-address"`
+	// Destination address, prefix or range to match, optionally negated with a ! prefix, eg. 10.0.0.0/8.
+	DstAddress string `json:"dst-address"`
+	// Address list the source address must be on, optionally negated with a ! prefix.
+	SrcAddressList string `json:"src-address-list"`
+	// Address list the destination address must be on, optionally negated with a ! prefix.
+	DstAddressList string `json:"dst-address-list"`
+	// IP protocol to match. Protocols not listed can be given by name or number.
+	Protocol FirewallRule_Protocol `json:"protocol"`
+	// Source ports and port ranges to match, eg. 1024-65535. Requires protocol to be tcp, udp, udp-lite or sctp.
+	SrcPort PortList `json:"src-port"`
+	// Destination ports and port ranges to match, eg. 80,443. Requires protocol to be tcp, udp, udp-lite or sctp.
+	DstPort PortList `json:"dst-port"`
+	// Ports and port ranges to match against either the source or the destination port.
+	Port PortList `json:"port"`
+	// Interface the packet entered the router through, optionally negated with a ! prefix.
+	InInterface string `json:"in-interface"`
+	// Interface the packet leaves the router through, optionally negated with a ! prefix.
+	OutInterface string `json:"out-interface"`
+	// Interface list the input interface must be on, optionally negated with a ! prefix.
+	InInterfaceList string `json:"in-interface-list"`
+	// Interface list the output interface must be on, optionally negated with a ! prefix.
+	OutInterfaceList string `json:"out-interface-list"`
+	// Packet mark to match, as set by mangle rules.
+	PacketMark string `json:"packet-mark"`
+	// Routing mark to match, as set by mangle rules.
+	RoutingMark string `json:"routing-mark"`
+	// TCP flags to match, each optionally negated with a ! prefix, eg. syn,!ack.
+	TcpFlags string `json:"tcp-flags"`
+	// ICMP type and code to match, eg. 8:0.
+	ICMPOptions string `json:"icmp-options"`
+	// Rate limit above which packets stop matching, as count,time,burst, eg. 10,1s,5.
+	Limit string `json:"limit"`
+	// Whether to log matched packets.
+	Log Boolean `json:"log"`
+	// Prefix of the log messages of matched packets.
+	LogPrefix string `json:"log-prefix"`
+	// Chain to continue in, for the jump action.
+	JumpTarget string `json:"jump-target"`
+	// Address list to add the address to, for the add-src-to-address-list and add-dst-to-address-list actions.
+	AddressList string `json:"address-list"`
+	// How long the address is kept on the address list: a duration, none-dynamic or none-static.
+	AddressListTimeout string `json:"address-list-timeout"`
+	// Short description of the rule.
+	Comment string `json:"comment"`
+	// Whether the rule is disabled.
+	Disabled Boolean `json:"disabled"`
+	// Number of bytes matched by the rule.
+	Bytes Number `json:"bytes"`
+	// Number of packets matched by the rule.
+	Packets Number `json:"packets"`
+	// Whether the rule was added dynamically, eg. by a service.
+	Dynamic Boolean `json:"dynamic"`
+	// Whether the rule is invalid, eg. because it refers to an interface that doesn't exist.
+	Invalid Boolean `json:"invalid"`
+	// Connection tracking states to match, eg. established,related.
+	ConnectionState FirewallConntrack_ConnectionStateList `json:"connection-state"`
+	// NAT states of the connection to match.
+	ConnectionNatState FirewallConntrack_ConnectionNatStateList `json:"connection-nat-state"`
+	// Connection mark to match, as set by mangle rules.
+	ConnectionMark string `json:"connection-mark"`
+}
+
+// Ipv6FirewallFilter_Update is an update to a ROS `ipv6/firewall/filter` record. Any unset field will not be updated.
+type Ipv6FirewallFilter_Update struct {
+	// Chain the rule belongs to: one of the built-in chains, or a user-defined one which is jumped to.
+	Chain *Ipv6FirewallFilter_Chain `json:"chain,omitempty"`
+	// Action taken on matched packets.
+	Action *Ipv6FirewallFilter_Action `json:"action,omitempty"`
+	// Reply sent for the reject action, eg. icmp-network-unreachable or tcp-reset.
+	RejectWith *string `json:"reject-with,omitempty"`
+	// Source address, prefix or range to match, optionally negated with a ! prefix, eg. 10.0.0.0/8.
+	SrcAddress *string `json:"src-address,omitempty"`
+	// Destination address, prefix or range to match, optionally negated with a ! prefix, eg. 10.0.0.0/8.
+	DstAddress *string `json:"dst-address,omitempty"`
+	// Address list the source address must be on, optionally negated with a ! prefix.
+	SrcAddressList *string `json:"src-address-list,omitempty"`
+	// Address list the destination address must be on, optionally negated with a ! prefix.
+	DstAddressList *string `json:"dst-address-list,omitempty"`
+	// IP protocol to match. Protocols not listed can be given by name or number.
+	Protocol *FirewallRule_Protocol `json:"protocol,omitempty"`
+	// Source ports and port ranges to match, eg. 1024-65535. Requires protocol to be tcp, udp, udp-lite or sctp.
+	SrcPort *PortList `json:"src-port,omitempty"`
+	// Destination ports and port ranges to match, eg. 80,443. Requires protocol to be tcp, udp, udp-lite or sctp.
+	DstPort *PortList `json:"dst-port,omitempty"`
+	// Ports and port ranges to match against either the source or the destination port.
+	Port *PortList `json:"port,omitempty"`
+	// Interface the packet entered the router through, optionally negated with a ! prefix.
+	InInterface *string `json:"in-interface,omitempty"`
+	// Interface the packet leaves the router through, optionally negated with a ! prefix.
+	OutInterface *string `json:"out-interface,omitempty"`
+	// Interface list the input interface must be on, optionally negated with a ! prefix.
+	InInterfaceList *string `json:"in-interface-list,omitempty"`
+	// Interface list the output interface must be on, optionally negated with a ! prefix.
+	OutInterfaceList *string `json:"out-interface-list,omitempty"`
+	// Packet mark to match, as set by mangle rules.
+	PacketMark *string `json:"packet-mark,omitempty"`
+	// Routing mark to match, as set by mangle rules.
+	RoutingMark *string `json:"routing-mark,omitempty"`
+	// TCP flags to match, each optionally negated with a ! prefix, eg. syn,!ack.
+	TcpFlags *string `json:"tcp-flags,omitempty"`
+	// ICMP type and code to match, eg. 8:0.
+	ICMPOptions *string `json:"icmp-options,omitempty"`
+	// Rate limit above which packets stop matching, as count,time,burst, eg. 10,1s,5.
+	Limit *string `json:"limit,omitempty"`
+	// Whether to log matched packets.
+	Log *Boolean `json:"log,omitempty"`
+	// Prefix of the log messages of matched packets.
+	LogPrefix *string `json:"log-prefix,omitempty"`
+	// Chain to continue in, for the jump action.
+	JumpTarget *string `json:"jump-target,omitempty"`
+	// Address list to add the address to, for the add-src-to-address-list and add-dst-to-address-list actions.
+	AddressList *string `json:"address-list,omitempty"`
+	// How long the address is kept on the address list: a duration, none-dynamic or none-static.
+	AddressListTimeout *string `json:"address-list-timeout,omitempty"`
+	// Short description of the rule.
+	Comment *string `json:"comment,omitempty"`
+	// Whether the rule is disabled.
+	Disabled *Boolean `json:"disabled,omitempty"`
+	// Connection tracking states to match, eg. established,related.
+	ConnectionState *FirewallConntrack_ConnectionStateList `json:"connection-state,omitempty"`
+	// NAT states of the connection to match.
+	ConnectionNatState *FirewallConntrack_ConnectionNatStateList `json:"connection-nat-state,omitempty"`
+	// Connection mark to match, as set by mangle rules.
+	ConnectionMark *string `json:"connection-mark,omitempty"`
+}
+
+// ToUpdate returns an update setting all settable fields of a `ipv6/firewall/filter` record to
+// their values in r. Fields unset in r which ROS doesn't accept empty (eg.
+// addresses, port lists or enums) are left out, so that listed records can be
+// written back. List values are shared between r and the update.
+func (r *Ipv6FirewallFilter) ToUpdate() *Ipv6FirewallFilter_Update {
+	u := &Ipv6FirewallFilter_Update{}
+	if r.Chain != "" {
+		u.Chain = new(Ipv6FirewallFilter_Chain)
+		*u.Chain = r.Chain
+	}
+	if r.Action != "" {
+		u.Action = new(Ipv6FirewallFilter_Action)
+		*u.Action = r.Action
+	}
+	u.RejectWith = new(string)
+	*u.RejectWith = r.RejectWith
+	u.SrcAddress = new(string)
+	*u.SrcAddress = r.SrcAddress
+	u.DstAddress = new(string)
+	*u.DstAddress = r.DstAddress
+	u.SrcAddressList = new(string)
+	*u.SrcAddressList = r.SrcAddressList
+	u.DstAddressList = new(string)
+	*u.DstAddressList = r.DstAddressList
+	if r.Protocol != "" {
+		u.Protocol = new(FirewallRule_Protocol)
+		*u.Protocol = r.Protocol
+	}
+	if !r.SrcPort.Equal(PortList{}) {
+		u.SrcPort = new(PortList)
+		*u.SrcPort = r.SrcPort
+	}
+	if !r.DstPort.Equal(PortList{}) {
+		u.DstPort = new(PortList)
+		*u.DstPort = r.DstPort
+	}
+	if !r.Port.Equal(PortList{}) {
+		u.Port = new(PortList)
+		*u.Port = r.Port
+	}
+	u.InInterface = new(string)
+	*u.InInterface = r.InInterface
+	u.OutInterface = new(string)
+	*u.OutInterface = r.OutInterface
+	u.InInterfaceList = new(string)
+	*u.InInterfaceList = r.InInterfaceList
+	u.OutInterfaceList = new(string)
+	*u.OutInterfaceList = r.OutInterfaceList
+	u.PacketMark = new(string)
+	*u.PacketMark = r.PacketMark
+	u.RoutingMark = new(string)
+	*u.RoutingMark = r.RoutingMark
+	u.TcpFlags = new(string)
+	*u.TcpFlags = r.TcpFlags
+	u.ICMPOptions = new(string)
+	*u.ICMPOptions = r.ICMPOptions
+	u.Limit = new(string)
+	*u.Limit = r.Limit
+	u.Log = new(Boolean)
+	*u.Log = r.Log
+	u.LogPrefix = new(string)
+	*u.LogPrefix = r.LogPrefix
+	u.JumpTarget = new(string)
+	*u.JumpTarget = r.JumpTarget
+	u.AddressList = new(string)
+	*u.AddressList = r.AddressList
+	u.AddressListTimeout = new(string)
+	*u.AddressListTimeout = r.AddressListTimeout
+	u.Comment = new(string)
+	*u.Comment = r.Comment
+	u.Disabled = new(Boolean)
+	*u.Disabled = r.Disabled
+	if r.ConnectionState.Not || len(r.ConnectionState.Values) != 0 {
+		u.ConnectionState = new(FirewallConntrack_ConnectionStateList)
+		*u.ConnectionState = r.ConnectionState
+	}
+	if r.ConnectionNatState.Not || len(r.ConnectionNatState.Values) != 0 {
+		u.ConnectionNatState = new(FirewallConntrack_ConnectionNatStateList)
+		*u.ConnectionNatState = r.ConnectionNatState
+	}
+	u.ConnectionMark = new(string)
+	*u.ConnectionMark = r.ConnectionMark
+	return u
+}
+
+// DiffIpv6FirewallFilter returns an update which changes current into desired, setting only
+// the settable fields that differ between them. Read-only fields, and fields
+// left out by ToUpdate when unset in desired, are ignored. The update IsEmpty if
+// there is nothing to change.
+func DiffIpv6FirewallFilter(current, desired *Ipv6FirewallFilter) *Ipv6FirewallFilter_Update {
+	u := &Ipv6FirewallFilter_Update{}
+	if (desired.Chain != "") && current.Chain != desired.Chain {
+		u.Chain = new(Ipv6FirewallFilter_Chain)
+		*u.Chain = desired.Chain
+	}
+	if (desired.Action != "") && current.Action != desired.Action {
+		u.Action = new(Ipv6FirewallFilter_Action)
+		*u.Action = desired.Action
+	}
+	if current.RejectWith != desired.RejectWith {
+		u.RejectWith = new(string)
+		*u.RejectWith = desired.RejectWith
+	}
+	if current.SrcAddress != desired.SrcAddress {
+		u.SrcAddress = new(string)
+		*u.SrcAddress = desired.SrcAddress
+	}
+	if current.DstAddress != desired.DstAddress {
+		u.DstAddress = new(string)
+		*u.DstAddress = desired.DstAddress
+	}
+	if current.SrcAddressList != desired.SrcAddressList {
+		u.SrcAddressList = new(string)
+		*u.SrcAddressList = desired.SrcAddressList
+	}
+	if current.DstAddressList != desired.DstAddressList {
+		u.DstAddressList = new(string)
+		*u.DstAddressList = desired.DstAddressList
+	}
+	if (desired.Protocol != "") && current.Protocol != desired.Protocol {
+		u.Protocol = new(FirewallRule_Protocol)
+		*u.Protocol = desired.Protocol
+	}
+	if (!desired.SrcPort.Equal(PortList{})) && !current.SrcPort.Equal(desired.SrcPort) {
+		u.SrcPort = new(PortList)
+		*u.SrcPort = desired.SrcPort
+	}
+	if (!desired.DstPort.Equal(PortList{})) && !current.DstPort.Equal(desired.DstPort) {
+		u.DstPort = new(PortList)
+		*u.DstPort = desired.DstPort
+	}
+	if (!desired.Port.Equal(PortList{})) && !current.Port.Equal(desired.Port) {
+		u.Port = new(PortList)
+		*u.Port = desired.Port
+	}
+	if current.InInterface != desired.InInterface {
+		u.InInterface = new(string)
+		*u.InInterface = desired.InInterface
+	}
+	if current.OutInterface != desired.OutInterface {
+		u.OutInterface = new(string)
+		*u.OutInterface = desired.OutInterface
+	}
+	if current.InInterfaceList != desired.InInterfaceList {
+		u.InInterfaceList = new(string)
+		*u.InInterfaceList = desired.InInterfaceList
+	}
+	if current.OutInterfaceList != desired.OutInterfaceList {
+		u.OutInterfaceList = new(string)
+		*u.OutInterfaceList = desired.OutInterfaceList
+	}
+	if current.PacketMark != desired.PacketMark {
+		u.PacketMark = new(string)
+		*u.PacketMark = desired.PacketMark
+	}
+	if current.RoutingMark != desired.RoutingMark {
+		u.RoutingMark = new(string)
+		*u.RoutingMark = desired.RoutingMark
+	}
+	if current.TcpFlags != desired.TcpFlags {
+		u.TcpFlags = new(string)
+		*u.TcpFlags = desired.TcpFlags
+	}
+	if current.ICMPOptions != desired.ICMPOptions {
+		u.ICMPOptions = new(string)
+		*u.ICMPOptions = desired.ICMPOptions
+	}
+	if current.Limit != desired.Limit {
+		u.Limit = new(string)
+		*u.Limit = desired.Limit
+	}
+	if current.Log != desired.Log {
+		u.Log = new(Boolean)
+		*u.Log = desired.Log
+	}
+	if current.LogPrefix != desired.LogPrefix {
+		u.LogPrefix = new(string)
+		*u.LogPrefix = desired.LogPrefix
+	}
+	if current.JumpTarget != desired.JumpTarget {
+		u.JumpTarget = new(string)
+		*u.JumpTarget = desired.JumpTarget
+	}
+	if current.AddressList != desired.AddressList {
+		u.AddressList = new(string)
+		*u.AddressList = desired.AddressList
+	}
+	if current.AddressListTimeout != desired.AddressListTimeout {
+		u.AddressListTimeout = new(string)
+		*u.AddressListTimeout = desired.AddressListTimeout
+	}
+	if current.Comment != desired.Comment {
+		u.Comment = new(string)
+		*u.Comment = desired.Comment
+	}
+	if current.Disabled != desired.Disabled {
+		u.Disabled = new(Boolean)
+		*u.Disabled = desired.Disabled
+	}
+	if (desired.ConnectionState.Not || len(desired.ConnectionState.Values) != 0) && !current.ConnectionState.Equal(desired.ConnectionState) {
+		u.ConnectionState = new(FirewallConntrack_ConnectionStateList)
+		*u.ConnectionState = desired.ConnectionState
+	}
+	if (desired.ConnectionNatState.Not || len(desired.ConnectionNatState.Values) != 0) && !current.ConnectionNatState.Equal(desired.ConnectionNatState) {
+		u.ConnectionNatState = new(FirewallConntrack_ConnectionNatStateList)
+		*u.ConnectionNatState = desired.ConnectionNatState
+	}
+	if current.ConnectionMark != desired.ConnectionMark {
+		u.ConnectionMark = new(string)
+		*u.ConnectionMark = desired.ConnectionMark
+	}
+	return u
+}
+
+// IsEmpty returns whether the update does not set any field, ie. applying it
+// would be a no-op.
+func (u *Ipv6FirewallFilter_Update) IsEmpty() bool {
+	return u.Chain == nil &&
+		u.Action == nil &&
+		u.RejectWith == nil &&
+		u.SrcAddress == nil &&
+		u.DstAddress == nil &&
+		u.SrcAddressList == nil &&
+		u.DstAddressList == nil &&
+		u.Protocol == nil &&
+		u.SrcPort == nil &&
+		u.DstPort == nil &&
+		u.Port == nil &&
+		u.InInterface == nil &&
+		u.OutInterface == nil &&
+		u.InInterfaceList == nil &&
+		u.OutInterfaceList == nil &&
+		u.PacketMark == nil &&
+		u.RoutingMark == nil &&
+		u.TcpFlags == nil &&
+		u.ICMPOptions == nil &&
+		u.Limit == nil &&
+		u.Log == nil &&
+		u.LogPrefix == nil &&
+		u.JumpTarget == nil &&
+		u.AddressList == nil &&
+		u.AddressListTimeout == nil &&
+		u.Comment == nil &&
+		u.Disabled == nil &&
+		u.ConnectionState == nil &&
+		u.ConnectionNatState == nil &&
+		u.ConnectionMark == nil
+}
+
+// Ipv6FirewallFilter_Field is the name of a `ipv6/firewall/filter` record property, for use in .proplist
+// projections.
+type Ipv6FirewallFilter_Field string
+
+const (
+	Ipv6FirewallFilter_FieldID                 Ipv6FirewallFilter_Field = ".id"
+	Ipv6FirewallFilter_FieldChain              Ipv6FirewallFilter_Field = "chain"
+	Ipv6FirewallFilter_FieldAction             Ipv6FirewallFilter_Field = "action"
+	Ipv6FirewallFilter_FieldRejectWith         Ipv6FirewallFilter_Field = "reject-with"
+	Ipv6FirewallFilter_FieldSrcAddress         Ipv6FirewallFilter_Field = "src-address"
+	Ipv6FirewallFilter_FieldDstAddress         Ipv6FirewallFilter_Field = "dst-address"
+	Ipv6FirewallFilter_FieldSrcAddressList     Ipv6FirewallFilter_Field = "src-address-list"
+	Ipv6FirewallFilter_FieldDstAddressList     Ipv6FirewallFilter_Field = "dst-address-list"
+	Ipv6FirewallFilter_FieldProtocol           Ipv6FirewallFilter_Field = "protocol"
+	Ipv6FirewallFilter_FieldSrcPort            Ipv6FirewallFilter_Field = "src-port"
+	Ipv6FirewallFilter_FieldDstPort            Ipv6FirewallFilter_Field = "dst-port"
+	Ipv6FirewallFilter_FieldPort               Ipv6FirewallFilter_Field = "port"
+	Ipv6FirewallFilter_FieldInInterface        Ipv6FirewallFilter_Field = "in-interface"
+	Ipv6FirewallFilter_FieldOutInterface       Ipv6FirewallFilter_Field = "out-interface"
+	Ipv6FirewallFilter_FieldInInterfaceList    Ipv6FirewallFilter_Field = "in-interface-list"
+	Ipv6FirewallFilter_FieldOutInterfaceList   Ipv6FirewallFilter_Field = "out-interface-list"
+	Ipv6FirewallFilter_FieldPacketMark         Ipv6FirewallFilter_Field = "packet-mark"
+	Ipv6FirewallFilter_FieldRoutingMark        Ipv6FirewallFilter_Field = "routing-mark"
+	Ipv6FirewallFilter_FieldTcpFlags           Ipv6FirewallFilter_Field = "tcp-flags"
+	Ipv6FirewallFilter_FieldICMPOptions        Ipv6FirewallFilter_Field = "icmp-options"
+	Ipv6FirewallFilter_FieldLimit              Ipv6FirewallFilter_Field = "limit"
+	Ipv6FirewallFilter_FieldLog                Ipv6FirewallFilter_Field = "log"
+	Ipv6FirewallFilter_FieldLogPrefix          Ipv6FirewallFilter_Field = "log-prefix"
+	Ipv6FirewallFilter_FieldJumpTarget         Ipv6FirewallFilter_Field = "jump-target"
+	Ipv6FirewallFilter_FieldAddressList        Ipv6FirewallFilter_Field = "address-list"
+	Ipv6FirewallFilter_FieldAddressListTimeout Ipv6FirewallFilter_Field = "address-list-timeout"
+	Ipv6FirewallFilter_FieldComment            Ipv6FirewallFilter_Field = "comment"
+	Ipv6FirewallFilter_FieldDisabled           Ipv6FirewallFilter_Field = "disabled"
+	Ipv6FirewallFilter_FieldBytes              Ipv6FirewallFilter_Field = "bytes"
+	Ipv6FirewallFilter_FieldPackets            Ipv6FirewallFilter_Field = "packets"
+	Ipv6FirewallFilter_FieldDynamic            Ipv6FirewallFilter_Field = "dynamic"
+	Ipv6FirewallFilter_FieldInvalid            Ipv6FirewallFilter_Field = "invalid"
+	Ipv6FirewallFilter_FieldConnectionState    Ipv6FirewallFilter_Field = "connection-state"
+	Ipv6FirewallFilter_FieldConnectionNatState Ipv6FirewallFilter_Field = "connection-nat-state"
+	Ipv6FirewallFilter_FieldConnectionMark     Ipv6FirewallFilter_Field = "connection-mark"
+)
+
+// Ipv6FirewallFilter_Filter is an equality filter on `ipv6/firewall/filter` records, evaluated by ROS.
+// Any unset field will not be filtered on.
+type Ipv6FirewallFilter_Filter struct {
+	ID                 *RecordID                                 `json:".id,omitempty"`
+	Chain              *Ipv6FirewallFilter_Chain                 `json:"chain,omitempty"`
+	Action             *Ipv6FirewallFilter_Action                `json:"action,omitempty"`
+	RejectWith         *string                                   `json:"reject-with,omitempty"`
+	SrcAddress         *string                                   `json:"src-address,omitempty"`
+	DstAddress         *string                                   `json:"dst-address,omitempty"`
+	SrcAddressList     *string                                   `json:"src-address-list,omitempty"`
+	DstAddressList     *string                                   `json:"dst-address-list,omitempty"`
+	Protocol           *FirewallRule_Protocol                    `json:"protocol,omitempty"`
+	SrcPort            *PortList                                 `json:"src-port,omitempty"`
+	DstPort            *PortList                                 `json:"dst-port,omitempty"`
+	Port               *PortList                                 `json:"port,omitempty"`
+	InInterface        *string                                   `json:"in-interface,omitempty"`
+	OutInterface       *string                                   `json:"out-interface,omitempty"`
+	InInterfaceList    *string                                   `json:"in-interface-list,omitempty"`
+	OutInterfaceList   *string                                   `json:"out-interface-list,omitempty"`
+	PacketMark         *string                                   `json:"packet-mark,omitempty"`
+	RoutingMark        *string                                   `json:"routing-mark,omitempty"`
+	TcpFlags           *string                                   `json:"tcp-flags,omitempty"`
+	ICMPOptions        *string                                   `json:"icmp-options,omitempty"`
+	Limit              *string                                   `json:"limit,omitempty"`
+	Log                *Boolean                                  `json:"log,omitempty"`
+	LogPrefix          *string                                   `json:"log-prefix,omitempty"`
+	JumpTarget         *string                                   `json:"jump-target,omitempty"`
+	AddressList        *string                                   `json:"address-list,omitempty"`
+	AddressListTimeout *string                                   `json:"address-list-timeout,omitempty"`
+	Comment            *string                                   `json:"comment,omitempty"`
+	Disabled           *Boolean                                  `json:"disabled,omitempty"`
+	Bytes              *Number                                   `json:"bytes,omitempty"`
+	Packets            *Number                                   `json:"packets,omitempty"`
+	Dynamic            *Boolean                                  `json:"dynamic,omitempty"`
+	Invalid            *Boolean                                  `json:"invalid,omitempty"`
+	ConnectionState    *FirewallConntrack_ConnectionStateList    `json:"connection-state,omitempty"`
+	ConnectionNatState *FirewallConntrack_ConnectionNatStateList `json:"connection-nat-state,omitempty"`
+	ConnectionMark     *string                                   `json:"connection-mark,omitempty"`
+}
+
+// Ipv6FirewallFilter_ListOptions limits the records and fields returned by Ipv6FirewallFilterListWith.
+type Ipv6FirewallFilter_ListOptions struct {
+	// Filter, if set, only returns records matching all set fields.
+	Filter *Ipv6FirewallFilter_Filter
+	// Proplist, if set, only returns the given fields of every record.
+	Proplist []Ipv6FirewallFilter_Field
+}
+
+// Ipv6FirewallFilterList returns a list of all `ipv6/firewall/filter` records.
+func (c *Client) Ipv6FirewallFilterList(ctx context.Context) ([]Ipv6FirewallFilter, error) {
+	return c.Ipv6FirewallFilterListWith(ctx, nil)
+}
+
+// Ipv6FirewallFilterListWith returns a list of all `ipv6/firewall/filter` records, optionally filtered
+// and projected by ROS according to the given options, which may be nil.
+func (c *Client) Ipv6FirewallFilterListWith(ctx context.Context, opts *Ipv6FirewallFilter_ListOptions) ([]Ipv6FirewallFilter, error) {
+	var filter *Ipv6FirewallFilter_Filter
+	var proplist []string
+	if opts != nil {
+		filter = opts.Filter
+		for _, f := range opts.Proplist {
+			proplist = append(proplist, string(f))
+		}
+	}
+	query, err := listQuery(filter, proplist)
+	if err != nil {
+		return nil, fmt.Errorf("invalid options: %w", err)
+	}
+	body, err := c.doGET(ctx, "ipv6/firewall/filter", "", query)
+	if err != nil {
+		return nil, fmt.Errorf("could not GET: %w", err)
+	}
+	defer body.Close()
+
+	var target []Ipv6FirewallFilter
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return target, nil
+}
+
+// Ipv6FirewallFilterFind returns all `ipv6/firewall/filter` records matching the given filter, which may
+// be nil. The filter is sent as a print .query and evaluated by ROS. If
+// proplist is given, only the given fields of every record are returned.
+func (c *Client) Ipv6FirewallFilterFind(ctx context.Context, filter *Ipv6FirewallFilter_Filter, proplist ...Ipv6FirewallFilter_Field) ([]Ipv6FirewallFilter, error) {
+	var props []string
+	for _, f := range proplist {
+		props = append(props, string(f))
+	}
+	rdata, err := printQuery(filter, props)
+	if err != nil {
+		return nil, fmt.Errorf("invalid filter: %w", err)
+	}
+	body, err := c.doPOST(ctx, "ipv6/firewall/filter", "print", rdata)
+	if err != nil {
+		return nil, fmt.Errorf("could not POST: %w", err)
+	}
+	defer body.Close()
+
+	var target []Ipv6FirewallFilter
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return target, nil
+}
+
+// Ipv6FirewallFilterPatch updates the given fields of a `ipv6/firewall/filter` record by ID.
+func (c *Client) Ipv6FirewallFilterPatch(ctx context.Context, id RecordID, u *Ipv6FirewallFilter_Update) (*Ipv6FirewallFilter, error) {
+	rdata, err := json.Marshal(u)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal update: %w", err)
+	}
+	body, err := c.doPATCH(ctx, "ipv6/firewall/filter", id, rdata)
+	if err != nil {
+		return nil, fmt.Errorf("could not PATCH: %w", err)
+	}
+	defer body.Close()
+
+	var target Ipv6FirewallFilter
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return &target, nil
+}
+
+// Ipv6FirewallFilterGet returns a single `ipv6/firewall/filter` record by ID.
+func (c *Client) Ipv6FirewallFilterGet(ctx context.Context, id RecordID) (*Ipv6FirewallFilter, error) {
+	body, err := c.doGET(ctx, "ipv6/firewall/filter", id, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not GET: %w", err)
+	}
+	defer body.Close()
+
+	var target Ipv6FirewallFilter
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return &target, nil
+}
+
+// Ipv6FirewallFilterAdd creates a new `ipv6/firewall/filter` record with the given fields set. Any unset
+// field will be populated by ROS with its default value.
+func (c *Client) Ipv6FirewallFilterAdd(ctx context.Context, u *Ipv6FirewallFilter_Update) (*Ipv6FirewallFilter, error) {
+	rdata, err := json.Marshal(u)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal update: %w", err)
+	}
+	body, err := c.doPUT(ctx, "ipv6/firewall/filter", rdata)
+	if err != nil {
+		return nil, fmt.Errorf("could not PUT: %w", err)
+	}
+	defer body.Close()
+
+	var target Ipv6FirewallFilter
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return &target, nil
+}
+
+// Ipv6FirewallFilterRemove deletes a `ipv6/firewall/filter` record by ID.
+func (c *Client) Ipv6FirewallFilterRemove(ctx context.Context, id RecordID) error {
+	body, err := c.doDELETE(ctx, "ipv6/firewall/filter", id)
+	if err != nil {
+		return fmt.Errorf("could not DELETE: %w", err)
+	}
+	body.Close()
+	return nil
+}
+
+// Ipv6FirewallFilterAddBefore creates a new `ipv6/firewall/filter` record with the given fields set,
+// placed before the record with the given ID. Any unset field will be
+// populated by ROS with its default value.
+func (c *Client) Ipv6FirewallFilterAddBefore(ctx context.Context, u *Ipv6FirewallFilter_Update, before RecordID) (*Ipv6FirewallFilter, error) {
+	rdata, err := json.Marshal(struct {
+		*Ipv6FirewallFilter_Update
+		PlaceBefore RecordID `json:"place-before"`
+	}{u, before})
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal update: %w", err)
+	}
+	body, err := c.doPUT(ctx, "ipv6/firewall/filter", rdata)
+	if err != nil {
+		return nil, fmt.Errorf("could not PUT: %w", err)
+	}
+	defer body.Close()
+
+	var target Ipv6FirewallFilter
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return &target, nil
+}
+
+// Ipv6FirewallFilterMove moves the `ipv6/firewall/filter` records with the given IDs, in the given
+// order, before the record with ID before, or to the end if before is empty.
+func (c *Client) Ipv6FirewallFilterMove(ctx context.Context, ids []RecordID, before RecordID) error {
+	return c.MoveRows(ctx, "ipv6/firewall/filter", ids, before)
+}
+
+// Ipv6FirewallFilter_Event is a change to a `ipv6/firewall/filter` record observed by Ipv6FirewallFilterWatch.
+type Ipv6FirewallFilter_Event struct {
+	Type EventType
+	// Before is the record before the change, nil if Type is EventAdded.
+	Before *Ipv6FirewallFilter
+	// After is the record after the change, nil if Type is EventRemoved.
+	After *Ipv6FirewallFilter
+	// Err is the polling error if Type is EventError.
+	Err error
+}
+
+// Ipv6FirewallFilterWatch polls the `ipv6/firewall/filter` records every interval and sends an event for
+// every record added, modified or removed since the previous poll, starting
+// with an EventAdded for every existing record. Polling errors are sent as
+// EventError, and retried with backoff. The channel is closed once ctx is
+// canceled, or after a single EventError if interval is not positive.
+func (c *Client) Ipv6FirewallFilterWatch(ctx context.Context, interval time.Duration) <-chan Ipv6FirewallFilter_Event {
+	ch := make(chan Ipv6FirewallFilter_Event)
+	list := func(ctx context.Context) ([]watchedRecord, error) {
+		records, err := c.Ipv6FirewallFilterList(ctx)
+		if err != nil {
+			return nil, err
+		}
+		res := make([]watchedRecord, len(records))
+		for i := range records {
+			res[i] = watchedRecord{id: records[i].ID, record: &records[i]}
+		}
+		return res, nil
+	}
+	emit := func(t EventType, before, after interface{}, err error) bool {
+		ev := Ipv6FirewallFilter_Event{Type: t, Err: err}
+		if before != nil {
+			ev.Before = before.(*Ipv6FirewallFilter)
+		}
+		if after != nil {
+			ev.After = after.(*Ipv6FirewallFilter)
+		}
+		select {
+		case ch <- ev:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+	go watch(ctx, interval, list, emit, func() { close(ch) })
+	return ch
+}
+
+// Ipv6FirewallFilter_Schema describes the `ipv6/firewall/filter` menu.
+var Ipv6FirewallFilter_Schema = &MenuSchema{
+	Path:    "ipv6/firewall/filter",
+	Table:   true,
+	Ordered: true,
+	Properties: []*PropertySchema{
+		{Name: "chain", Kind: KindEnum, Open: true, Variants: []string{"input", "forward", "output"}},
+		{Name: "action", Kind: KindEnum, Variants: []string{"accept", "add-dst-to-address-list", "add-src-to-address-list", "drop", "fasttrack-connection", "jump", "log", "passthrough", "reject", "return"}},
+		{Name: "reject-with", Kind: KindString},
+		{Name: "src-address", Kind: KindString},
+		{Name: "dst-address", Kind: KindString},
+		{Name: "src-address-list", Kind: KindString},
+		{Name: "dst-address-list", Kind: KindString},
+		{Name: "protocol", Kind: KindEnum, Open: true, Variants: []string{"tcp", "udp", "icmp", "icmpv6", "gre", "ipsec-esp", "ipsec-ah", "ospf", "vrrp", "sctp", "udp-lite", "igmp"}},
+		{Name: "src-port", Kind: KindPortList},
+		{Name: "dst-port", Kind: KindPortList},
+		{Name: "port", Kind: KindPortList},
+		{Name: "in-interface", Kind: KindString},
+		{Name: "out-interface", Kind: KindString},
+		{Name: "in-interface-list", Kind: KindString},
+		{Name: "out-interface-list", Kind: KindString},
+		{Name: "packet-mark", Kind: KindString},
+		{Name: "routing-mark", Kind: KindString},
+		{Name: "tcp-flags", Kind: KindString},
+		{Name: "icmp-options", Kind: KindString},
+		{Name: "limit", Kind: KindString},
+		{Name: "log", Kind: KindBoolean},
+		{Name: "log-prefix", Kind: KindString},
+		{Name: "jump-target", Kind: KindString},
+		{Name: "address-list", Kind: KindString},
+		{Name: "address-list-timeout", Kind: KindString},
+		{Name: "comment", Kind: KindString},
+		{Name: "disabled", Kind: KindBoolean},
+		{Name: "bytes", Kind: KindNumber, ReadOnly: true},
+		{Name: "packets", Kind: KindNumber, ReadOnly: true},
+		{Name: "dynamic", Kind: KindBoolean, ReadOnly: true},
+		{Name: "invalid", Kind: KindBoolean, ReadOnly: true},
+		{Name: "connection-state", Kind: KindEnumList, Variants: []string{"established", "related", "new", "invalid", "untracked"}},
+		{Name: "connection-nat-state", Kind: KindEnumList, Variants: []string{"srcnat", "dstnat"}},
+		{Name: "connection-mark", Kind: KindString},
+	},
+}
+
+func init() {
+	registerMenu(Ipv6FirewallFilter_Schema)
+}
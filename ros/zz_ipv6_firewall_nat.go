@@ -0,0 +1,879 @@
+package ros
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Automatically generated by github.com/q3k/ros7api/gen, do not edit.
+
+// Ipv6FirewallNat_Chain is the type of the `chain` property. Besides the values below, any
+// other value is accepted.
+type Ipv6FirewallNat_Chain string
+
+const (
+	// Packets leaving the router, after routing.
+	Ipv6FirewallNat_ChainSrcnat Ipv6FirewallNat_Chain = "srcnat"
+	// Packets entering the router, before routing.
+	Ipv6FirewallNat_ChainDstnat Ipv6FirewallNat_Chain = "dstnat"
+)
+
+// Values returns all values of Ipv6FirewallNat_Chain known to this library.
+func (Ipv6FirewallNat_Chain) Values() []Ipv6FirewallNat_Chain {
+	return []Ipv6FirewallNat_Chain{
+		Ipv6FirewallNat_ChainSrcnat,
+		Ipv6FirewallNat_ChainDstnat,
+	}
+}
+
+// IsKnown returns whether the value is known to this library.
+func (e Ipv6FirewallNat_Chain) IsKnown() bool {
+	switch e {
+	case Ipv6FirewallNat_ChainSrcnat, Ipv6FirewallNat_ChainDstnat:
+		return true
+	}
+	return false
+}
+
+func (e Ipv6FirewallNat_Chain) String() string {
+	return string(e)
+}
+
+func (e *Ipv6FirewallNat_Chain) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*e = Ipv6FirewallNat_Chain(s)
+	return nil
+}
+
+func (e Ipv6FirewallNat_Chain) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(e))
+}
+
+// Ipv6FirewallNat_Action is the type of the `action` property. Values not known to this library
+// (eg. introduced in newer ROS versions) are kept as-is, but reported
+// through UnknownEnumHandler.
+type Ipv6FirewallNat_Action string
+
+const (
+	// Stop processing the table and accept the packet.
+	Ipv6FirewallNat_ActionAccept Ipv6FirewallNat_Action = "accept"
+	// Add the destination address to the address list given by address-list.
+	Ipv6FirewallNat_ActionAddDstToAddressList Ipv6FirewallNat_Action = "add-dst-to-address-list"
+	// Add the source address to the address list given by address-list.
+	Ipv6FirewallNat_ActionAddSrcToAddressList Ipv6FirewallNat_Action = "add-src-to-address-list"
+	// Replace the destination address and port with to-addresses and to-ports.
+	Ipv6FirewallNat_ActionDstNat Ipv6FirewallNat_Action = "dst-nat"
+	// Continue in the chain given by jump-target.
+	Ipv6FirewallNat_ActionJump Ipv6FirewallNat_Action = "jump"
+	// Log the packet and continue with the next rule.
+	Ipv6FirewallNat_ActionLog Ipv6FirewallNat_Action = "log"
+	// Replace the source address with the address of the output interface.
+	Ipv6FirewallNat_ActionMasquerade Ipv6FirewallNat_Action = "masquerade"
+	// Map a whole address range to another one of the same size.
+	Ipv6FirewallNat_ActionNetmap Ipv6FirewallNat_Action = "netmap"
+	// Only count the packet and continue with the next rule.
+	Ipv6FirewallNat_ActionPassthrough Ipv6FirewallNat_Action = "passthrough"
+	// Replace the destination address with the router's own, and the destination port with to-ports.
+	Ipv6FirewallNat_ActionRedirect Ipv6FirewallNat_Action = "redirect"
+	// Return to the rule after the jump which led to this chain.
+	Ipv6FirewallNat_ActionReturn Ipv6FirewallNat_Action = "return"
+	// Replace the source address and port with to-addresses and to-ports.
+	Ipv6FirewallNat_ActionSrcNat Ipv6FirewallNat_Action = "src-nat"
+)
+
+// Values returns all values of Ipv6FirewallNat_Action known to this library.
+func (Ipv6FirewallNat_Action) Values() []Ipv6FirewallNat_Action {
+	return []Ipv6FirewallNat_Action{
+		Ipv6FirewallNat_ActionAccept,
+		Ipv6FirewallNat_ActionAddDstToAddressList,
+		Ipv6FirewallNat_ActionAddSrcToAddressList,
+		Ipv6FirewallNat_ActionDstNat,
+		Ipv6FirewallNat_ActionJump,
+		Ipv6FirewallNat_ActionLog,
+		Ipv6FirewallNat_ActionMasquerade,
+		Ipv6FirewallNat_ActionNetmap,
+		Ipv6FirewallNat_ActionPassthrough,
+		Ipv6FirewallNat_ActionRedirect,
+		Ipv6FirewallNat_ActionReturn,
+		Ipv6FirewallNat_ActionSrcNat,
+	}
+}
+
+// IsKnown returns whether the value is known to this library.
+func (e Ipv6FirewallNat_Action) IsKnown() bool {
+	switch e {
+	case Ipv6FirewallNat_ActionAccept, Ipv6FirewallNat_ActionAddDstToAddressList, Ipv6FirewallNat_ActionAddSrcToAddressList, Ipv6FirewallNat_ActionDstNat, Ipv6FirewallNat_ActionJump, Ipv6FirewallNat_ActionLog, Ipv6FirewallNat_ActionMasquerade, Ipv6FirewallNat_ActionNetmap, Ipv6FirewallNat_ActionPassthrough, Ipv6FirewallNat_ActionRedirect, Ipv6FirewallNat_ActionReturn, Ipv6FirewallNat_ActionSrcNat:
+		return true
+	}
+	return false
+}
+
+func (e Ipv6FirewallNat_Action) String() string {
+	return string(e)
+}
+
+func (e *Ipv6FirewallNat_Action) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*e = Ipv6FirewallNat_Action(s)
+	if s != "" && !e.IsKnown() {
+		reportUnknownEnum("Ipv6FirewallNat_Action", s)
+	}
+	return nil
+}
+
+func (e Ipv6FirewallNat_Action) MarshalJSON() ([]byte, error) {
+	if e != "" && !e.IsKnown() {
+		reportUnknownEnum("Ipv6FirewallNat_Action", string(e))
+	}
+	return json.Marshal(string(e))
+}
+
+// Ipv6FirewallNat represents a ROS `ipv6/firewall/nat` record, including read-only fields.
+//
+// IPv6 firewall NAT rules, which rewrite the addresses and ports of connections. Rules are evaluated in order.
+type Ipv6FirewallNat struct {
+	Record
+
+	// Chain the rule belongs to: one of the built-in chains, or a user-defined one which is jumped to.
+	Chain Ipv6FirewallNat_Chain `json:"chain"`
+	// Action taken on matched packets.
+	Action Ipv6FirewallNat_Action `json:"action"`
+	// Address or address range to translate to, for NAT actions.
+	ToAddresses string `json:"to-addresses"`
+	// Port or port range to translate to, for NAT actions.
+	ToPorts PortList `json:"to-ports"`
+	// Source address, prefix or range to match, optionally negated with a ! prefix, eg. 10.0.0.0/8.
+	SrcAddress string `json:"src-address"`
+	// Destination address, prefix or range to match, optionally negated with a ! prefix, eg. 10.0.0.0/8.
+	DstAddress string `json:"dst-address"`
+	// Address list the source address must be on, optionally negated with a ! prefix.
+	SrcAddressList string `json:"src-address-list"`
+	// Address list the destination address must be on, optionally negated with a ! prefix.
+	DstAddressList string `json:"dst-address-list"`
+	// IP protocol to match. Protocols not listed can be given by name or number.
+	Protocol FirewallRule_Protocol `json:"protocol"`
+	// Source ports and port ranges to match, eg. 1024-65535. Requires protocol to be tcp, udp, udp-lite or sctp.
+	SrcPort PortList `json:"src-port"`
+	// Destination ports and port ranges to match, eg. 80,443. Requires protocol to be tcp, udp, udp-lite or sctp.
+	DstPort PortList `json:"dst-port"`
+	// Ports and port ranges to match against either the source or the destination port.
+	Port PortList `json:"port"`
+	// Interface the packet entered the router through, optionally negated with a ! prefix.
+	InInterface string `json:"in-interface"`
+	// Interface the packet leaves the router through, optionally negated with a ! prefix.
+	OutInterface string `json:"out-interface"`
+	// Interface list the input interface must be on, optionally negated with a ! prefix.
+	InInterfaceList string `json:"in-interface-list"`
+	// Interface list the output interface must be on, optionally negated with a ! prefix.
+	OutInterfaceList string `json:"out-interface-list"`
+	// Packet mark to match, as set by mangle rules.
+	PacketMark string `json:"packet-mark"`
+	// Routing mark to match, as set by mangle rules.
+	RoutingMark string `json:"routing-mark"`
+	// TCP flags to match, each optionally negated with a ! prefix, eg. syn,!ack.
+	TcpFlags string `json:"tcp-flags"`
+	// ICMP type and code to match, eg. 8:0.
+	ICMPOptions string `json:"icmp-options"`
+	// Rate limit above which packets stop matching, as count,time,burst, eg. 10,1s,5.
+	Limit string `json:"limit"`
+	// Whether to log matched packets.
+	Log Boolean `json:"log"`
+	// Prefix of the log messages of matched packets.
+	LogPrefix string `json:"log-prefix"`
+	// Chain to continue in, for the jump action.
+	JumpTarget string `json:"jump-target"`
+	// Address list to add the address to, for the add-src-to-address-list and add-dst-to-address-list actions.
+	AddressList string `json:"address-list"`
+	// How long the address is kept on the address list: a duration, none-dynamic or none-static.
+	AddressListTimeout string `json:"address-list-timeout"`
+	// Short description of the rule.
+	Comment string `json:"comment"`
+	// Whether the rule is disabled.
+	Disabled Boolean `json:"disabled"`
+	// Number of bytes matched by the rule.
+	Bytes Number `json:"bytes"`
+	// Number of packets matched by the rule.
+	Packets Number `json:"packets"`
+	// Whether the rule was added dynamically, eg. by a service.
+	Dynamic Boolean `json:"dynamic"`
+	// Whether the rule is invalid, eg. because it refers to an interface that doesn't exist.
+	Invalid Boolean `json:"invalid"`
+	// Connection tracking states to match, eg. established,related.
+	ConnectionState FirewallConntrack_ConnectionStateList `json:"connection-state"`
+	// NAT states of the connection to match.
+	ConnectionNatState FirewallConntrack_ConnectionNatStateList `json:"connection-nat-state"`
+	// Connection mark to match, as set by mangle rules.
+	ConnectionMark string `json:"connection-mark"`
+}
+
+// Ipv6FirewallNat_Update is an update to a ROS `ipv6/firewall/nat` record. Any unset field will not be updated.
+type Ipv6FirewallNat_Update struct {
+	// Chain the rule belongs to: one of the built-in chains, or a user-defined one which is jumped to.
+	Chain *Ipv6FirewallNat_Chain `json:"chain,omitempty"`
+	// Action taken on matched packets.
+	Action *Ipv6FirewallNat_Action `json:"action,omitempty"`
+	// Address or address range to translate to, for NAT actions.
+	ToAddresses *string `json:"to-addresses,omitempty"`
+	// Port or port range to translate to, for NAT actions.
+	ToPorts *PortList `json:"to-ports,omitempty"`
+	// Source address, prefix or range to match, optionally negated with a ! prefix, eg. 10.0.0.0/8.
+	SrcAddress *string `json:"src-address,omitempty"`
+	// Destination address, prefix or range to match, optionally negated with a ! prefix, eg. 10.0.0.0/8.
+	DstAddress *string `json:"dst-address,omitempty"`
+	// Address list the source address must be on, optionally negated with a ! prefix.
+	SrcAddressList *string `json:"src-address-list,omitempty"`
+	// Address list the destination address must be on, optionally negated with a ! prefix.
+	DstAddressList *string `json:"dst-address-list,omitempty"`
+	// IP protocol to match. Protocols not listed can be given by name or number.
+	Protocol *FirewallRule_Protocol `json:"protocol,omitempty"`
+	// Source ports and port ranges to match, eg. 1024-65535. Requires protocol to be tcp, udp, udp-lite or sctp.
+	SrcPort *PortList `json:"src-port,omitempty"`
+	// Destination ports and port ranges to match, eg. 80,443. Requires protocol to be tcp, udp, udp-lite or sctp.
+	DstPort *PortList `json:"dst-port,omitempty"`
+	// Ports and port ranges to match against either the source or the destination port.
+	Port *PortList `json:"port,omitempty"`
+	// Interface the packet entered the router through, optionally negated with a ! prefix.
+	InInterface *string `json:"in-interface,omitempty"`
+	// Interface the packet leaves the router through, optionally negated with a ! prefix.
+	OutInterface *string `json:"out-interface,omitempty"`
+	// Interface list the input interface must be on, optionally negated with a ! prefix.
+	InInterfaceList *string `json:"in-interface-list,omitempty"`
+	// Interface list the output interface must be on, optionally negated with a ! prefix.
+	OutInterfaceList *string `json:"out-interface-list,omitempty"`
+	// Packet mark to match, as set by mangle rules.
+	PacketMark *string `json:"packet-mark,omitempty"`
+	// Routing mark to match, as set by mangle rules.
+	RoutingMark *string `json:"routing-mark,omitempty"`
+	// TCP flags to match, each optionally negated with a ! prefix, eg. syn,!ack.
+	TcpFlags *string `json:"tcp-flags,omitempty"`
+	// ICMP type and code to match, eg. 8:0.
+	ICMPOptions *string `json:"icmp-options,omitempty"`
+	// Rate limit above which packets stop matching, as count,time,burst, eg. 10,1s,5.
+	Limit *string `json:"limit,omitempty"`
+	// Whether to log matched packets.
+	Log *Boolean `json:"log,omitempty"`
+	// Prefix of the log messages of matched packets.
+	LogPrefix *string `json:"log-prefix,omitempty"`
+	// Chain to continue in, for the jump action.
+	JumpTarget *string `json:"jump-target,omitempty"`
+	// Address list to add the address to, for the add-src-to-address-list and add-dst-to-address-list actions.
+	AddressList *string `json:"address-list,omitempty"`
+	// How long the address is kept on the address list: a duration, none-dynamic or none-static.
+	AddressListTimeout *string `json:"address-list-timeout,omitempty"`
+	// Short description of the rule.
+	Comment *string `json:"comment,omitempty"`
+	// Whether the rule is disabled.
+	Disabled *Boolean `json:"disabled,omitempty"`
+	// Connection tracking states to match, eg. established,related.
+	ConnectionState *FirewallConntrack_ConnectionStateList `json:"connection-state,omitempty"`
+	// NAT states of the connection to match.
+	ConnectionNatState *FirewallConntrack_ConnectionNatStateList `json:"connection-nat-state,omitempty"`
+	// Connection mark to match, as set by mangle rules.
+	ConnectionMark *string `json:"connection-mark,omitempty"`
+}
+
+// ToUpdate returns an update setting all settable fields of a `ipv6/firewall/nat` record to
+// their values in r. Fields unset in r which ROS doesn't accept empty (eg.
+// addresses, port lists or enums) are left out, so that listed records can be
+// written back. List values are shared between r and the update.
+func (r *Ipv6FirewallNat) ToUpdate() *Ipv6FirewallNat_Update {
+	u := &Ipv6FirewallNat_Update{}
+	if r.Chain != "" {
+		u.Chain = new(Ipv6FirewallNat_Chain)
+		*u.Chain = r.Chain
+	}
+	if r.Action != "" {
+		u.Action = new(Ipv6FirewallNat_Action)
+		*u.Action = r.Action
+	}
+	u.ToAddresses = new(string)
+	*u.ToAddresses = r.ToAddresses
+	if !r.ToPorts.Equal(PortList{}) {
+		u.ToPorts = new(PortList)
+		*u.ToPorts = r.ToPorts
+	}
+	u.SrcAddress = new(string)
+	*u.SrcAddress = r.SrcAddress
+	u.DstAddress = new(string)
+	*u.DstAddress = r.DstAddress
+	u.SrcAddressList = new(string)
+	*u.SrcAddressList = r.SrcAddressList
+	u.DstAddressList = new(string)
+	*u.DstAddressList = r.DstAddressList
+	if r.Protocol != "" {
+		u.Protocol = new(FirewallRule_Protocol)
+		*u.Protocol = r.Protocol
+	}
+	if !r.SrcPort.Equal(PortList{}) {
+		u.SrcPort = new(PortList)
+		*u.SrcPort = r.SrcPort
+	}
+	if !r.DstPort.Equal(PortList{}) {
+		u.DstPort = new(PortList)
+		*u.DstPort = r.DstPort
+	}
+	if !r.Port.Equal(PortList{}) {
+		u.Port = new(PortList)
+		*u.Port = r.Port
+	}
+	u.InInterface = new(string)
+	*u.InInterface = r.InInterface
+	u.OutInterface = new(string)
+	*u.OutInterface = r.OutInterface
+	u.InInterfaceList = new(string)
+	*u.InInterfaceList = r.InInterfaceList
+	u.OutInterfaceList = new(string)
+	*u.OutInterfaceList = r.OutInterfaceList
+	u.PacketMark = new(string)
+	*u.PacketMark = r.PacketMark
+	u.RoutingMark = new(string)
+	*u.RoutingMark = r.RoutingMark
+	u.TcpFlags = new(string)
+	*u.TcpFlags = r.TcpFlags
+	u.ICMPOptions = new(string)
+	*u.ICMPOptions = r.ICMPOptions
+	u.Limit = new(string)
+	*u.Limit = r.Limit
+	u.Log = new(Boolean)
+	*u.Log = r.Log
+	u.LogPrefix = new(string)
+	*u.LogPrefix = r.LogPrefix
+	u.JumpTarget = new(string)
+	*u.JumpTarget = r.JumpTarget
+	u.AddressList = new(string)
+	*u.AddressList = r.AddressList
+	u.AddressListTimeout = new(string)
+	*u.AddressListTimeout = r.AddressListTimeout
+	u.Comment = new(string)
+	*u.Comment = r.Comment
+	u.Disabled = new(Boolean)
+	*u.Disabled = r.Disabled
+	if r.ConnectionState.Not || len(r.ConnectionState.Values) != 0 {
+		u.ConnectionState = new(FirewallConntrack_ConnectionStateList)
+		*u.ConnectionState = r.ConnectionState
+	}
+	if r.ConnectionNatState.Not || len(r.ConnectionNatState.Values) != 0 {
+		u.ConnectionNatState = new(FirewallConntrack_ConnectionNatStateList)
+		*u.ConnectionNatState = r.ConnectionNatState
+	}
+	u.ConnectionMark = new(string)
+	*u.ConnectionMark = r.ConnectionMark
+	return u
+}
+
+// DiffIpv6FirewallNat returns an update which changes current into desired, setting only
+// the settable fields that differ between them. Read-only fields, and fields
+// left out by ToUpdate when unset in desired, are ignored. The update IsEmpty if
+// there is nothing to change.
+func DiffIpv6FirewallNat(current, desired *Ipv6FirewallNat) *Ipv6FirewallNat_Update {
+	u := &Ipv6FirewallNat_Update{}
+	if (desired.Chain != "") && current.Chain != desired.Chain {
+		u.Chain = new(Ipv6FirewallNat_Chain)
+		*u.Chain = desired.Chain
+	}
+	if (desired.Action != "") && current.Action != desired.Action {
+		u.Action = new(Ipv6FirewallNat_Action)
+		*u.Action = desired.Action
+	}
+	if current.ToAddresses != desired.ToAddresses {
+		u.ToAddresses = new(string)
+		*u.ToAddresses = desired.ToAddresses
+	}
+	if (!desired.ToPorts.Equal(PortList{})) && !current.ToPorts.Equal(desired.ToPorts) {
+		u.ToPorts = new(PortList)
+		*u.ToPorts = desired.ToPorts
+	}
+	if current.SrcAddress != desired.SrcAddress {
+		u.SrcAddress = new(string)
+		*u.SrcAddress = desired.SrcAddress
+	}
+	if current.DstAddress != desired.DstAddress {
+		u.DstAddress = new(string)
+		*u.DstAddress = desired.DstAddress
+	}
+	if current.SrcAddressList != desired.SrcAddressList {
+		u.SrcAddressList = new(string)
+		*u.SrcAddressList = desired.SrcAddressList
+	}
+	if current.DstAddressList != desired.DstAddressList {
+		u.DstAddressList = new(string)
+		*u.DstAddressList = desired.DstAddressList
+	}
+	if (desired.Protocol != "") && current.Protocol != desired.Protocol {
+		u.Protocol = new(FirewallRule_Protocol)
+		*u.Protocol = desired.Protocol
+	}
+	if (!desired.SrcPort.Equal(PortList{})) && !current.SrcPort.Equal(desired.SrcPort) {
+		u.SrcPort = new(PortList)
+		*u.SrcPort = desired.SrcPort
+	}
+	if (!desired.DstPort.Equal(PortList{})) && !current.DstPort.Equal(desired.DstPort) {
+		u.DstPort = new(PortList)
+		*u.DstPort = desired.DstPort
+	}
+	if (!desired.Port.Equal(PortList{})) && !current.Port.Equal(desired.Port) {
+		u.Port = new(PortList)
+		*u.Port = desired.Port
+	}
+	if current.InInterface != desired.InInterface {
+		u.InInterface = new(string)
+		*u.InInterface = desired.InInterface
+	}
+	if current.OutInterface != desired.OutInterface {
+		u.OutInterface = new(string)
+		*u.OutInterface = desired.OutInterface
+	}
+	if current.InInterfaceList != desired.InInterfaceList {
+		u.InInterfaceList = new(string)
+		*u.InInterfaceList = desired.InInterfaceList
+	}
+	if current.OutInterfaceList != desired.OutInterfaceList {
+		u.OutInterfaceList = new(string)
+		*u.OutInterfaceList = desired.OutInterfaceList
+	}
+	if current.PacketMark != desired.PacketMark {
+		u.PacketMark = new(string)
+		*u.PacketMark = desired.PacketMark
+	}
+	if current.RoutingMark != desired.RoutingMark {
+		u.RoutingMark = new(string)
+		*u.RoutingMark = desired.RoutingMark
+	}
+	if current.TcpFlags != desired.TcpFlags {
+		u.TcpFlags = new(string)
+		*u.TcpFlags = desired.TcpFlags
+	}
+	if current.ICMPOptions != desired.ICMPOptions {
+		u.ICMPOptions = new(string)
+		*u.ICMPOptions = desired.ICMPOptions
+	}
+	if current.Limit != desired.Limit {
+		u.Limit = new(string)
+		*u.Limit = desired.Limit
+	}
+	if current.Log != desired.Log {
+		u.Log = new(Boolean)
+		*u.Log = desired.Log
+	}
+	if current.LogPrefix != desired.LogPrefix {
+		u.LogPrefix = new(string)
+		*u.LogPrefix = desired.LogPrefix
+	}
+	if current.JumpTarget != desired.JumpTarget {
+		u.JumpTarget = new(string)
+		*u.JumpTarget = desired.JumpTarget
+	}
+	if current.AddressList != desired.AddressList {
+		u.AddressList = new(string)
+		*u.AddressList = desired.AddressList
+	}
+	if current.AddressListTimeout != desired.AddressListTimeout {
+		u.AddressListTimeout = new(string)
+		*u.AddressListTimeout = desired.AddressListTimeout
+	}
+	if current.Comment != desired.Comment {
+		u.Comment = new(string)
+		*u.Comment = desired.Comment
+	}
+	if current.Disabled != desired.Disabled {
+		u.Disabled = new(Boolean)
+		*u.Disabled = desired.Disabled
+	}
+	if (desired.ConnectionState.Not || len(desired.ConnectionState.Values) != 0) && !current.ConnectionState.Equal(desired.ConnectionState) {
+		u.ConnectionState = new(FirewallConntrack_ConnectionStateList)
+		*u.ConnectionState = desired.ConnectionState
+	}
+	if (desired.ConnectionNatState.Not || len(desired.ConnectionNatState.Values) != 0) && !current.ConnectionNatState.Equal(desired.ConnectionNatState) {
+		u.ConnectionNatState = new(FirewallConntrack_ConnectionNatStateList)
+		*u.ConnectionNatState = desired.ConnectionNatState
+	}
+	if current.ConnectionMark != desired.ConnectionMark {
+		u.ConnectionMark = new(string)
+		*u.ConnectionMark = desired.ConnectionMark
+	}
+	return u
+}
+
+// IsEmpty returns whether the update does not set any field, ie. applying it
+// would be a no-op.
+func (u *Ipv6FirewallNat_Update) IsEmpty() bool {
+	return u.Chain == nil &&
+		u.Action == nil &&
+		u.ToAddresses == nil &&
+		u.ToPorts == nil &&
+		u.SrcAddress == nil &&
+		u.DstAddress == nil &&
+		u.SrcAddressList == nil &&
+		u.DstAddressList == nil &&
+		u.Protocol == nil &&
+		u.SrcPort == nil &&
+		u.DstPort == nil &&
+		u.Port == nil &&
+		u.InInterface == nil &&
+		u.OutInterface == nil &&
+		u.InInterfaceList == nil &&
+		u.OutInterfaceList == nil &&
+		u.PacketMark == nil &&
+		u.RoutingMark == nil &&
+		u.TcpFlags == nil &&
+		u.ICMPOptions == nil &&
+		u.Limit == nil &&
+		u.Log == nil &&
+		u.LogPrefix == nil &&
+		u.JumpTarget == nil &&
+		u.AddressList == nil &&
+		u.AddressListTimeout == nil &&
+		u.Comment == nil &&
+		u.Disabled == nil &&
+		u.ConnectionState == nil &&
+		u.ConnectionNatState == nil &&
+		u.ConnectionMark == nil
+}
+
+// Ipv6FirewallNat_Field is the name of a `ipv6/firewall/nat` record property, for use in .proplist
+// projections.
+type Ipv6FirewallNat_Field string
+
+const (
+	Ipv6FirewallNat_FieldID                 Ipv6FirewallNat_Field = ".id"
+	Ipv6FirewallNat_FieldChain              Ipv6FirewallNat_Field = "chain"
+	Ipv6FirewallNat_FieldAction             Ipv6FirewallNat_Field = "action"
+	Ipv6FirewallNat_FieldToAddresses        Ipv6FirewallNat_Field = "to-addresses"
+	Ipv6FirewallNat_FieldToPorts            Ipv6FirewallNat_Field = "to-ports"
+	Ipv6FirewallNat_FieldSrcAddress         Ipv6FirewallNat_Field = "src-address"
+	Ipv6FirewallNat_FieldDstAddress         Ipv6FirewallNat_Field = "dst-address"
+	Ipv6FirewallNat_FieldSrcAddressList     Ipv6FirewallNat_Field = "src-address-list"
+	Ipv6FirewallNat_FieldDstAddressList     Ipv6FirewallNat_Field = "dst-address-list"
+	Ipv6FirewallNat_FieldProtocol           Ipv6FirewallNat_Field = "protocol"
+	Ipv6FirewallNat_FieldSrcPort            Ipv6FirewallNat_Field = "src-port"
+	Ipv6FirewallNat_FieldDstPort            Ipv6FirewallNat_Field = "dst-port"
+	Ipv6FirewallNat_FieldPort               Ipv6FirewallNat_Field = "port"
+	Ipv6FirewallNat_FieldInInterface        Ipv6FirewallNat_Field = "in-interface"
+	Ipv6FirewallNat_FieldOutInterface       Ipv6FirewallNat_Field = "out-interface"
+	Ipv6FirewallNat_FieldInInterfaceList    Ipv6FirewallNat_Field = "in-interface-list"
+	Ipv6FirewallNat_FieldOutInterfaceList   Ipv6FirewallNat_Field = "out-interface-list"
+	Ipv6FirewallNat_FieldPacketMark         Ipv6FirewallNat_Field = "packet-mark"
+	Ipv6FirewallNat_FieldRoutingMark        Ipv6FirewallNat_Field = "routing-mark"
+	Ipv6FirewallNat_FieldTcpFlags           Ipv6FirewallNat_Field = "tcp-flags"
+	Ipv6FirewallNat_FieldICMPOptions        Ipv6FirewallNat_Field = "icmp-options"
+	Ipv6FirewallNat_FieldLimit              Ipv6FirewallNat_Field = "limit"
+	Ipv6FirewallNat_FieldLog                Ipv6FirewallNat_Field = "log"
+	Ipv6FirewallNat_FieldLogPrefix          Ipv6FirewallNat_Field = "log-prefix"
+	Ipv6FirewallNat_FieldJumpTarget         Ipv6FirewallNat_Field = "jump-target"
+	Ipv6FirewallNat_FieldAddressList        Ipv6FirewallNat_Field = "address-list"
+	Ipv6FirewallNat_FieldAddressListTimeout Ipv6FirewallNat_Field = "address-list-timeout"
+	Ipv6FirewallNat_FieldComment            Ipv6FirewallNat_Field = "comment"
+	Ipv6FirewallNat_FieldDisabled           Ipv6FirewallNat_Field = "disabled"
+	Ipv6FirewallNat_FieldBytes              Ipv6FirewallNat_Field = "bytes"
+	Ipv6FirewallNat_FieldPackets            Ipv6FirewallNat_Field = "packets"
+	Ipv6FirewallNat_FieldDynamic            Ipv6FirewallNat_Field = "dynamic"
+	Ipv6FirewallNat_FieldInvalid            Ipv6FirewallNat_Field = "invalid"
+	Ipv6FirewallNat_FieldConnectionState    Ipv6FirewallNat_Field = "connection-state"
+	Ipv6FirewallNat_FieldConnectionNatState Ipv6FirewallNat_Field = "connection-nat-state"
+	Ipv6FirewallNat_FieldConnectionMark     Ipv6FirewallNat_Field = "connection-mark"
+)
+
+// Ipv6FirewallNat_Filter is an equality filter on `ipv6/firewall/nat` records, evaluated by ROS.
+// Any unset field will not be filtered on.
+type Ipv6FirewallNat_Filter struct {
+	ID                 *RecordID                                 `json:".id,omitempty"`
+	Chain              *Ipv6FirewallNat_Chain                    `json:"chain,omitempty"`
+	Action             *Ipv6FirewallNat_Action                   `json:"action,omitempty"`
+	ToAddresses        *string                                   `json:"to-addresses,omitempty"`
+	ToPorts            *PortList                                 `json:"to-ports,omitempty"`
+	SrcAddress         *string                                   `json:"src-address,omitempty"`
+	DstAddress         *string                                   `json:"dst-address,omitempty"`
+	SrcAddressList     *string                                   `json:"src-address-list,omitempty"`
+	DstAddressList     *string                                   `json:"dst-address-list,omitempty"`
+	Protocol           *FirewallRule_Protocol                    `json:"protocol,omitempty"`
+	SrcPort            *PortList                                 `json:"src-port,omitempty"`
+	DstPort            *PortList                                 `json:"dst-port,omitempty"`
+	Port               *PortList                                 `json:"port,omitempty"`
+	InInterface        *string                                   `json:"in-interface,omitempty"`
+	OutInterface       *string                                   `json:"out-interface,omitempty"`
+	InInterfaceList    *string                                   `json:"in-interface-list,omitempty"`
+	OutInterfaceList   *string                                   `json:"out-interface-list,omitempty"`
+	PacketMark         *string                                   `json:"packet-mark,omitempty"`
+	RoutingMark        *string                                   `json:"routing-mark,omitempty"`
+	TcpFlags           *string                                   `json:"tcp-flags,omitempty"`
+	ICMPOptions        *string                                   `json:"icmp-options,omitempty"`
+	Limit              *string                                   `json:"limit,omitempty"`
+	Log                *Boolean                                  `json:"log,omitempty"`
+	LogPrefix          *string                                   `json:"log-prefix,omitempty"`
+	JumpTarget         *string                                   `json:"jump-target,omitempty"`
+	AddressList        *string                                   `json:"address-list,omitempty"`
+	AddressListTimeout *string                                   `json:"address-list-timeout,omitempty"`
+	Comment            *string                                   `json:"comment,omitempty"`
+	Disabled           *Boolean                                  `json:"disabled,omitempty"`
+	Bytes              *Number                                   `json:"bytes,omitempty"`
+	Packets            *Number                                   `json:"packets,omitempty"`
+	Dynamic            *Boolean                                  `json:"dynamic,omitempty"`
+	Invalid            *Boolean                                  `json:"invalid,omitempty"`
+	ConnectionState    *FirewallConntrack_ConnectionStateList    `json:"connection-state,omitempty"`
+	ConnectionNatState *FirewallConntrack_ConnectionNatStateList `json:"connection-nat-state,omitempty"`
+	ConnectionMark     *string                                   `json:"connection-mark,omitempty"`
+}
+
+// Ipv6FirewallNat_ListOptions limits the records and fields returned by Ipv6FirewallNatListWith.
+type Ipv6FirewallNat_ListOptions struct {
+	// Filter, if set, only returns records matching all set fields.
+	Filter *Ipv6FirewallNat_Filter
+	// Proplist, if set, only returns the given fields of every record.
+	Proplist []Ipv6FirewallNat_Field
+}
+
+// Ipv6FirewallNatList returns a list of all `ipv6/firewall/nat` records.
+func (c *Client) Ipv6FirewallNatList(ctx context.Context) ([]Ipv6FirewallNat, error) {
+	return c.Ipv6FirewallNatListWith(ctx, nil)
+}
+
+// Ipv6FirewallNatListWith returns a list of all `ipv6/firewall/nat` records, optionally filtered
+// and projected by ROS according to the given options, which may be nil.
+func (c *Client) Ipv6FirewallNatListWith(ctx context.Context, opts *Ipv6FirewallNat_ListOptions) ([]Ipv6FirewallNat, error) {
+	var filter *Ipv6FirewallNat_Filter
+	var proplist []string
+	if opts != nil {
+		filter = opts.Filter
+		for _, f := range opts.Proplist {
+			proplist = append(proplist, string(f))
+		}
+	}
+	query, err := listQuery(filter, proplist)
+	if err != nil {
+		return nil, fmt.Errorf("invalid options: %w", err)
+	}
+	body, err := c.doGET(ctx, "ipv6/firewall/nat", "", query)
+	if err != nil {
+		return nil, fmt.Errorf("could not GET: %w", err)
+	}
+	defer body.Close()
+
+	var target []Ipv6FirewallNat
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return target, nil
+}
+
+// Ipv6FirewallNatFind returns all `ipv6/firewall/nat` records matching the given filter, which may
+// be nil. The filter is sent as a print .query and evaluated by ROS. If
+// proplist is given, only the given fields of every record are returned.
+func (c *Client) Ipv6FirewallNatFind(ctx context.Context, filter *Ipv6FirewallNat_Filter, proplist ...Ipv6FirewallNat_Field) ([]Ipv6FirewallNat, error) {
+	var props []string
+	for _, f := range proplist {
+		props = append(props, string(f))
+	}
+	rdata, err := printQuery(filter, props)
+	if err != nil {
+		return nil, fmt.Errorf("invalid filter: %w", err)
+	}
+	body, err := c.doPOST(ctx, "ipv6/firewall/nat", "print", rdata)
+	if err != nil {
+		return nil, fmt.Errorf("could not POST: %w", err)
+	}
+	defer body.Close()
+
+	var target []Ipv6FirewallNat
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return target, nil
+}
+
+// Ipv6FirewallNatPatch updates the given fields of a `ipv6/firewall/nat` record by ID.
+func (c *Client) Ipv6FirewallNatPatch(ctx context.Context, id RecordID, u *Ipv6FirewallNat_Update) (*Ipv6FirewallNat, error) {
+	rdata, err := json.Marshal(u)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal update: %w", err)
+	}
+	body, err := c.doPATCH(ctx, "ipv6/firewall/nat", id, rdata)
+	if err != nil {
+		return nil, fmt.Errorf("could not PATCH: %w", err)
+	}
+	defer body.Close()
+
+	var target Ipv6FirewallNat
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return &target, nil
+}
+
+// Ipv6FirewallNatGet returns a single `ipv6/firewall/nat` record by ID.
+func (c *Client) Ipv6FirewallNatGet(ctx context.Context, id RecordID) (*Ipv6FirewallNat, error) {
+	body, err := c.doGET(ctx, "ipv6/firewall/nat", id, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not GET: %w", err)
+	}
+	defer body.Close()
+
+	var target Ipv6FirewallNat
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return &target, nil
+}
+
+// Ipv6FirewallNatAdd creates a new `ipv6/firewall/nat` record with the given fields set. Any unset
+// field will be populated by ROS with its default value.
+func (c *Client) Ipv6FirewallNatAdd(ctx context.Context, u *Ipv6FirewallNat_Update) (*Ipv6FirewallNat, error) {
+	rdata, err := json.Marshal(u)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal update: %w", err)
+	}
+	body, err := c.doPUT(ctx, "ipv6/firewall/nat", rdata)
+	if err != nil {
+		return nil, fmt.Errorf("could not PUT: %w", err)
+	}
+	defer body.Close()
+
+	var target Ipv6FirewallNat
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return &target, nil
+}
+
+// Ipv6FirewallNatRemove deletes a `ipv6/firewall/nat` record by ID.
+func (c *Client) Ipv6FirewallNatRemove(ctx context.Context, id RecordID) error {
+	body, err := c.doDELETE(ctx, "ipv6/firewall/nat", id)
+	if err != nil {
+		return fmt.Errorf("could not DELETE: %w", err)
+	}
+	body.Close()
+	return nil
+}
+
+// Ipv6FirewallNatAddBefore creates a new `ipv6/firewall/nat` record with the given fields set,
+// placed before the record with the given ID. Any unset field will be
+// populated by ROS with its default value.
+func (c *Client) Ipv6FirewallNatAddBefore(ctx context.Context, u *Ipv6FirewallNat_Update, before RecordID) (*Ipv6FirewallNat, error) {
+	rdata, err := json.Marshal(struct {
+		*Ipv6FirewallNat_Update
+		PlaceBefore RecordID `json:"place-before"`
+	}{u, before})
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal update: %w", err)
+	}
+	body, err := c.doPUT(ctx, "ipv6/firewall/nat", rdata)
+	if err != nil {
+		return nil, fmt.Errorf("could not PUT: %w", err)
+	}
+	defer body.Close()
+
+	var target Ipv6FirewallNat
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return &target, nil
+}
+
+// Ipv6FirewallNatMove moves the `ipv6/firewall/nat` records with the given IDs, in the given
+// order, before the record with ID before, or to the end if before is empty.
+func (c *Client) Ipv6FirewallNatMove(ctx context.Context, ids []RecordID, before RecordID) error {
+	return c.MoveRows(ctx, "ipv6/firewall/nat", ids, before)
+}
+
+// Ipv6FirewallNat_Event is a change to a `ipv6/firewall/nat` record observed by Ipv6FirewallNatWatch.
+type Ipv6FirewallNat_Event struct {
+	Type EventType
+	// Before is the record before the change, nil if Type is EventAdded.
+	Before *Ipv6FirewallNat
+	// After is the record after the change, nil if Type is EventRemoved.
+	After *Ipv6FirewallNat
+	// Err is the polling error if Type is EventError.
+	Err error
+}
+
+// Ipv6FirewallNatWatch polls the `ipv6/firewall/nat` records every interval and sends an event for
+// every record added, modified or removed since the previous poll, starting
+// with an EventAdded for every existing record. Polling errors are sent as
+// EventError, and retried with backoff. The channel is closed once ctx is
+// canceled, or after a single EventError if interval is not positive.
+func (c *Client) Ipv6FirewallNatWatch(ctx context.Context, interval time.Duration) <-chan Ipv6FirewallNat_Event {
+	ch := make(chan Ipv6FirewallNat_Event)
+	list := func(ctx context.Context) ([]watchedRecord, error) {
+		records, err := c.Ipv6FirewallNatList(ctx)
+		if err != nil {
+			return nil, err
+		}
+		res := make([]watchedRecord, len(records))
+		for i := range records {
+			res[i] = watchedRecord{id: records[i].ID, record: &records[i]}
+		}
+		return res, nil
+	}
+	emit := func(t EventType, before, after interface{}, err error) bool {
+		ev := Ipv6FirewallNat_Event{Type: t, Err: err}
+		if before != nil {
+			ev.Before = before.(*Ipv6FirewallNat)
+		}
+		if after != nil {
+			ev.After = after.(*Ipv6FirewallNat)
+		}
+		select {
+		case ch <- ev:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+	go watch(ctx, interval, list, emit, func() { close(ch) })
+	return ch
+}
+
+// Ipv6FirewallNat_Schema describes the `ipv6/firewall/nat` menu.
+var Ipv6FirewallNat_Schema = &MenuSchema{
+	Path:    "ipv6/firewall/nat",
+	Table:   true,
+	Ordered: true,
+	Properties: []*PropertySchema{
+		{Name: "chain", Kind: KindEnum, Open: true, Variants: []string{"srcnat", "dstnat"}},
+		{Name: "action", Kind: KindEnum, Variants: []string{"accept", "add-dst-to-address-list", "add-src-to-address-list", "dst-nat", "jump", "log", "masquerade", "netmap", "passthrough", "redirect", "return", "src-nat"}},
+		{Name: "to-addresses", Kind: KindString},
+		{Name: "to-ports", Kind: KindPortList},
+		{Name: "src-address", Kind: KindString},
+		{Name: "dst-address", Kind: KindString},
+		{Name: "src-address-list", Kind: KindString},
+		{Name: "dst-address-list", Kind: KindString},
+		{Name: "protocol", Kind: KindEnum, Open: true, Variants: []string{"tcp", "udp", "icmp", "icmpv6", "gre", "ipsec-esp", "ipsec-ah", "ospf", "vrrp", "sctp", "udp-lite", "igmp"}},
+		{Name: "src-port", Kind: KindPortList},
+		{Name: "dst-port", Kind: KindPortList},
+		{Name: "port", Kind: KindPortList},
+		{Name: "in-interface", Kind: KindString},
+		{Name: "out-interface", Kind: KindString},
+		{Name: "in-interface-list", Kind: KindString},
+		{Name: "out-interface-list", Kind: KindString},
+		{Name: "packet-mark", Kind: KindString},
+		{Name: "routing-mark", Kind: KindString},
+		{Name: "tcp-flags", Kind: KindString},
+		{Name: "icmp-options", Kind: KindString},
+		{Name: "limit", Kind: KindString},
+		{Name: "log", Kind: KindBoolean},
+		{Name: "log-prefix", Kind: KindString},
+		{Name: "jump-target", Kind: KindString},
+		{Name: "address-list", Kind: KindString},
+		{Name: "address-list-timeout", Kind: KindString},
+		{Name: "comment", Kind: KindString},
+		{Name: "disabled", Kind: KindBoolean},
+		{Name: "bytes", Kind: KindNumber, ReadOnly: true},
+		{Name: "packets", Kind: KindNumber, ReadOnly: true},
+		{Name: "dynamic", Kind: KindBoolean, ReadOnly: true},
+		{Name: "invalid", Kind: KindBoolean, ReadOnly: true},
+		{Name: "connection-state", Kind: KindEnumList, Variants: []string{"established", "related", "new", "invalid", "untracked"}},
+		{Name: "connection-nat-state", Kind: KindEnumList, Variants: []string{"srcnat", "dstnat"}},
+		{Name: "connection-mark", Kind: KindString},
+	},
+}
+
+func init() {
+	registerMenu(Ipv6FirewallNat_Schema)
+}
@@ -0,0 +1,491 @@
+package ros
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Automatically generated by github.com/q3k/ros7api/gen, do not edit.
+
+// Ipv6Route_CheckGateway is the type of the `check-gateway` property. Values not known to this library
+// (eg. introduced in newer ROS versions) are kept as-is, but reported
+// through UnknownEnumHandler.
+type Ipv6Route_CheckGateway string
+
+const (
+	// Gateway reachability is not checked.
+	Ipv6Route_CheckGatewayNone Ipv6Route_CheckGateway = "none"
+	// Gateway reachability is checked with ARP requests.
+	Ipv6Route_CheckGatewayArp Ipv6Route_CheckGateway = "arp"
+	// Gateway reachability is checked with ICMP echo requests.
+	Ipv6Route_CheckGatewayPing Ipv6Route_CheckGateway = "ping"
+	// Gateway reachability is checked with BFD.
+	Ipv6Route_CheckGatewayBfd Ipv6Route_CheckGateway = "bfd"
+	// Gateway reachability is checked with multihop BFD.
+	Ipv6Route_CheckGatewayBfdMultihop Ipv6Route_CheckGateway = "bfd-multihop"
+)
+
+// Values returns all values of Ipv6Route_CheckGateway known to this library.
+func (Ipv6Route_CheckGateway) Values() []Ipv6Route_CheckGateway {
+	return []Ipv6Route_CheckGateway{
+		Ipv6Route_CheckGatewayNone,
+		Ipv6Route_CheckGatewayArp,
+		Ipv6Route_CheckGatewayPing,
+		Ipv6Route_CheckGatewayBfd,
+		Ipv6Route_CheckGatewayBfdMultihop,
+	}
+}
+
+// IsKnown returns whether the value is known to this library.
+func (e Ipv6Route_CheckGateway) IsKnown() bool {
+	switch e {
+	case Ipv6Route_CheckGatewayNone, Ipv6Route_CheckGatewayArp, Ipv6Route_CheckGatewayPing, Ipv6Route_CheckGatewayBfd, Ipv6Route_CheckGatewayBfdMultihop:
+		return true
+	}
+	return false
+}
+
+func (e Ipv6Route_CheckGateway) String() string {
+	return string(e)
+}
+
+func (e *Ipv6Route_CheckGateway) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*e = Ipv6Route_CheckGateway(s)
+	if s != "" && !e.IsKnown() {
+		reportUnknownEnum("Ipv6Route_CheckGateway", s)
+	}
+	return nil
+}
+
+func (e Ipv6Route_CheckGateway) MarshalJSON() ([]byte, error) {
+	if e != "" && !e.IsKnown() {
+		reportUnknownEnum("Ipv6Route_CheckGateway", string(e))
+	}
+	return json.Marshal(string(e))
+}
+
+// Ipv6Route represents a ROS `ipv6/route` record, including read-only fields.
+//
+// IPv6 routes, both static and added dynamically by routing protocols.
+type Ipv6Route struct {
+	Record
+
+	// Destination prefix of the route, eg. 2001:db8::/32.
+	DstAddress IPNet `json:"dst-address"`
+	// Gateways of the route, each an IP address, an interface, or an IP address reachable through an interface, eg. fe80::1%ether1. ECMP routes have more than one. Empty for routes without a gateway, eg. blackholes.
+	Gateway GatewayList `json:"gateway"`
+	// Administrative distance of the route, used to pick between routes to the same destination. Lower is preferred.
+	Distance Number `json:"distance"`
+	// Scope of the route, used when resolving recursive gateways of other routes.
+	Scope Number `json:"scope"`
+	// Maximum scope of the routes through which the gateway of this route can be resolved.
+	TargetScope Number `json:"target-scope"`
+	// Routing table the route belongs to, eg. main.
+	RoutingTable string `json:"routing-table"`
+	// VRF interface through which the gateway is resolved, for routes leaking between VRFs.
+	VRFInterface string `json:"vrf-interface"`
+	// Whether packets matching the route are silently dropped.
+	Blackhole Boolean `json:"blackhole"`
+	// How to check that the gateway is reachable. The route is made inactive while it isn't.
+	CheckGateway Ipv6Route_CheckGateway `json:"check-gateway"`
+	// Short description of the route.
+	Comment string `json:"comment"`
+	// Whether the route is disabled.
+	Disabled Boolean `json:"disabled"`
+	// Whether the route is active, ie. used for forwarding.
+	Active Boolean `json:"active"`
+	// Whether the route was added dynamically, eg. by a routing protocol or for a connected network.
+	Dynamic Boolean `json:"dynamic"`
+	// Whether the route is one of several equal cost routes to the same destination.
+	ECMP Boolean `json:"ecmp"`
+	// Gateways the route is resolved to, ie. the directly connected next hops and their interfaces. Empty if unresolved.
+	ImmediateGW GatewayList `json:"immediate-gw"`
+}
+
+// Ipv6Route_Update is an update to a ROS `ipv6/route` record. Any unset field will not be updated.
+type Ipv6Route_Update struct {
+	// Destination prefix of the route, eg. 2001:db8::/32.
+	DstAddress *IPNet `json:"dst-address,omitempty"`
+	// Gateways of the route, each an IP address, an interface, or an IP address reachable through an interface, eg. fe80::1%ether1. ECMP routes have more than one. Empty for routes without a gateway, eg. blackholes.
+	Gateway *GatewayList `json:"gateway,omitempty"`
+	// Administrative distance of the route, used to pick between routes to the same destination. Lower is preferred.
+	Distance *Number `json:"distance,omitempty"`
+	// Scope of the route, used when resolving recursive gateways of other routes.
+	Scope *Number `json:"scope,omitempty"`
+	// Maximum scope of the routes through which the gateway of this route can be resolved.
+	TargetScope *Number `json:"target-scope,omitempty"`
+	// Routing table the route belongs to, eg. main.
+	RoutingTable *string `json:"routing-table,omitempty"`
+	// VRF interface through which the gateway is resolved, for routes leaking between VRFs.
+	VRFInterface *string `json:"vrf-interface,omitempty"`
+	// Whether packets matching the route are silently dropped.
+	Blackhole *Boolean `json:"blackhole,omitempty"`
+	// How to check that the gateway is reachable. The route is made inactive while it isn't.
+	CheckGateway *Ipv6Route_CheckGateway `json:"check-gateway,omitempty"`
+	// Short description of the route.
+	Comment *string `json:"comment,omitempty"`
+	// Whether the route is disabled.
+	Disabled *Boolean `json:"disabled,omitempty"`
+}
+
+// ToUpdate returns an update setting all settable fields of a `ipv6/route` record to
+// their values in r. Fields unset in r which ROS doesn't accept empty (eg.
+// addresses, port lists or enums) are left out, so that listed records can be
+// written back. List values are shared between r and the update.
+func (r *Ipv6Route) ToUpdate() *Ipv6Route_Update {
+	u := &Ipv6Route_Update{}
+	if r.DstAddress.Address != nil {
+		u.DstAddress = new(IPNet)
+		*u.DstAddress = r.DstAddress
+	}
+	if len(r.Gateway) != 0 {
+		u.Gateway = new(GatewayList)
+		*u.Gateway = r.Gateway
+	}
+	u.Distance = new(Number)
+	*u.Distance = r.Distance
+	u.Scope = new(Number)
+	*u.Scope = r.Scope
+	u.TargetScope = new(Number)
+	*u.TargetScope = r.TargetScope
+	u.RoutingTable = new(string)
+	*u.RoutingTable = r.RoutingTable
+	u.VRFInterface = new(string)
+	*u.VRFInterface = r.VRFInterface
+	u.Blackhole = new(Boolean)
+	*u.Blackhole = r.Blackhole
+	if r.CheckGateway != "" {
+		u.CheckGateway = new(Ipv6Route_CheckGateway)
+		*u.CheckGateway = r.CheckGateway
+	}
+	u.Comment = new(string)
+	*u.Comment = r.Comment
+	u.Disabled = new(Boolean)
+	*u.Disabled = r.Disabled
+	return u
+}
+
+// DiffIpv6Route returns an update which changes current into desired, setting only
+// the settable fields that differ between them. Read-only fields, and fields
+// left out by ToUpdate when unset in desired, are ignored. The update IsEmpty if
+// there is nothing to change.
+func DiffIpv6Route(current, desired *Ipv6Route) *Ipv6Route_Update {
+	u := &Ipv6Route_Update{}
+	if (desired.DstAddress.Address != nil) && !current.DstAddress.Equal(desired.DstAddress) {
+		u.DstAddress = new(IPNet)
+		*u.DstAddress = desired.DstAddress
+	}
+	if (len(desired.Gateway) != 0) && !current.Gateway.Equal(desired.Gateway) {
+		u.Gateway = new(GatewayList)
+		*u.Gateway = desired.Gateway
+	}
+	if current.Distance != desired.Distance {
+		u.Distance = new(Number)
+		*u.Distance = desired.Distance
+	}
+	if current.Scope != desired.Scope {
+		u.Scope = new(Number)
+		*u.Scope = desired.Scope
+	}
+	if current.TargetScope != desired.TargetScope {
+		u.TargetScope = new(Number)
+		*u.TargetScope = desired.TargetScope
+	}
+	if current.RoutingTable != desired.RoutingTable {
+		u.RoutingTable = new(string)
+		*u.RoutingTable = desired.RoutingTable
+	}
+	if current.VRFInterface != desired.VRFInterface {
+		u.VRFInterface = new(string)
+		*u.VRFInterface = desired.VRFInterface
+	}
+	if current.Blackhole != desired.Blackhole {
+		u.Blackhole = new(Boolean)
+		*u.Blackhole = desired.Blackhole
+	}
+	if (desired.CheckGateway != "") && current.CheckGateway != desired.CheckGateway {
+		u.CheckGateway = new(Ipv6Route_CheckGateway)
+		*u.CheckGateway = desired.CheckGateway
+	}
+	if current.Comment != desired.Comment {
+		u.Comment = new(string)
+		*u.Comment = desired.Comment
+	}
+	if current.Disabled != desired.Disabled {
+		u.Disabled = new(Boolean)
+		*u.Disabled = desired.Disabled
+	}
+	return u
+}
+
+// IsEmpty returns whether the update does not set any field, ie. applying it
+// would be a no-op.
+func (u *Ipv6Route_Update) IsEmpty() bool {
+	return u.DstAddress == nil &&
+		u.Gateway == nil &&
+		u.Distance == nil &&
+		u.Scope == nil &&
+		u.TargetScope == nil &&
+		u.RoutingTable == nil &&
+		u.VRFInterface == nil &&
+		u.Blackhole == nil &&
+		u.CheckGateway == nil &&
+		u.Comment == nil &&
+		u.Disabled == nil
+}
+
+// Ipv6Route_Field is the name of a `ipv6/route` record property, for use in .proplist
+// projections.
+type Ipv6Route_Field string
+
+const (
+	Ipv6Route_FieldID           Ipv6Route_Field = ".id"
+	Ipv6Route_FieldDstAddress   Ipv6Route_Field = "dst-address"
+	Ipv6Route_FieldGateway      Ipv6Route_Field = "gateway"
+	Ipv6Route_FieldDistance     Ipv6Route_Field = "distance"
+	Ipv6Route_FieldScope        Ipv6Route_Field = "scope"
+	Ipv6Route_FieldTargetScope  Ipv6Route_Field = "target-scope"
+	Ipv6Route_FieldRoutingTable Ipv6Route_Field = "routing-table"
+	Ipv6Route_FieldVRFInterface Ipv6Route_Field = "vrf-interface"
+	Ipv6Route_FieldBlackhole    Ipv6Route_Field = "blackhole"
+	Ipv6Route_FieldCheckGateway Ipv6Route_Field = "check-gateway"
+	Ipv6Route_FieldComment      Ipv6Route_Field = "comment"
+	Ipv6Route_FieldDisabled     Ipv6Route_Field = "disabled"
+	Ipv6Route_FieldActive       Ipv6Route_Field = "active"
+	Ipv6Route_FieldDynamic      Ipv6Route_Field = "dynamic"
+	Ipv6Route_FieldECMP         Ipv6Route_Field = "ecmp"
+	Ipv6Route_FieldImmediateGW  Ipv6Route_Field = "immediate-gw"
+)
+
+// Ipv6Route_Filter is an equality filter on `ipv6/route` records, evaluated by ROS.
+// Any unset field will not be filtered on.
+type Ipv6Route_Filter struct {
+	ID           *RecordID               `json:".id,omitempty"`
+	DstAddress   *IPNet                  `json:"dst-address,omitempty"`
+	Gateway      *GatewayList            `json:"gateway,omitempty"`
+	Distance     *Number                 `json:"distance,omitempty"`
+	Scope        *Number                 `json:"scope,omitempty"`
+	TargetScope  *Number                 `json:"target-scope,omitempty"`
+	RoutingTable *string                 `json:"routing-table,omitempty"`
+	VRFInterface *string                 `json:"vrf-interface,omitempty"`
+	Blackhole    *Boolean                `json:"blackhole,omitempty"`
+	CheckGateway *Ipv6Route_CheckGateway `json:"check-gateway,omitempty"`
+	Comment      *string                 `json:"comment,omitempty"`
+	Disabled     *Boolean                `json:"disabled,omitempty"`
+	Active       *Boolean                `json:"active,omitempty"`
+	Dynamic      *Boolean                `json:"dynamic,omitempty"`
+	ECMP         *Boolean                `json:"ecmp,omitempty"`
+	ImmediateGW  *GatewayList            `json:"immediate-gw,omitempty"`
+}
+
+// Ipv6Route_ListOptions limits the records and fields returned by Ipv6RouteListWith.
+type Ipv6Route_ListOptions struct {
+	// Filter, if set, only returns records matching all set fields.
+	Filter *Ipv6Route_Filter
+	// Proplist, if set, only returns the given fields of every record.
+	Proplist []Ipv6Route_Field
+}
+
+// Ipv6RouteList returns a list of all `ipv6/route` records.
+func (c *Client) Ipv6RouteList(ctx context.Context) ([]Ipv6Route, error) {
+	return c.Ipv6RouteListWith(ctx, nil)
+}
+
+// Ipv6RouteListWith returns a list of all `ipv6/route` records, optionally filtered
+// and projected by ROS according to the given options, which may be nil.
+func (c *Client) Ipv6RouteListWith(ctx context.Context, opts *Ipv6Route_ListOptions) ([]Ipv6Route, error) {
+	var filter *Ipv6Route_Filter
+	var proplist []string
+	if opts != nil {
+		filter = opts.Filter
+		for _, f := range opts.Proplist {
+			proplist = append(proplist, string(f))
+		}
+	}
+	query, err := listQuery(filter, proplist)
+	if err != nil {
+		return nil, fmt.Errorf("invalid options: %w", err)
+	}
+	body, err := c.doGET(ctx, "ipv6/route", "", query)
+	if err != nil {
+		return nil, fmt.Errorf("could not GET: %w", err)
+	}
+	defer body.Close()
+
+	var target []Ipv6Route
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return target, nil
+}
+
+// Ipv6RouteFind returns all `ipv6/route` records matching the given filter, which may
+// be nil. The filter is sent as a print .query and evaluated by ROS. If
+// proplist is given, only the given fields of every record are returned.
+func (c *Client) Ipv6RouteFind(ctx context.Context, filter *Ipv6Route_Filter, proplist ...Ipv6Route_Field) ([]Ipv6Route, error) {
+	var props []string
+	for _, f := range proplist {
+		props = append(props, string(f))
+	}
+	rdata, err := printQuery(filter, props)
+	if err != nil {
+		return nil, fmt.Errorf("invalid filter: %w", err)
+	}
+	body, err := c.doPOST(ctx, "ipv6/route", "print", rdata)
+	if err != nil {
+		return nil, fmt.Errorf("could not POST: %w", err)
+	}
+	defer body.Close()
+
+	var target []Ipv6Route
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return target, nil
+}
+
+// Ipv6RoutePatch updates the given fields of a `ipv6/route` record by ID.
+func (c *Client) Ipv6RoutePatch(ctx context.Context, id RecordID, u *Ipv6Route_Update) (*Ipv6Route, error) {
+	rdata, err := json.Marshal(u)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal update: %w", err)
+	}
+	body, err := c.doPATCH(ctx, "ipv6/route", id, rdata)
+	if err != nil {
+		return nil, fmt.Errorf("could not PATCH: %w", err)
+	}
+	defer body.Close()
+
+	var target Ipv6Route
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return &target, nil
+}
+
+// Ipv6RouteGet returns a single `ipv6/route` record by ID.
+func (c *Client) Ipv6RouteGet(ctx context.Context, id RecordID) (*Ipv6Route, error) {
+	body, err := c.doGET(ctx, "ipv6/route", id, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not GET: %w", err)
+	}
+	defer body.Close()
+
+	var target Ipv6Route
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return &target, nil
+}
+
+// Ipv6RouteAdd creates a new `ipv6/route` record with the given fields set. Any unset
+// field will be populated by ROS with its default value.
+func (c *Client) Ipv6RouteAdd(ctx context.Context, u *Ipv6Route_Update) (*Ipv6Route, error) {
+	rdata, err := json.Marshal(u)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal update: %w", err)
+	}
+	body, err := c.doPUT(ctx, "ipv6/route", rdata)
+	if err != nil {
+		return nil, fmt.Errorf("could not PUT: %w", err)
+	}
+	defer body.Close()
+
+	var target Ipv6Route
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return &target, nil
+}
+
+// Ipv6RouteRemove deletes a `ipv6/route` record by ID.
+func (c *Client) Ipv6RouteRemove(ctx context.Context, id RecordID) error {
+	body, err := c.doDELETE(ctx, "ipv6/route", id)
+	if err != nil {
+		return fmt.Errorf("could not DELETE: %w", err)
+	}
+	body.Close()
+	return nil
+}
+
+// Ipv6Route_Event is a change to a `ipv6/route` record observed by Ipv6RouteWatch.
+type Ipv6Route_Event struct {
+	Type EventType
+	// Before is the record before the change, nil if Type is EventAdded.
+	Before *Ipv6Route
+	// After is the record after the change, nil if Type is EventRemoved.
+	After *Ipv6Route
+	// Err is the polling error if Type is EventError.
+	Err error
+}
+
+// Ipv6RouteWatch polls the `ipv6/route` records every interval and sends an event for
+// every record added, modified or removed since the previous poll, starting
+// with an EventAdded for every existing record. Polling errors are sent as
+// EventError, and retried with backoff. The channel is closed once ctx is
+// canceled, or after a single EventError if interval is not positive.
+func (c *Client) Ipv6RouteWatch(ctx context.Context, interval time.Duration) <-chan Ipv6Route_Event {
+	ch := make(chan Ipv6Route_Event)
+	list := func(ctx context.Context) ([]watchedRecord, error) {
+		records, err := c.Ipv6RouteList(ctx)
+		if err != nil {
+			return nil, err
+		}
+		res := make([]watchedRecord, len(records))
+		for i := range records {
+			res[i] = watchedRecord{id: records[i].ID, record: &records[i]}
+		}
+		return res, nil
+	}
+	emit := func(t EventType, before, after interface{}, err error) bool {
+		ev := Ipv6Route_Event{Type: t, Err: err}
+		if before != nil {
+			ev.Before = before.(*Ipv6Route)
+		}
+		if after != nil {
+			ev.After = after.(*Ipv6Route)
+		}
+		select {
+		case ch <- ev:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+	go watch(ctx, interval, list, emit, func() { close(ch) })
+	return ch
+}
+
+// Ipv6Route_Schema describes the `ipv6/route` menu.
+var Ipv6Route_Schema = &MenuSchema{
+	Path:  "ipv6/route",
+	Table: true,
+	Key:   []string{"dst-address", "gateway", "routing-table"},
+	Properties: []*PropertySchema{
+		{Name: "dst-address", Kind: KindIPPrefix},
+		{Name: "gateway", Kind: KindGatewayList},
+		{Name: "distance", Kind: KindNumber},
+		{Name: "scope", Kind: KindNumber},
+		{Name: "target-scope", Kind: KindNumber},
+		{Name: "routing-table", Kind: KindString},
+		{Name: "vrf-interface", Kind: KindString},
+		{Name: "blackhole", Kind: KindBoolean},
+		{Name: "check-gateway", Kind: KindEnum, Variants: []string{"none", "arp", "ping", "bfd", "bfd-multihop"}},
+		{Name: "comment", Kind: KindString},
+		{Name: "disabled", Kind: KindBoolean},
+		{Name: "active", Kind: KindBoolean, ReadOnly: true},
+		{Name: "dynamic", Kind: KindBoolean, ReadOnly: true},
+		{Name: "ecmp", Kind: KindBoolean, ReadOnly: true},
+		{Name: "immediate-gw", Kind: KindGatewayList, ReadOnly: true},
+	},
+}
+
+func init() {
+	registerMenu(Ipv6Route_Schema)
+}
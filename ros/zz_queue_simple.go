@@ -0,0 +1,409 @@
+package ros
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Automatically generated by github.com/q3k/ros7api/gen, do not edit.
+
+// QueueSimple represents a ROS `queue/simple` record, including read-only fields.
+//
+// Simple queues, which limit the traffic of targets. Queues are evaluated in order, the first one matching a packet is used.
+type QueueSimple struct {
+	Record
+
+	// Name of the queue.
+	Name string `json:"name"`
+	// Addresses or networks whose traffic is limited, eg. 192.168.88.0/24,10.0.0.1/32.
+	Target IPNetList `json:"target"`
+	// Parent queue whose limits also apply, or none.
+	Parent string `json:"parent"`
+	// Maximum upload/download data rate of the target, eg. 10M/20M. 0 means no limit.
+	MaxLimit RatePair `json:"max-limit"`
+	// Upload/download data rate guaranteed to the target, eg. 1M/2M. 0 means none.
+	LimitAt RatePair `json:"limit-at"`
+	// Maximum upload/download data rate of the target while bursting. 0 means no bursts.
+	BurstLimit RatePair `json:"burst-limit"`
+	// Average upload/download data rate below which the target may burst.
+	BurstThreshold RatePair `json:"burst-threshold"`
+	// Short description of the queue.
+	Comment string `json:"comment"`
+	// Whether the queue is disabled.
+	Disabled Boolean `json:"disabled"`
+}
+
+// QueueSimple_Update is an update to a ROS `queue/simple` record. Any unset field will not be updated.
+type QueueSimple_Update struct {
+	// Name of the queue.
+	Name *string `json:"name,omitempty"`
+	// Addresses or networks whose traffic is limited, eg. 192.168.88.0/24,10.0.0.1/32.
+	Target *IPNetList `json:"target,omitempty"`
+	// Parent queue whose limits also apply, or none.
+	Parent *string `json:"parent,omitempty"`
+	// Maximum upload/download data rate of the target, eg. 10M/20M. 0 means no limit.
+	MaxLimit *RatePair `json:"max-limit,omitempty"`
+	// Upload/download data rate guaranteed to the target, eg. 1M/2M. 0 means none.
+	LimitAt *RatePair `json:"limit-at,omitempty"`
+	// Maximum upload/download data rate of the target while bursting. 0 means no bursts.
+	BurstLimit *RatePair `json:"burst-limit,omitempty"`
+	// Average upload/download data rate below which the target may burst.
+	BurstThreshold *RatePair `json:"burst-threshold,omitempty"`
+	// Short description of the queue.
+	Comment *string `json:"comment,omitempty"`
+	// Whether the queue is disabled.
+	Disabled *Boolean `json:"disabled,omitempty"`
+}
+
+// ToUpdate returns an update setting all settable fields of a `queue/simple` record to
+// their values in r. Fields unset in r which ROS doesn't accept empty (eg.
+// addresses, port lists or enums) are left out, so that listed records can be
+// written back. List values are shared between r and the update.
+func (r *QueueSimple) ToUpdate() *QueueSimple_Update {
+	u := &QueueSimple_Update{}
+	u.Name = new(string)
+	*u.Name = r.Name
+	if len(r.Target) != 0 {
+		u.Target = new(IPNetList)
+		*u.Target = r.Target
+	}
+	u.Parent = new(string)
+	*u.Parent = r.Parent
+	u.MaxLimit = new(RatePair)
+	*u.MaxLimit = r.MaxLimit
+	u.LimitAt = new(RatePair)
+	*u.LimitAt = r.LimitAt
+	u.BurstLimit = new(RatePair)
+	*u.BurstLimit = r.BurstLimit
+	u.BurstThreshold = new(RatePair)
+	*u.BurstThreshold = r.BurstThreshold
+	u.Comment = new(string)
+	*u.Comment = r.Comment
+	u.Disabled = new(Boolean)
+	*u.Disabled = r.Disabled
+	return u
+}
+
+// DiffQueueSimple returns an update which changes current into desired, setting only
+// the settable fields that differ between them. Read-only fields, and fields
+// left out by ToUpdate when unset in desired, are ignored. The update IsEmpty if
+// there is nothing to change.
+func DiffQueueSimple(current, desired *QueueSimple) *QueueSimple_Update {
+	u := &QueueSimple_Update{}
+	if current.Name != desired.Name {
+		u.Name = new(string)
+		*u.Name = desired.Name
+	}
+	if (len(desired.Target) != 0) && !current.Target.Equal(desired.Target) {
+		u.Target = new(IPNetList)
+		*u.Target = desired.Target
+	}
+	if current.Parent != desired.Parent {
+		u.Parent = new(string)
+		*u.Parent = desired.Parent
+	}
+	if current.MaxLimit != desired.MaxLimit {
+		u.MaxLimit = new(RatePair)
+		*u.MaxLimit = desired.MaxLimit
+	}
+	if current.LimitAt != desired.LimitAt {
+		u.LimitAt = new(RatePair)
+		*u.LimitAt = desired.LimitAt
+	}
+	if current.BurstLimit != desired.BurstLimit {
+		u.BurstLimit = new(RatePair)
+		*u.BurstLimit = desired.BurstLimit
+	}
+	if current.BurstThreshold != desired.BurstThreshold {
+		u.BurstThreshold = new(RatePair)
+		*u.BurstThreshold = desired.BurstThreshold
+	}
+	if current.Comment != desired.Comment {
+		u.Comment = new(string)
+		*u.Comment = desired.Comment
+	}
+	if current.Disabled != desired.Disabled {
+		u.Disabled = new(Boolean)
+		*u.Disabled = desired.Disabled
+	}
+	return u
+}
+
+// IsEmpty returns whether the update does not set any field, ie. applying it
+// would be a no-op.
+func (u *QueueSimple_Update) IsEmpty() bool {
+	return u.Name == nil &&
+		u.Target == nil &&
+		u.Parent == nil &&
+		u.MaxLimit == nil &&
+		u.LimitAt == nil &&
+		u.BurstLimit == nil &&
+		u.BurstThreshold == nil &&
+		u.Comment == nil &&
+		u.Disabled == nil
+}
+
+// QueueSimple_Field is the name of a `queue/simple` record property, for use in .proplist
+// projections.
+type QueueSimple_Field string
+
+const (
+	QueueSimple_FieldID             QueueSimple_Field = ".id"
+	QueueSimple_FieldName           QueueSimple_Field = "name"
+	QueueSimple_FieldTarget         QueueSimple_Field = "target"
+	QueueSimple_FieldParent         QueueSimple_Field = "parent"
+	QueueSimple_FieldMaxLimit       QueueSimple_Field = "max-limit"
+	QueueSimple_FieldLimitAt        QueueSimple_Field = "limit-at"
+	QueueSimple_FieldBurstLimit     QueueSimple_Field = "burst-limit"
+	QueueSimple_FieldBurstThreshold QueueSimple_Field = "burst-threshold"
+	QueueSimple_FieldComment        QueueSimple_Field = "comment"
+	QueueSimple_FieldDisabled       QueueSimple_Field = "disabled"
+)
+
+// QueueSimple_Filter is an equality filter on `queue/simple` records, evaluated by ROS.
+// Any unset field will not be filtered on.
+type QueueSimple_Filter struct {
+	ID             *RecordID  `json:".id,omitempty"`
+	Name           *string    `json:"name,omitempty"`
+	Target         *IPNetList `json:"target,omitempty"`
+	Parent         *string    `json:"parent,omitempty"`
+	MaxLimit       *RatePair  `json:"max-limit,omitempty"`
+	LimitAt        *RatePair  `json:"limit-at,omitempty"`
+	BurstLimit     *RatePair  `json:"burst-limit,omitempty"`
+	BurstThreshold *RatePair  `json:"burst-threshold,omitempty"`
+	Comment        *string    `json:"comment,omitempty"`
+	Disabled       *Boolean   `json:"disabled,omitempty"`
+}
+
+// QueueSimple_ListOptions limits the records and fields returned by QueueSimpleListWith.
+type QueueSimple_ListOptions struct {
+	// Filter, if set, only returns records matching all set fields.
+	Filter *QueueSimple_Filter
+	// Proplist, if set, only returns the given fields of every record.
+	Proplist []QueueSimple_Field
+}
+
+// QueueSimpleList returns a list of all `queue/simple` records.
+func (c *Client) QueueSimpleList(ctx context.Context) ([]QueueSimple, error) {
+	return c.QueueSimpleListWith(ctx, nil)
+}
+
+// QueueSimpleListWith returns a list of all `queue/simple` records, optionally filtered
+// and projected by ROS according to the given options, which may be nil.
+func (c *Client) QueueSimpleListWith(ctx context.Context, opts *QueueSimple_ListOptions) ([]QueueSimple, error) {
+	var filter *QueueSimple_Filter
+	var proplist []string
+	if opts != nil {
+		filter = opts.Filter
+		for _, f := range opts.Proplist {
+			proplist = append(proplist, string(f))
+		}
+	}
+	query, err := listQuery(filter, proplist)
+	if err != nil {
+		return nil, fmt.Errorf("invalid options: %w", err)
+	}
+	body, err := c.doGET(ctx, "queue/simple", "", query)
+	if err != nil {
+		return nil, fmt.Errorf("could not GET: %w", err)
+	}
+	defer body.Close()
+
+	var target []QueueSimple
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return target, nil
+}
+
+// QueueSimpleFind returns all `queue/simple` records matching the given filter, which may
+// be nil. The filter is sent as a print .query and evaluated by ROS. If
+// proplist is given, only the given fields of every record are returned.
+func (c *Client) QueueSimpleFind(ctx context.Context, filter *QueueSimple_Filter, proplist ...QueueSimple_Field) ([]QueueSimple, error) {
+	var props []string
+	for _, f := range proplist {
+		props = append(props, string(f))
+	}
+	rdata, err := printQuery(filter, props)
+	if err != nil {
+		return nil, fmt.Errorf("invalid filter: %w", err)
+	}
+	body, err := c.doPOST(ctx, "queue/simple", "print", rdata)
+	if err != nil {
+		return nil, fmt.Errorf("could not POST: %w", err)
+	}
+	defer body.Close()
+
+	var target []QueueSimple
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return target, nil
+}
+
+// QueueSimplePatch updates the given fields of a `queue/simple` record by ID.
+func (c *Client) QueueSimplePatch(ctx context.Context, id RecordID, u *QueueSimple_Update) (*QueueSimple, error) {
+	rdata, err := json.Marshal(u)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal update: %w", err)
+	}
+	body, err := c.doPATCH(ctx, "queue/simple", id, rdata)
+	if err != nil {
+		return nil, fmt.Errorf("could not PATCH: %w", err)
+	}
+	defer body.Close()
+
+	var target QueueSimple
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return &target, nil
+}
+
+// QueueSimpleGet returns a single `queue/simple` record by ID.
+func (c *Client) QueueSimpleGet(ctx context.Context, id RecordID) (*QueueSimple, error) {
+	body, err := c.doGET(ctx, "queue/simple", id, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not GET: %w", err)
+	}
+	defer body.Close()
+
+	var target QueueSimple
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return &target, nil
+}
+
+// QueueSimpleAdd creates a new `queue/simple` record with the given fields set. Any unset
+// field will be populated by ROS with its default value.
+func (c *Client) QueueSimpleAdd(ctx context.Context, u *QueueSimple_Update) (*QueueSimple, error) {
+	rdata, err := json.Marshal(u)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal update: %w", err)
+	}
+	body, err := c.doPUT(ctx, "queue/simple", rdata)
+	if err != nil {
+		return nil, fmt.Errorf("could not PUT: %w", err)
+	}
+	defer body.Close()
+
+	var target QueueSimple
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return &target, nil
+}
+
+// QueueSimpleRemove deletes a `queue/simple` record by ID.
+func (c *Client) QueueSimpleRemove(ctx context.Context, id RecordID) error {
+	body, err := c.doDELETE(ctx, "queue/simple", id)
+	if err != nil {
+		return fmt.Errorf("could not DELETE: %w", err)
+	}
+	body.Close()
+	return nil
+}
+
+// QueueSimpleAddBefore creates a new `queue/simple` record with the given fields set,
+// placed before the record with the given ID. Any unset field will be
+// populated by ROS with its default value.
+func (c *Client) QueueSimpleAddBefore(ctx context.Context, u *QueueSimple_Update, before RecordID) (*QueueSimple, error) {
+	rdata, err := json.Marshal(struct {
+		*QueueSimple_Update
+		PlaceBefore RecordID `json:"place-before"`
+	}{u, before})
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal update: %w", err)
+	}
+	body, err := c.doPUT(ctx, "queue/simple", rdata)
+	if err != nil {
+		return nil, fmt.Errorf("could not PUT: %w", err)
+	}
+	defer body.Close()
+
+	var target QueueSimple
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return &target, nil
+}
+
+// QueueSimpleMove moves the `queue/simple` records with the given IDs, in the given
+// order, before the record with ID before, or to the end if before is empty.
+func (c *Client) QueueSimpleMove(ctx context.Context, ids []RecordID, before RecordID) error {
+	return c.MoveRows(ctx, "queue/simple", ids, before)
+}
+
+// QueueSimple_Event is a change to a `queue/simple` record observed by QueueSimpleWatch.
+type QueueSimple_Event struct {
+	Type EventType
+	// Before is the record before the change, nil if Type is EventAdded.
+	Before *QueueSimple
+	// After is the record after the change, nil if Type is EventRemoved.
+	After *QueueSimple
+	// Err is the polling error if Type is EventError.
+	Err error
+}
+
+// QueueSimpleWatch polls the `queue/simple` records every interval and sends an event for
+// every record added, modified or removed since the previous poll, starting
+// with an EventAdded for every existing record. Polling errors are sent as
+// EventError, and retried with backoff. The channel is closed once ctx is
+// canceled, or after a single EventError if interval is not positive.
+func (c *Client) QueueSimpleWatch(ctx context.Context, interval time.Duration) <-chan QueueSimple_Event {
+	ch := make(chan QueueSimple_Event)
+	list := func(ctx context.Context) ([]watchedRecord, error) {
+		records, err := c.QueueSimpleList(ctx)
+		if err != nil {
+			return nil, err
+		}
+		res := make([]watchedRecord, len(records))
+		for i := range records {
+			res[i] = watchedRecord{id: records[i].ID, record: &records[i]}
+		}
+		return res, nil
+	}
+	emit := func(t EventType, before, after interface{}, err error) bool {
+		ev := QueueSimple_Event{Type: t, Err: err}
+		if before != nil {
+			ev.Before = before.(*QueueSimple)
+		}
+		if after != nil {
+			ev.After = after.(*QueueSimple)
+		}
+		select {
+		case ch <- ev:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+	go watch(ctx, interval, list, emit, func() { close(ch) })
+	return ch
+}
+
+// QueueSimple_Schema describes the `queue/simple` menu.
+var QueueSimple_Schema = &MenuSchema{
+	Path:    "queue/simple",
+	Table:   true,
+	Key:     []string{"name"},
+	Ordered: true,
+	Properties: []*PropertySchema{
+		{Name: "name", Kind: KindString},
+		{Name: "target", Kind: KindIPPrefixList},
+		{Name: "parent", Kind: KindString},
+		{Name: "max-limit", Kind: KindRatePair},
+		{Name: "limit-at", Kind: KindRatePair},
+		{Name: "burst-limit", Kind: KindRatePair},
+		{Name: "burst-threshold", Kind: KindRatePair},
+		{Name: "comment", Kind: KindString},
+		{Name: "disabled", Kind: KindBoolean},
+	},
+}
+
+func init() {
+	registerMenu(QueueSimple_Schema)
+}
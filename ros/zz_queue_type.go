@@ -0,0 +1,417 @@
+package ros
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Automatically generated by github.com/q3k/ros7api/gen, do not edit.
+
+// QueueType_Kind is the type of the `kind` property. Values not known to this library
+// (eg. introduced in newer ROS versions) are kept as-is, but reported
+// through UnknownEnumHandler.
+type QueueType_Kind string
+
+const (
+	// First in, first out queue limited by size in bytes.
+	QueueType_KindBfifo QueueType_Kind = "bfifo"
+	// First in, first out queue limited by number of packets.
+	QueueType_KindPfifo QueueType_Kind = "pfifo"
+	// pfifo with support for multiple transmit queues.
+	QueueType_KindMqPfifo QueueType_Kind = "mq-pfifo"
+	// Random Early Detection.
+	QueueType_KindRed QueueType_Kind = "red"
+	// Stochastic Fairness Queuing.
+	QueueType_KindSfq QueueType_Kind = "sfq"
+	// Per Connection Queuing, limiting each sub-stream to pcq-rate.
+	QueueType_KindPcq QueueType_Kind = "pcq"
+	// Controlled Delay.
+	QueueType_KindCodel QueueType_Kind = "codel"
+	// Fair Queuing Controlled Delay.
+	QueueType_KindFqCodel QueueType_Kind = "fq-codel"
+	// Common Applications Kept Enhanced.
+	QueueType_KindCake QueueType_Kind = "cake"
+	// No queuing, only for interface queues of hardware with its own.
+	QueueType_KindNone QueueType_Kind = "none"
+)
+
+// Values returns all values of QueueType_Kind known to this library.
+func (QueueType_Kind) Values() []QueueType_Kind {
+	return []QueueType_Kind{
+		QueueType_KindBfifo,
+		QueueType_KindPfifo,
+		QueueType_KindMqPfifo,
+		QueueType_KindRed,
+		QueueType_KindSfq,
+		QueueType_KindPcq,
+		QueueType_KindCodel,
+		QueueType_KindFqCodel,
+		QueueType_KindCake,
+		QueueType_KindNone,
+	}
+}
+
+// IsKnown returns whether the value is known to this library.
+func (e QueueType_Kind) IsKnown() bool {
+	switch e {
+	case QueueType_KindBfifo, QueueType_KindPfifo, QueueType_KindMqPfifo, QueueType_KindRed, QueueType_KindSfq, QueueType_KindPcq, QueueType_KindCodel, QueueType_KindFqCodel, QueueType_KindCake, QueueType_KindNone:
+		return true
+	}
+	return false
+}
+
+func (e QueueType_Kind) String() string {
+	return string(e)
+}
+
+func (e *QueueType_Kind) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*e = QueueType_Kind(s)
+	if s != "" && !e.IsKnown() {
+		reportUnknownEnum("QueueType_Kind", s)
+	}
+	return nil
+}
+
+func (e QueueType_Kind) MarshalJSON() ([]byte, error) {
+	if e != "" && !e.IsKnown() {
+		reportUnknownEnum("QueueType_Kind", string(e))
+	}
+	return json.Marshal(string(e))
+}
+
+// QueueType represents a ROS `queue/type` record, including read-only fields.
+//
+// Queue types, referenced by simple and tree queues and by interface queues.
+type QueueType struct {
+	Record
+
+	// Name of the queue type.
+	Name string `json:"name"`
+	// Queuing discipline of the queue type.
+	Kind QueueType_Kind `json:"kind"`
+	// Maximum data rate of each sub-stream of a pcq queue, eg. 2M. 0 means no limit.
+	PCQRate Rate `json:"pcq-rate"`
+	// Queue size of a single sub-stream of a pcq queue, in KiB.
+	PCQLimit Number `json:"pcq-limit"`
+	// Maximum amount of data queued in all sub-streams of a pcq queue, in KiB.
+	PCQTotalLimit Number `json:"pcq-total-limit"`
+	// Properties identifying the sub-streams of a pcq queue, eg. src-address,dst-port.
+	PCQClassifier StringList `json:"pcq-classifier"`
+	// Whether the queue type is built into ROS.
+	Default Boolean `json:"default"`
+}
+
+// QueueType_Update is an update to a ROS `queue/type` record. Any unset field will not be updated.
+type QueueType_Update struct {
+	// Name of the queue type.
+	Name *string `json:"name,omitempty"`
+	// Queuing discipline of the queue type.
+	Kind *QueueType_Kind `json:"kind,omitempty"`
+	// Maximum data rate of each sub-stream of a pcq queue, eg. 2M. 0 means no limit.
+	PCQRate *Rate `json:"pcq-rate,omitempty"`
+	// Queue size of a single sub-stream of a pcq queue, in KiB.
+	PCQLimit *Number `json:"pcq-limit,omitempty"`
+	// Maximum amount of data queued in all sub-streams of a pcq queue, in KiB.
+	PCQTotalLimit *Number `json:"pcq-total-limit,omitempty"`
+	// Properties identifying the sub-streams of a pcq queue, eg. src-address,dst-port.
+	PCQClassifier *StringList `json:"pcq-classifier,omitempty"`
+}
+
+// ToUpdate returns an update setting all settable fields of a `queue/type` record to
+// their values in r. Fields unset in r which ROS doesn't accept empty (eg.
+// addresses, port lists or enums) are left out, so that listed records can be
+// written back. List values are shared between r and the update.
+func (r *QueueType) ToUpdate() *QueueType_Update {
+	u := &QueueType_Update{}
+	u.Name = new(string)
+	*u.Name = r.Name
+	if r.Kind != "" {
+		u.Kind = new(QueueType_Kind)
+		*u.Kind = r.Kind
+	}
+	u.PCQRate = new(Rate)
+	*u.PCQRate = r.PCQRate
+	u.PCQLimit = new(Number)
+	*u.PCQLimit = r.PCQLimit
+	u.PCQTotalLimit = new(Number)
+	*u.PCQTotalLimit = r.PCQTotalLimit
+	u.PCQClassifier = new(StringList)
+	*u.PCQClassifier = r.PCQClassifier
+	return u
+}
+
+// DiffQueueType returns an update which changes current into desired, setting only
+// the settable fields that differ between them. Read-only fields, and fields
+// left out by ToUpdate when unset in desired, are ignored. The update IsEmpty if
+// there is nothing to change.
+func DiffQueueType(current, desired *QueueType) *QueueType_Update {
+	u := &QueueType_Update{}
+	if current.Name != desired.Name {
+		u.Name = new(string)
+		*u.Name = desired.Name
+	}
+	if (desired.Kind != "") && current.Kind != desired.Kind {
+		u.Kind = new(QueueType_Kind)
+		*u.Kind = desired.Kind
+	}
+	if current.PCQRate != desired.PCQRate {
+		u.PCQRate = new(Rate)
+		*u.PCQRate = desired.PCQRate
+	}
+	if current.PCQLimit != desired.PCQLimit {
+		u.PCQLimit = new(Number)
+		*u.PCQLimit = desired.PCQLimit
+	}
+	if current.PCQTotalLimit != desired.PCQTotalLimit {
+		u.PCQTotalLimit = new(Number)
+		*u.PCQTotalLimit = desired.PCQTotalLimit
+	}
+	if !current.PCQClassifier.Equal(desired.PCQClassifier) {
+		u.PCQClassifier = new(StringList)
+		*u.PCQClassifier = desired.PCQClassifier
+	}
+	return u
+}
+
+// IsEmpty returns whether the update does not set any field, ie. applying it
+// would be a no-op.
+func (u *QueueType_Update) IsEmpty() bool {
+	return u.Name == nil &&
+		u.Kind == nil &&
+		u.PCQRate == nil &&
+		u.PCQLimit == nil &&
+		u.PCQTotalLimit == nil &&
+		u.PCQClassifier == nil
+}
+
+// QueueType_Field is the name of a `queue/type` record property, for use in .proplist
+// projections.
+type QueueType_Field string
+
+const (
+	QueueType_FieldID            QueueType_Field = ".id"
+	QueueType_FieldName          QueueType_Field = "name"
+	QueueType_FieldKind          QueueType_Field = "kind"
+	QueueType_FieldPCQRate       QueueType_Field = "pcq-rate"
+	QueueType_FieldPCQLimit      QueueType_Field = "pcq-limit"
+	QueueType_FieldPCQTotalLimit QueueType_Field = "pcq-total-limit"
+	QueueType_FieldPCQClassifier QueueType_Field = "pcq-classifier"
+	QueueType_FieldDefault       QueueType_Field = "default"
+)
+
+// QueueType_Filter is an equality filter on `queue/type` records, evaluated by ROS.
+// Any unset field will not be filtered on.
+type QueueType_Filter struct {
+	ID            *RecordID       `json:".id,omitempty"`
+	Name          *string         `json:"name,omitempty"`
+	Kind          *QueueType_Kind `json:"kind,omitempty"`
+	PCQRate       *Rate           `json:"pcq-rate,omitempty"`
+	PCQLimit      *Number         `json:"pcq-limit,omitempty"`
+	PCQTotalLimit *Number         `json:"pcq-total-limit,omitempty"`
+	PCQClassifier *StringList     `json:"pcq-classifier,omitempty"`
+	Default       *Boolean        `json:"default,omitempty"`
+}
+
+// QueueType_ListOptions limits the records and fields returned by QueueTypeListWith.
+type QueueType_ListOptions struct {
+	// Filter, if set, only returns records matching all set fields.
+	Filter *QueueType_Filter
+	// Proplist, if set, only returns the given fields of every record.
+	Proplist []QueueType_Field
+}
+
+// QueueTypeList returns a list of all `queue/type` records.
+func (c *Client) QueueTypeList(ctx context.Context) ([]QueueType, error) {
+	return c.QueueTypeListWith(ctx, nil)
+}
+
+// QueueTypeListWith returns a list of all `queue/type` records, optionally filtered
+// and projected by ROS according to the given options, which may be nil.
+func (c *Client) QueueTypeListWith(ctx context.Context, opts *QueueType_ListOptions) ([]QueueType, error) {
+	var filter *QueueType_Filter
+	var proplist []string
+	if opts != nil {
+		filter = opts.Filter
+		for _, f := range opts.Proplist {
+			proplist = append(proplist, string(f))
+		}
+	}
+	query, err := listQuery(filter, proplist)
+	if err != nil {
+		return nil, fmt.Errorf("invalid options: %w", err)
+	}
+	body, err := c.doGET(ctx, "queue/type", "", query)
+	if err != nil {
+		return nil, fmt.Errorf("could not GET: %w", err)
+	}
+	defer body.Close()
+
+	var target []QueueType
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return target, nil
+}
+
+// QueueTypeFind returns all `queue/type` records matching the given filter, which may
+// be nil. The filter is sent as a print .query and evaluated by ROS. If
+// proplist is given, only the given fields of every record are returned.
+func (c *Client) QueueTypeFind(ctx context.Context, filter *QueueType_Filter, proplist ...QueueType_Field) ([]QueueType, error) {
+	var props []string
+	for _, f := range proplist {
+		props = append(props, string(f))
+	}
+	rdata, err := printQuery(filter, props)
+	if err != nil {
+		return nil, fmt.Errorf("invalid filter: %w", err)
+	}
+	body, err := c.doPOST(ctx, "queue/type", "print", rdata)
+	if err != nil {
+		return nil, fmt.Errorf("could not POST: %w", err)
+	}
+	defer body.Close()
+
+	var target []QueueType
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return target, nil
+}
+
+// QueueTypePatch updates the given fields of a `queue/type` record by ID.
+func (c *Client) QueueTypePatch(ctx context.Context, id RecordID, u *QueueType_Update) (*QueueType, error) {
+	rdata, err := json.Marshal(u)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal update: %w", err)
+	}
+	body, err := c.doPATCH(ctx, "queue/type", id, rdata)
+	if err != nil {
+		return nil, fmt.Errorf("could not PATCH: %w", err)
+	}
+	defer body.Close()
+
+	var target QueueType
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return &target, nil
+}
+
+// QueueTypeGet returns a single `queue/type` record by ID.
+func (c *Client) QueueTypeGet(ctx context.Context, id RecordID) (*QueueType, error) {
+	body, err := c.doGET(ctx, "queue/type", id, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not GET: %w", err)
+	}
+	defer body.Close()
+
+	var target QueueType
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return &target, nil
+}
+
+// QueueTypeAdd creates a new `queue/type` record with the given fields set. Any unset
+// field will be populated by ROS with its default value.
+func (c *Client) QueueTypeAdd(ctx context.Context, u *QueueType_Update) (*QueueType, error) {
+	rdata, err := json.Marshal(u)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal update: %w", err)
+	}
+	body, err := c.doPUT(ctx, "queue/type", rdata)
+	if err != nil {
+		return nil, fmt.Errorf("could not PUT: %w", err)
+	}
+	defer body.Close()
+
+	var target QueueType
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return &target, nil
+}
+
+// QueueTypeRemove deletes a `queue/type` record by ID.
+func (c *Client) QueueTypeRemove(ctx context.Context, id RecordID) error {
+	body, err := c.doDELETE(ctx, "queue/type", id)
+	if err != nil {
+		return fmt.Errorf("could not DELETE: %w", err)
+	}
+	body.Close()
+	return nil
+}
+
+// QueueType_Event is a change to a `queue/type` record observed by QueueTypeWatch.
+type QueueType_Event struct {
+	Type EventType
+	// Before is the record before the change, nil if Type is EventAdded.
+	Before *QueueType
+	// After is the record after the change, nil if Type is EventRemoved.
+	After *QueueType
+	// Err is the polling error if Type is EventError.
+	Err error
+}
+
+// QueueTypeWatch polls the `queue/type` records every interval and sends an event for
+// every record added, modified or removed since the previous poll, starting
+// with an EventAdded for every existing record. Polling errors are sent as
+// EventError, and retried with backoff. The channel is closed once ctx is
+// canceled, or after a single EventError if interval is not positive.
+func (c *Client) QueueTypeWatch(ctx context.Context, interval time.Duration) <-chan QueueType_Event {
+	ch := make(chan QueueType_Event)
+	list := func(ctx context.Context) ([]watchedRecord, error) {
+		records, err := c.QueueTypeList(ctx)
+		if err != nil {
+			return nil, err
+		}
+		res := make([]watchedRecord, len(records))
+		for i := range records {
+			res[i] = watchedRecord{id: records[i].ID, record: &records[i]}
+		}
+		return res, nil
+	}
+	emit := func(t EventType, before, after interface{}, err error) bool {
+		ev := QueueType_Event{Type: t, Err: err}
+		if before != nil {
+			ev.Before = before.(*QueueType)
+		}
+		if after != nil {
+			ev.After = after.(*QueueType)
+		}
+		select {
+		case ch <- ev:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+	go watch(ctx, interval, list, emit, func() { close(ch) })
+	return ch
+}
+
+// QueueType_Schema describes the `queue/type` menu.
+var QueueType_Schema = &MenuSchema{
+	Path:  "queue/type",
+	Table: true,
+	Key:   []string{"name"},
+	Properties: []*PropertySchema{
+		{Name: "name", Kind: KindString},
+		{Name: "kind", Kind: KindEnum, Variants: []string{"bfifo", "pfifo", "mq-pfifo", "red", "sfq", "pcq", "codel", "fq-codel", "cake", "none"}},
+		{Name: "pcq-rate", Kind: KindRate},
+		{Name: "pcq-limit", Kind: KindNumber},
+		{Name: "pcq-total-limit", Kind: KindNumber},
+		{Name: "pcq-classifier", Kind: KindStringList},
+		{Name: "default", Kind: KindBoolean, ReadOnly: true},
+	},
+}
+
+func init() {
+	registerMenu(QueueType_Schema)
+}
@@ -0,0 +1,110 @@
+package ros
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// Automatically generated by github.com/q3k/ros7api/gen, do not edit.
+
+// Ping_Args are the arguments to the ROS `ping` command. Any unset argument will
+// not be passed.
+type Ping_Args struct {
+	// IP address or DNS name of the host to ping.
+	Address *string `json:"address,omitempty"`
+	// How many echo requests to send. Required over REST, as ping otherwise never finishes.
+	Count *Number `json:"count,omitempty"`
+	// Interface through which echo requests are sent.
+	Interface *string `json:"interface,omitempty"`
+	// Routing table used to look up the route to the host.
+	RoutingTable *string `json:"routing-table,omitempty"`
+	// Size of the sent IP packets in bytes.
+	Size *Number `json:"size,omitempty"`
+	// Source address of the sent packets.
+	SrcAddress *IP `json:"src-address,omitempty"`
+}
+
+// Ping_Reply is a row returned by the ROS `ping` command.
+type Ping_Reply struct {
+	// Sequence number of the echo request.
+	Seq Number `json:"seq"`
+	// Host that responded, or the pinged host if there was no response.
+	Host string `json:"host"`
+	Size Number `json:"size"`
+	TTL  Number `json:"ttl"`
+	// Round trip time of this echo request, eg. 1ms.
+	Time Duration `json:"time"`
+	// Error status of this echo request, eg. timeout.
+	Status string `json:"status"`
+	// Number of echo requests sent so far.
+	Sent Number `json:"sent"`
+	// Number of echo replies received so far.
+	Received Number `json:"received"`
+	// Percentage of echo requests without a reply so far.
+	PacketLoss Number   `json:"packet-loss"`
+	MinRTT     Duration `json:"min-rtt"`
+	AvgRTT     Duration `json:"avg-rtt"`
+	MaxRTT     Duration `json:"max-rtt"`
+}
+
+// Ping runs the ROS `ping` command.
+//
+// Sends ICMP echo requests to a host and returns a row per sent request. The count argument must be set over REST, as ping otherwise runs until the request times out.
+func (c *Client) Ping(ctx context.Context, args *Ping_Args) ([]Ping_Reply, error) {
+	rdata := []byte("{}")
+	if args != nil {
+		var err error
+		rdata, err = json.Marshal(args)
+		if err != nil {
+			return nil, fmt.Errorf("could not marshal arguments: %w", err)
+		}
+	}
+	body, err := c.doPOST(ctx, "", "ping", rdata)
+	if err != nil {
+		return nil, fmt.Errorf("could not POST: %w", err)
+	}
+	defer body.Close()
+
+	var target []Ping_Reply
+	if err := decodeRows(body, &target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return target, nil
+}
+
+// Root_Schema describes the root menu.
+var Root_Schema = &MenuSchema{
+	Path: "",
+	Commands: []*CommandSchema{
+		{
+			Name: "ping",
+			Arguments: []*PropertySchema{
+				{Name: "address", Kind: KindString},
+				{Name: "count", Kind: KindNumber},
+				{Name: "interface", Kind: KindString},
+				{Name: "routing-table", Kind: KindString},
+				{Name: "size", Kind: KindNumber},
+				{Name: "src-address", Kind: KindIP},
+			},
+			Reply: []*PropertySchema{
+				{Name: "seq", Kind: KindNumber},
+				{Name: "host", Kind: KindString},
+				{Name: "size", Kind: KindNumber},
+				{Name: "ttl", Kind: KindNumber},
+				{Name: "time", Kind: KindDuration},
+				{Name: "status", Kind: KindString},
+				{Name: "sent", Kind: KindNumber},
+				{Name: "received", Kind: KindNumber},
+				{Name: "packet-loss", Kind: KindNumber},
+				{Name: "min-rtt", Kind: KindDuration},
+				{Name: "avg-rtt", Kind: KindDuration},
+				{Name: "max-rtt", Kind: KindDuration},
+			},
+		},
+	},
+}
+
+func init() {
+	registerMenu(Root_Schema)
+}
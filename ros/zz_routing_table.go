@@ -0,0 +1,315 @@
+package ros
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Automatically generated by github.com/q3k/ros7api/gen, do not edit.
+
+// RoutingTable represents a ROS `routing/table` record, including read-only fields.
+//
+// Routing tables. The main table always exists, others need to be added before routes can be put in them.
+type RoutingTable struct {
+	Record
+
+	// Name of the routing table.
+	Name string `json:"name"`
+	// Whether the routing table is pushed to the FIB, ie. used for forwarding. Tables without it are only used by routing protocols.
+	FIB Boolean `json:"fib"`
+	// Short description of the routing table.
+	Comment string `json:"comment"`
+	// Whether the routing table is disabled.
+	Disabled Boolean `json:"disabled"`
+	// Whether the routing table was added dynamically, eg. main, or one for a VRF.
+	Dynamic Boolean `json:"dynamic"`
+	Invalid Boolean `json:"invalid"`
+}
+
+// RoutingTable_Update is an update to a ROS `routing/table` record. Any unset field will not be updated.
+type RoutingTable_Update struct {
+	// Name of the routing table.
+	Name *string `json:"name,omitempty"`
+	// Whether the routing table is pushed to the FIB, ie. used for forwarding. Tables without it are only used by routing protocols.
+	FIB *Boolean `json:"fib,omitempty"`
+	// Short description of the routing table.
+	Comment *string `json:"comment,omitempty"`
+	// Whether the routing table is disabled.
+	Disabled *Boolean `json:"disabled,omitempty"`
+}
+
+// ToUpdate returns an update setting all settable fields of a `routing/table` record to
+// their values in r. Fields unset in r which ROS doesn't accept empty (eg.
+// addresses, port lists or enums) are left out, so that listed records can be
+// written back. List values are shared between r and the update.
+func (r *RoutingTable) ToUpdate() *RoutingTable_Update {
+	u := &RoutingTable_Update{}
+	u.Name = new(string)
+	*u.Name = r.Name
+	u.FIB = new(Boolean)
+	*u.FIB = r.FIB
+	u.Comment = new(string)
+	*u.Comment = r.Comment
+	u.Disabled = new(Boolean)
+	*u.Disabled = r.Disabled
+	return u
+}
+
+// DiffRoutingTable returns an update which changes current into desired, setting only
+// the settable fields that differ between them. Read-only fields, and fields
+// left out by ToUpdate when unset in desired, are ignored. The update IsEmpty if
+// there is nothing to change.
+func DiffRoutingTable(current, desired *RoutingTable) *RoutingTable_Update {
+	u := &RoutingTable_Update{}
+	if current.Name != desired.Name {
+		u.Name = new(string)
+		*u.Name = desired.Name
+	}
+	if current.FIB != desired.FIB {
+		u.FIB = new(Boolean)
+		*u.FIB = desired.FIB
+	}
+	if current.Comment != desired.Comment {
+		u.Comment = new(string)
+		*u.Comment = desired.Comment
+	}
+	if current.Disabled != desired.Disabled {
+		u.Disabled = new(Boolean)
+		*u.Disabled = desired.Disabled
+	}
+	return u
+}
+
+// IsEmpty returns whether the update does not set any field, ie. applying it
+// would be a no-op.
+func (u *RoutingTable_Update) IsEmpty() bool {
+	return u.Name == nil &&
+		u.FIB == nil &&
+		u.Comment == nil &&
+		u.Disabled == nil
+}
+
+// RoutingTable_Field is the name of a `routing/table` record property, for use in .proplist
+// projections.
+type RoutingTable_Field string
+
+const (
+	RoutingTable_FieldID       RoutingTable_Field = ".id"
+	RoutingTable_FieldName     RoutingTable_Field = "name"
+	RoutingTable_FieldFIB      RoutingTable_Field = "fib"
+	RoutingTable_FieldComment  RoutingTable_Field = "comment"
+	RoutingTable_FieldDisabled RoutingTable_Field = "disabled"
+	RoutingTable_FieldDynamic  RoutingTable_Field = "dynamic"
+	RoutingTable_FieldInvalid  RoutingTable_Field = "invalid"
+)
+
+// RoutingTable_Filter is an equality filter on `routing/table` records, evaluated by ROS.
+// Any unset field will not be filtered on.
+type RoutingTable_Filter struct {
+	ID       *RecordID `json:".id,omitempty"`
+	Name     *string   `json:"name,omitempty"`
+	FIB      *Boolean  `json:"fib,omitempty"`
+	Comment  *string   `json:"comment,omitempty"`
+	Disabled *Boolean  `json:"disabled,omitempty"`
+	Dynamic  *Boolean  `json:"dynamic,omitempty"`
+	Invalid  *Boolean  `json:"invalid,omitempty"`
+}
+
+// RoutingTable_ListOptions limits the records and fields returned by RoutingTableListWith.
+type RoutingTable_ListOptions struct {
+	// Filter, if set, only returns records matching all set fields.
+	Filter *RoutingTable_Filter
+	// Proplist, if set, only returns the given fields of every record.
+	Proplist []RoutingTable_Field
+}
+
+// RoutingTableList returns a list of all `routing/table` records.
+func (c *Client) RoutingTableList(ctx context.Context) ([]RoutingTable, error) {
+	return c.RoutingTableListWith(ctx, nil)
+}
+
+// RoutingTableListWith returns a list of all `routing/table` records, optionally filtered
+// and projected by ROS according to the given options, which may be nil.
+func (c *Client) RoutingTableListWith(ctx context.Context, opts *RoutingTable_ListOptions) ([]RoutingTable, error) {
+	var filter *RoutingTable_Filter
+	var proplist []string
+	if opts != nil {
+		filter = opts.Filter
+		for _, f := range opts.Proplist {
+			proplist = append(proplist, string(f))
+		}
+	}
+	query, err := listQuery(filter, proplist)
+	if err != nil {
+		return nil, fmt.Errorf("invalid options: %w", err)
+	}
+	body, err := c.doGET(ctx, "routing/table", "", query)
+	if err != nil {
+		return nil, fmt.Errorf("could not GET: %w", err)
+	}
+	defer body.Close()
+
+	var target []RoutingTable
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return target, nil
+}
+
+// RoutingTableFind returns all `routing/table` records matching the given filter, which may
+// be nil. The filter is sent as a print .query and evaluated by ROS. If
+// proplist is given, only the given fields of every record are returned.
+func (c *Client) RoutingTableFind(ctx context.Context, filter *RoutingTable_Filter, proplist ...RoutingTable_Field) ([]RoutingTable, error) {
+	var props []string
+	for _, f := range proplist {
+		props = append(props, string(f))
+	}
+	rdata, err := printQuery(filter, props)
+	if err != nil {
+		return nil, fmt.Errorf("invalid filter: %w", err)
+	}
+	body, err := c.doPOST(ctx, "routing/table", "print", rdata)
+	if err != nil {
+		return nil, fmt.Errorf("could not POST: %w", err)
+	}
+	defer body.Close()
+
+	var target []RoutingTable
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return target, nil
+}
+
+// RoutingTablePatch updates the given fields of a `routing/table` record by ID.
+func (c *Client) RoutingTablePatch(ctx context.Context, id RecordID, u *RoutingTable_Update) (*RoutingTable, error) {
+	rdata, err := json.Marshal(u)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal update: %w", err)
+	}
+	body, err := c.doPATCH(ctx, "routing/table", id, rdata)
+	if err != nil {
+		return nil, fmt.Errorf("could not PATCH: %w", err)
+	}
+	defer body.Close()
+
+	var target RoutingTable
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return &target, nil
+}
+
+// RoutingTableGet returns a single `routing/table` record by ID.
+func (c *Client) RoutingTableGet(ctx context.Context, id RecordID) (*RoutingTable, error) {
+	body, err := c.doGET(ctx, "routing/table", id, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not GET: %w", err)
+	}
+	defer body.Close()
+
+	var target RoutingTable
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return &target, nil
+}
+
+// RoutingTableAdd creates a new `routing/table` record with the given fields set. Any unset
+// field will be populated by ROS with its default value.
+func (c *Client) RoutingTableAdd(ctx context.Context, u *RoutingTable_Update) (*RoutingTable, error) {
+	rdata, err := json.Marshal(u)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal update: %w", err)
+	}
+	body, err := c.doPUT(ctx, "routing/table", rdata)
+	if err != nil {
+		return nil, fmt.Errorf("could not PUT: %w", err)
+	}
+	defer body.Close()
+
+	var target RoutingTable
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return &target, nil
+}
+
+// RoutingTableRemove deletes a `routing/table` record by ID.
+func (c *Client) RoutingTableRemove(ctx context.Context, id RecordID) error {
+	body, err := c.doDELETE(ctx, "routing/table", id)
+	if err != nil {
+		return fmt.Errorf("could not DELETE: %w", err)
+	}
+	body.Close()
+	return nil
+}
+
+// RoutingTable_Event is a change to a `routing/table` record observed by RoutingTableWatch.
+type RoutingTable_Event struct {
+	Type EventType
+	// Before is the record before the change, nil if Type is EventAdded.
+	Before *RoutingTable
+	// After is the record after the change, nil if Type is EventRemoved.
+	After *RoutingTable
+	// Err is the polling error if Type is EventError.
+	Err error
+}
+
+// RoutingTableWatch polls the `routing/table` records every interval and sends an event for
+// every record added, modified or removed since the previous poll, starting
+// with an EventAdded for every existing record. Polling errors are sent as
+// EventError, and retried with backoff. The channel is closed once ctx is
+// canceled, or after a single EventError if interval is not positive.
+func (c *Client) RoutingTableWatch(ctx context.Context, interval time.Duration) <-chan RoutingTable_Event {
+	ch := make(chan RoutingTable_Event)
+	list := func(ctx context.Context) ([]watchedRecord, error) {
+		records, err := c.RoutingTableList(ctx)
+		if err != nil {
+			return nil, err
+		}
+		res := make([]watchedRecord, len(records))
+		for i := range records {
+			res[i] = watchedRecord{id: records[i].ID, record: &records[i]}
+		}
+		return res, nil
+	}
+	emit := func(t EventType, before, after interface{}, err error) bool {
+		ev := RoutingTable_Event{Type: t, Err: err}
+		if before != nil {
+			ev.Before = before.(*RoutingTable)
+		}
+		if after != nil {
+			ev.After = after.(*RoutingTable)
+		}
+		select {
+		case ch <- ev:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+	go watch(ctx, interval, list, emit, func() { close(ch) })
+	return ch
+}
+
+// RoutingTable_Schema describes the `routing/table` menu.
+var RoutingTable_Schema = &MenuSchema{
+	Path:  "routing/table",
+	Table: true,
+	Key:   []string{"name"},
+	Properties: []*PropertySchema{
+		{Name: "name", Kind: KindString},
+		{Name: "fib", Kind: KindBoolean},
+		{Name: "comment", Kind: KindString},
+		{Name: "disabled", Kind: KindBoolean},
+		{Name: "dynamic", Kind: KindBoolean, ReadOnly: true},
+		{Name: "invalid", Kind: KindBoolean, ReadOnly: true},
+	},
+}
+
+func init() {
+	registerMenu(RoutingTable_Schema)
+}
@@ -0,0 +1,242 @@
+package ros
+
+import (
+	"encoding/json"
+)
+
+// Automatically generated by github.com/q3k/ros7api/gen, do not edit.
+
+// FirewallConntrack_ConnectionState is the type of the `connection-state` property. Values not known to this library
+// (eg. introduced in newer ROS versions) are kept as-is, but reported
+// through UnknownEnumHandler.
+type FirewallConntrack_ConnectionState string
+
+const (
+	// The packet belongs to a connection which has seen packets in both directions.
+	FirewallConntrack_ConnectionStateEstablished FirewallConntrack_ConnectionState = "established"
+	// The packet starts a new connection related to an existing one, eg. an FTP data connection.
+	FirewallConntrack_ConnectionStateRelated FirewallConntrack_ConnectionState = "related"
+	// The packet starts a new connection.
+	FirewallConntrack_ConnectionStateNew FirewallConntrack_ConnectionState = "new"
+	// The packet doesn't belong to any known connection, and doesn't start a new one.
+	FirewallConntrack_ConnectionStateInvalid FirewallConntrack_ConnectionState = "invalid"
+	// The packet was excluded from connection tracking by a raw rule.
+	FirewallConntrack_ConnectionStateUntracked FirewallConntrack_ConnectionState = "untracked"
+)
+
+// Values returns all values of FirewallConntrack_ConnectionState known to this library.
+func (FirewallConntrack_ConnectionState) Values() []FirewallConntrack_ConnectionState {
+	return []FirewallConntrack_ConnectionState{
+		FirewallConntrack_ConnectionStateEstablished,
+		FirewallConntrack_ConnectionStateRelated,
+		FirewallConntrack_ConnectionStateNew,
+		FirewallConntrack_ConnectionStateInvalid,
+		FirewallConntrack_ConnectionStateUntracked,
+	}
+}
+
+// IsKnown returns whether the value is known to this library.
+func (e FirewallConntrack_ConnectionState) IsKnown() bool {
+	switch e {
+	case FirewallConntrack_ConnectionStateEstablished, FirewallConntrack_ConnectionStateRelated, FirewallConntrack_ConnectionStateNew, FirewallConntrack_ConnectionStateInvalid, FirewallConntrack_ConnectionStateUntracked:
+		return true
+	}
+	return false
+}
+
+func (e FirewallConntrack_ConnectionState) String() string {
+	return string(e)
+}
+
+func (e *FirewallConntrack_ConnectionState) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*e = FirewallConntrack_ConnectionState(s)
+	if s != "" && !e.IsKnown() {
+		reportUnknownEnum("FirewallConntrack_ConnectionState", s)
+	}
+	return nil
+}
+
+func (e FirewallConntrack_ConnectionState) MarshalJSON() ([]byte, error) {
+	if e != "" && !e.IsKnown() {
+		reportUnknownEnum("FirewallConntrack_ConnectionState", string(e))
+	}
+	return json.Marshal(string(e))
+}
+
+// FirewallConntrack_ConnectionStateList is the type of the `connection-state` property, a list of
+// FirewallConntrack_ConnectionState values. If Not is set, the property matches all values not in
+// the list.
+type FirewallConntrack_ConnectionStateList struct {
+	Not    bool
+	Values []FirewallConntrack_ConnectionState
+}
+
+// FirewallConntrack_ConnectionStateListPtr returns a pointer to FirewallConntrack_ConnectionStateList, for use in _Update structs.
+func FirewallConntrack_ConnectionStateListPtr(not bool, values ...FirewallConntrack_ConnectionState) *FirewallConntrack_ConnectionStateList {
+	return &FirewallConntrack_ConnectionStateList{Not: not, Values: values}
+}
+
+// Equal returns whether both lists contain the same values in the same order,
+// and are negated alike.
+func (l FirewallConntrack_ConnectionStateList) Equal(o FirewallConntrack_ConnectionStateList) bool {
+	if l.Not != o.Not || len(l.Values) != len(o.Values) {
+		return false
+	}
+	for i := range l.Values {
+		if l.Values[i] != o.Values[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func (l FirewallConntrack_ConnectionStateList) String() string {
+	values := make([]string, len(l.Values))
+	for i, v := range l.Values {
+		values[i] = string(v)
+	}
+	return formatEnumList(l.Not, values)
+}
+
+func (l *FirewallConntrack_ConnectionStateList) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	not, values := parseEnumList(s)
+	*l = FirewallConntrack_ConnectionStateList{Not: not}
+	for _, v := range values {
+		e := FirewallConntrack_ConnectionState(v)
+		if !e.IsKnown() {
+			reportUnknownEnum("FirewallConntrack_ConnectionState", v)
+		}
+		l.Values = append(l.Values, e)
+	}
+	return nil
+}
+
+func (l *FirewallConntrack_ConnectionStateList) MarshalJSON() ([]byte, error) {
+	for _, v := range l.Values {
+		if !v.IsKnown() {
+			reportUnknownEnum("FirewallConntrack_ConnectionState", string(v))
+		}
+	}
+	return json.Marshal(l.String())
+}
+
+// FirewallConntrack_ConnectionNatState is the type of the `connection-nat-state` property. Values not known to this library
+// (eg. introduced in newer ROS versions) are kept as-is, but reported
+// through UnknownEnumHandler.
+type FirewallConntrack_ConnectionNatState string
+
+const (
+	// The connection is source NATed.
+	FirewallConntrack_ConnectionNatStateSrcnat FirewallConntrack_ConnectionNatState = "srcnat"
+	// The connection is destination NATed.
+	FirewallConntrack_ConnectionNatStateDstnat FirewallConntrack_ConnectionNatState = "dstnat"
+)
+
+// Values returns all values of FirewallConntrack_ConnectionNatState known to this library.
+func (FirewallConntrack_ConnectionNatState) Values() []FirewallConntrack_ConnectionNatState {
+	return []FirewallConntrack_ConnectionNatState{
+		FirewallConntrack_ConnectionNatStateSrcnat,
+		FirewallConntrack_ConnectionNatStateDstnat,
+	}
+}
+
+// IsKnown returns whether the value is known to this library.
+func (e FirewallConntrack_ConnectionNatState) IsKnown() bool {
+	switch e {
+	case FirewallConntrack_ConnectionNatStateSrcnat, FirewallConntrack_ConnectionNatStateDstnat:
+		return true
+	}
+	return false
+}
+
+func (e FirewallConntrack_ConnectionNatState) String() string {
+	return string(e)
+}
+
+func (e *FirewallConntrack_ConnectionNatState) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*e = FirewallConntrack_ConnectionNatState(s)
+	if s != "" && !e.IsKnown() {
+		reportUnknownEnum("FirewallConntrack_ConnectionNatState", s)
+	}
+	return nil
+}
+
+func (e FirewallConntrack_ConnectionNatState) MarshalJSON() ([]byte, error) {
+	if e != "" && !e.IsKnown() {
+		reportUnknownEnum("FirewallConntrack_ConnectionNatState", string(e))
+	}
+	return json.Marshal(string(e))
+}
+
+// FirewallConntrack_ConnectionNatStateList is the type of the `connection-nat-state` property, a list of
+// FirewallConntrack_ConnectionNatState values. If Not is set, the property matches all values not in
+// the list.
+type FirewallConntrack_ConnectionNatStateList struct {
+	Not    bool
+	Values []FirewallConntrack_ConnectionNatState
+}
+
+// FirewallConntrack_ConnectionNatStateListPtr returns a pointer to FirewallConntrack_ConnectionNatStateList, for use in _Update structs.
+func FirewallConntrack_ConnectionNatStateListPtr(not bool, values ...FirewallConntrack_ConnectionNatState) *FirewallConntrack_ConnectionNatStateList {
+	return &FirewallConntrack_ConnectionNatStateList{Not: not, Values: values}
+}
+
+// Equal returns whether both lists contain the same values in the same order,
+// and are negated alike.
+func (l FirewallConntrack_ConnectionNatStateList) Equal(o FirewallConntrack_ConnectionNatStateList) bool {
+	if l.Not != o.Not || len(l.Values) != len(o.Values) {
+		return false
+	}
+	for i := range l.Values {
+		if l.Values[i] != o.Values[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func (l FirewallConntrack_ConnectionNatStateList) String() string {
+	values := make([]string, len(l.Values))
+	for i, v := range l.Values {
+		values[i] = string(v)
+	}
+	return formatEnumList(l.Not, values)
+}
+
+func (l *FirewallConntrack_ConnectionNatStateList) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	not, values := parseEnumList(s)
+	*l = FirewallConntrack_ConnectionNatStateList{Not: not}
+	for _, v := range values {
+		e := FirewallConntrack_ConnectionNatState(v)
+		if !e.IsKnown() {
+			reportUnknownEnum("FirewallConntrack_ConnectionNatState", v)
+		}
+		l.Values = append(l.Values, e)
+	}
+	return nil
+}
+
+func (l *FirewallConntrack_ConnectionNatStateList) MarshalJSON() ([]byte, error) {
+	for _, v := range l.Values {
+		if !v.IsKnown() {
+			reportUnknownEnum("FirewallConntrack_ConnectionNatState", string(v))
+		}
+	}
+	return json.Marshal(l.String())
+}
@@ -0,0 +1,70 @@
+package ros
+
+import (
+	"encoding/json"
+)
+
+// Automatically generated by github.com/q3k/ros7api/gen, do not edit.
+
+// FirewallRule_Protocol is the type of the `protocol` property. Besides the values below, any
+// other value is accepted.
+type FirewallRule_Protocol string
+
+const (
+	FirewallRule_ProtocolTcp      FirewallRule_Protocol = "tcp"
+	FirewallRule_ProtocolUdp      FirewallRule_Protocol = "udp"
+	FirewallRule_ProtocolIcmp     FirewallRule_Protocol = "icmp"
+	FirewallRule_ProtocolIcmpv6   FirewallRule_Protocol = "icmpv6"
+	FirewallRule_ProtocolGre      FirewallRule_Protocol = "gre"
+	FirewallRule_ProtocolIpsecEsp FirewallRule_Protocol = "ipsec-esp"
+	FirewallRule_ProtocolIpsecAh  FirewallRule_Protocol = "ipsec-ah"
+	FirewallRule_ProtocolOspf     FirewallRule_Protocol = "ospf"
+	FirewallRule_ProtocolVrrp     FirewallRule_Protocol = "vrrp"
+	FirewallRule_ProtocolSctp     FirewallRule_Protocol = "sctp"
+	FirewallRule_ProtocolUdpLite  FirewallRule_Protocol = "udp-lite"
+	FirewallRule_ProtocolIgmp     FirewallRule_Protocol = "igmp"
+)
+
+// Values returns all values of FirewallRule_Protocol known to this library.
+func (FirewallRule_Protocol) Values() []FirewallRule_Protocol {
+	return []FirewallRule_Protocol{
+		FirewallRule_ProtocolTcp,
+		FirewallRule_ProtocolUdp,
+		FirewallRule_ProtocolIcmp,
+		FirewallRule_ProtocolIcmpv6,
+		FirewallRule_ProtocolGre,
+		FirewallRule_ProtocolIpsecEsp,
+		FirewallRule_ProtocolIpsecAh,
+		FirewallRule_ProtocolOspf,
+		FirewallRule_ProtocolVrrp,
+		FirewallRule_ProtocolSctp,
+		FirewallRule_ProtocolUdpLite,
+		FirewallRule_ProtocolIgmp,
+	}
+}
+
+// IsKnown returns whether the value is known to this library.
+func (e FirewallRule_Protocol) IsKnown() bool {
+	switch e {
+	case FirewallRule_ProtocolTcp, FirewallRule_ProtocolUdp, FirewallRule_ProtocolIcmp, FirewallRule_ProtocolIcmpv6, FirewallRule_ProtocolGre, FirewallRule_ProtocolIpsecEsp, FirewallRule_ProtocolIpsecAh, FirewallRule_ProtocolOspf, FirewallRule_ProtocolVrrp, FirewallRule_ProtocolSctp, FirewallRule_ProtocolUdpLite, FirewallRule_ProtocolIgmp:
+		return true
+	}
+	return false
+}
+
+func (e FirewallRule_Protocol) String() string {
+	return string(e)
+}
+
+func (e *FirewallRule_Protocol) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*e = FirewallRule_Protocol(s)
+	return nil
+}
+
+func (e FirewallRule_Protocol) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(e))
+}
@@ -0,0 +1,36 @@
+package ros
+
+import (
+	"context"
+	"fmt"
+)
+
+// Automatically generated by github.com/q3k/ros7api/gen, do not edit.
+
+// SystemReboot runs the ROS `system/reboot` command.
+//
+// Reboots the device.
+func (c *Client) SystemReboot(ctx context.Context) error {
+	rdata := []byte("{}")
+	body, err := c.doPOST(ctx, "system", "reboot", rdata)
+	if err != nil {
+		return fmt.Errorf("could not POST: %w", err)
+	}
+	defer body.Close()
+
+	return nil
+}
+
+// System_Schema describes the `system` menu.
+var System_Schema = &MenuSchema{
+	Path: "system",
+	Commands: []*CommandSchema{
+		{
+			Name: "reboot",
+		},
+	},
+}
+
+func init() {
+	registerMenu(System_Schema)
+}
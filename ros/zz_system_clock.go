@@ -0,0 +1,119 @@
+package ros
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// Automatically generated by github.com/q3k/ros7api/gen, do not edit.
+
+// SystemClock represents the ROS `system/clock` singleton record, including read-only fields.
+//
+// System date and time settings.
+type SystemClock struct {
+	// Whether the time zone should be detected automatically based on the public IP address of the device.
+	TimeZoneAutodetect Boolean `json:"time-zone-autodetect"`
+	// Name of the time zone, eg. Europe/Warsaw, or 'manual'.
+	TimeZoneName string `json:"time-zone-name"`
+	// Current date, eg. jan/02/2022.
+	Date string `json:"date"`
+	// Current time, eg. 13:37:00.
+	Time string `json:"time"`
+	// Current offset from GMT, eg. +01:00.
+	GMTOffset string `json:"gmt-offset"`
+	// Whether daylight saving time is currently in effect.
+	DSTActive Boolean `json:"dst-active"`
+}
+
+// SystemClock_Update is an update to a ROS `system/clock` record. Any unset field will not be updated.
+type SystemClock_Update struct {
+	// Whether the time zone should be detected automatically based on the public IP address of the device.
+	TimeZoneAutodetect *Boolean `json:"time-zone-autodetect,omitempty"`
+	// Name of the time zone, eg. Europe/Warsaw, or 'manual'.
+	TimeZoneName *string `json:"time-zone-name,omitempty"`
+}
+
+// ToUpdate returns an update setting all settable fields of a `system/clock` record to
+// their values in r. Fields unset in r which ROS doesn't accept empty (eg.
+// addresses, port lists or enums) are left out, so that listed records can be
+// written back. List values are shared between r and the update.
+func (r *SystemClock) ToUpdate() *SystemClock_Update {
+	u := &SystemClock_Update{}
+	u.TimeZoneAutodetect = new(Boolean)
+	*u.TimeZoneAutodetect = r.TimeZoneAutodetect
+	u.TimeZoneName = new(string)
+	*u.TimeZoneName = r.TimeZoneName
+	return u
+}
+
+// DiffSystemClock returns an update which changes current into desired, setting only
+// the settable fields that differ between them. Read-only fields, and fields
+// left out by ToUpdate when unset in desired, are ignored. The update IsEmpty if
+// there is nothing to change.
+func DiffSystemClock(current, desired *SystemClock) *SystemClock_Update {
+	u := &SystemClock_Update{}
+	if current.TimeZoneAutodetect != desired.TimeZoneAutodetect {
+		u.TimeZoneAutodetect = new(Boolean)
+		*u.TimeZoneAutodetect = desired.TimeZoneAutodetect
+	}
+	if current.TimeZoneName != desired.TimeZoneName {
+		u.TimeZoneName = new(string)
+		*u.TimeZoneName = desired.TimeZoneName
+	}
+	return u
+}
+
+// IsEmpty returns whether the update does not set any field, ie. applying it
+// would be a no-op.
+func (u *SystemClock_Update) IsEmpty() bool {
+	return u.TimeZoneAutodetect == nil &&
+		u.TimeZoneName == nil
+}
+
+// SystemClockGet returns the `system/clock` singleton record.
+func (c *Client) SystemClockGet(ctx context.Context) (*SystemClock, error) {
+	body, err := c.doGET(ctx, "system/clock", "", nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not GET: %w", err)
+	}
+	defer body.Close()
+
+	var target SystemClock
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return &target, nil
+}
+
+// SystemClockSet updates the given fields of the `system/clock` singleton record.
+func (c *Client) SystemClockSet(ctx context.Context, u *SystemClock_Update) error {
+	rdata, err := json.Marshal(u)
+	if err != nil {
+		return fmt.Errorf("could not marshal update: %w", err)
+	}
+	body, err := c.doPOST(ctx, "system/clock", "set", rdata)
+	if err != nil {
+		return fmt.Errorf("could not POST: %w", err)
+	}
+	body.Close()
+	return nil
+}
+
+// SystemClock_Schema describes the `system/clock` menu.
+var SystemClock_Schema = &MenuSchema{
+	Path:      "system/clock",
+	Singleton: true,
+	Properties: []*PropertySchema{
+		{Name: "time-zone-autodetect", Kind: KindBoolean},
+		{Name: "time-zone-name", Kind: KindString},
+		{Name: "date", Kind: KindString, ReadOnly: true},
+		{Name: "time", Kind: KindString, ReadOnly: true},
+		{Name: "gmt-offset", Kind: KindString, ReadOnly: true},
+		{Name: "dst-active", Kind: KindBoolean, ReadOnly: true},
+	},
+}
+
+func init() {
+	registerMenu(SystemClock_Schema)
+}
@@ -0,0 +1,95 @@
+package ros
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// Automatically generated by github.com/q3k/ros7api/gen, do not edit.
+
+// SystemIdentity represents the ROS `system/identity` singleton record, including read-only fields.
+//
+// Identity is the name of the device, used eg. in the CLI prompt and announced by discovery protocols.
+type SystemIdentity struct {
+	// Name of the device.
+	Name string `json:"name"`
+}
+
+// SystemIdentity_Update is an update to a ROS `system/identity` record. Any unset field will not be updated.
+type SystemIdentity_Update struct {
+	// Name of the device.
+	Name *string `json:"name,omitempty"`
+}
+
+// ToUpdate returns an update setting all settable fields of a `system/identity` record to
+// their values in r. Fields unset in r which ROS doesn't accept empty (eg.
+// addresses, port lists or enums) are left out, so that listed records can be
+// written back. List values are shared between r and the update.
+func (r *SystemIdentity) ToUpdate() *SystemIdentity_Update {
+	u := &SystemIdentity_Update{}
+	u.Name = new(string)
+	*u.Name = r.Name
+	return u
+}
+
+// DiffSystemIdentity returns an update which changes current into desired, setting only
+// the settable fields that differ between them. Read-only fields, and fields
+// left out by ToUpdate when unset in desired, are ignored. The update IsEmpty if
+// there is nothing to change.
+func DiffSystemIdentity(current, desired *SystemIdentity) *SystemIdentity_Update {
+	u := &SystemIdentity_Update{}
+	if current.Name != desired.Name {
+		u.Name = new(string)
+		*u.Name = desired.Name
+	}
+	return u
+}
+
+// IsEmpty returns whether the update does not set any field, ie. applying it
+// would be a no-op.
+func (u *SystemIdentity_Update) IsEmpty() bool {
+	return u.Name == nil
+}
+
+// SystemIdentityGet returns the `system/identity` singleton record.
+func (c *Client) SystemIdentityGet(ctx context.Context) (*SystemIdentity, error) {
+	body, err := c.doGET(ctx, "system/identity", "", nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not GET: %w", err)
+	}
+	defer body.Close()
+
+	var target SystemIdentity
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return &target, nil
+}
+
+// SystemIdentitySet updates the given fields of the `system/identity` singleton record.
+func (c *Client) SystemIdentitySet(ctx context.Context, u *SystemIdentity_Update) error {
+	rdata, err := json.Marshal(u)
+	if err != nil {
+		return fmt.Errorf("could not marshal update: %w", err)
+	}
+	body, err := c.doPOST(ctx, "system/identity", "set", rdata)
+	if err != nil {
+		return fmt.Errorf("could not POST: %w", err)
+	}
+	body.Close()
+	return nil
+}
+
+// SystemIdentity_Schema describes the `system/identity` menu.
+var SystemIdentity_Schema = &MenuSchema{
+	Path:      "system/identity",
+	Singleton: true,
+	Properties: []*PropertySchema{
+		{Name: "name", Kind: KindString},
+	},
+}
+
+func init() {
+	registerMenu(SystemIdentity_Schema)
+}
@@ -0,0 +1,201 @@
+package ros
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// Automatically generated by github.com/q3k/ros7api/gen, do not edit.
+
+// SystemPackageUpdate_Channel is the type of the `channel` property. Values not known to this library
+// (eg. introduced in newer ROS versions) are kept as-is, but reported
+// through UnknownEnumHandler.
+type SystemPackageUpdate_Channel string
+
+const (
+	SystemPackageUpdate_ChannelStable      SystemPackageUpdate_Channel = "stable"
+	SystemPackageUpdate_ChannelLongTerm    SystemPackageUpdate_Channel = "long-term"
+	SystemPackageUpdate_ChannelTesting     SystemPackageUpdate_Channel = "testing"
+	SystemPackageUpdate_ChannelDevelopment SystemPackageUpdate_Channel = "development"
+)
+
+// Values returns all values of SystemPackageUpdate_Channel known to this library.
+func (SystemPackageUpdate_Channel) Values() []SystemPackageUpdate_Channel {
+	return []SystemPackageUpdate_Channel{
+		SystemPackageUpdate_ChannelStable,
+		SystemPackageUpdate_ChannelLongTerm,
+		SystemPackageUpdate_ChannelTesting,
+		SystemPackageUpdate_ChannelDevelopment,
+	}
+}
+
+// IsKnown returns whether the value is known to this library.
+func (e SystemPackageUpdate_Channel) IsKnown() bool {
+	switch e {
+	case SystemPackageUpdate_ChannelStable, SystemPackageUpdate_ChannelLongTerm, SystemPackageUpdate_ChannelTesting, SystemPackageUpdate_ChannelDevelopment:
+		return true
+	}
+	return false
+}
+
+func (e SystemPackageUpdate_Channel) String() string {
+	return string(e)
+}
+
+func (e *SystemPackageUpdate_Channel) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*e = SystemPackageUpdate_Channel(s)
+	if s != "" && !e.IsKnown() {
+		reportUnknownEnum("SystemPackageUpdate_Channel", s)
+	}
+	return nil
+}
+
+func (e SystemPackageUpdate_Channel) MarshalJSON() ([]byte, error) {
+	if e != "" && !e.IsKnown() {
+		reportUnknownEnum("SystemPackageUpdate_Channel", string(e))
+	}
+	return json.Marshal(string(e))
+}
+
+// SystemPackageUpdate represents the ROS `system/package/update` singleton record, including read-only fields.
+//
+// Package update settings and status.
+type SystemPackageUpdate struct {
+	// Release channel used when checking for updates.
+	Channel SystemPackageUpdate_Channel `json:"channel"`
+	// Currently installed RouterOS version.
+	InstalledVersion string `json:"installed-version"`
+	// Latest RouterOS version available in the channel, as of the last check.
+	LatestVersion string `json:"latest-version"`
+	// Human readable status of the last check or update.
+	Status string `json:"status"`
+}
+
+// SystemPackageUpdate_Update is an update to a ROS `system/package/update` record. Any unset field will not be updated.
+type SystemPackageUpdate_Update struct {
+	// Release channel used when checking for updates.
+	Channel *SystemPackageUpdate_Channel `json:"channel,omitempty"`
+}
+
+// ToUpdate returns an update setting all settable fields of a `system/package/update` record to
+// their values in r. Fields unset in r which ROS doesn't accept empty (eg.
+// addresses, port lists or enums) are left out, so that listed records can be
+// written back. List values are shared between r and the update.
+func (r *SystemPackageUpdate) ToUpdate() *SystemPackageUpdate_Update {
+	u := &SystemPackageUpdate_Update{}
+	if r.Channel != "" {
+		u.Channel = new(SystemPackageUpdate_Channel)
+		*u.Channel = r.Channel
+	}
+	return u
+}
+
+// DiffSystemPackageUpdate returns an update which changes current into desired, setting only
+// the settable fields that differ between them. Read-only fields, and fields
+// left out by ToUpdate when unset in desired, are ignored. The update IsEmpty if
+// there is nothing to change.
+func DiffSystemPackageUpdate(current, desired *SystemPackageUpdate) *SystemPackageUpdate_Update {
+	u := &SystemPackageUpdate_Update{}
+	if (desired.Channel != "") && current.Channel != desired.Channel {
+		u.Channel = new(SystemPackageUpdate_Channel)
+		*u.Channel = desired.Channel
+	}
+	return u
+}
+
+// IsEmpty returns whether the update does not set any field, ie. applying it
+// would be a no-op.
+func (u *SystemPackageUpdate_Update) IsEmpty() bool {
+	return u.Channel == nil
+}
+
+// SystemPackageUpdateGet returns the `system/package/update` singleton record.
+func (c *Client) SystemPackageUpdateGet(ctx context.Context) (*SystemPackageUpdate, error) {
+	body, err := c.doGET(ctx, "system/package/update", "", nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not GET: %w", err)
+	}
+	defer body.Close()
+
+	var target SystemPackageUpdate
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return &target, nil
+}
+
+// SystemPackageUpdateSet updates the given fields of the `system/package/update` singleton record.
+func (c *Client) SystemPackageUpdateSet(ctx context.Context, u *SystemPackageUpdate_Update) error {
+	rdata, err := json.Marshal(u)
+	if err != nil {
+		return fmt.Errorf("could not marshal update: %w", err)
+	}
+	body, err := c.doPOST(ctx, "system/package/update", "set", rdata)
+	if err != nil {
+		return fmt.Errorf("could not POST: %w", err)
+	}
+	body.Close()
+	return nil
+}
+
+// SystemPackageUpdateCheckForUpdates_Reply is a row returned by the ROS `system/package/update/check-for-updates` command.
+type SystemPackageUpdateCheckForUpdates_Reply struct {
+	// Update channel that was checked, eg. stable.
+	Channel string `json:"channel"`
+	// Currently installed RouterOS version.
+	InstalledVersion string `json:"installed-version"`
+	// Latest RouterOS version available in the channel.
+	LatestVersion string `json:"latest-version"`
+	// Human readable status of the check, eg. 'System is already up to date'.
+	Status string `json:"status"`
+}
+
+// SystemPackageUpdateCheckForUpdates runs the ROS `system/package/update/check-for-updates` command.
+//
+// Checks the upgrade server for a newer RouterOS version in the configured channel.
+func (c *Client) SystemPackageUpdateCheckForUpdates(ctx context.Context) ([]SystemPackageUpdateCheckForUpdates_Reply, error) {
+	rdata := []byte("{}")
+	body, err := c.doPOST(ctx, "system/package/update", "check-for-updates", rdata)
+	if err != nil {
+		return nil, fmt.Errorf("could not POST: %w", err)
+	}
+	defer body.Close()
+
+	var target []SystemPackageUpdateCheckForUpdates_Reply
+	if err := decodeRows(body, &target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return target, nil
+}
+
+// SystemPackageUpdate_Schema describes the `system/package/update` menu.
+var SystemPackageUpdate_Schema = &MenuSchema{
+	Path:      "system/package/update",
+	Singleton: true,
+	Properties: []*PropertySchema{
+		{Name: "channel", Kind: KindEnum, Variants: []string{"stable", "long-term", "testing", "development"}},
+		{Name: "installed-version", Kind: KindString, ReadOnly: true},
+		{Name: "latest-version", Kind: KindString, ReadOnly: true},
+		{Name: "status", Kind: KindString, ReadOnly: true},
+	},
+	Commands: []*CommandSchema{
+		{
+			Name: "check-for-updates",
+			Reply: []*PropertySchema{
+				{Name: "channel", Kind: KindString},
+				{Name: "installed-version", Kind: KindString},
+				{Name: "latest-version", Kind: KindString},
+				{Name: "status", Kind: KindString},
+			},
+		},
+	},
+}
+
+func init() {
+	registerMenu(SystemPackageUpdate_Schema)
+}
@@ -0,0 +1,94 @@
+package ros
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// Automatically generated by github.com/q3k/ros7api/gen, do not edit.
+
+// SystemResource represents the ROS `system/resource` singleton record, including read-only fields.
+//
+// Basic information about the device and its resource usage.
+type SystemResource struct {
+	// Version of RouterOS running on the device, eg. 7.15.2 (stable).
+	Version string `json:"version"`
+	// Time since the device was booted.
+	Uptime Duration `json:"uptime"`
+	// Build time of the running RouterOS version.
+	BuildTime string `json:"build-time"`
+	// CPU usage in percent.
+	CPULoad     Number `json:"cpu-load"`
+	FreeMemory  Bytes  `json:"free-memory"`
+	TotalMemory Bytes  `json:"total-memory"`
+	// CPU architecture, eg. arm64.
+	ArchitectureName string `json:"architecture-name"`
+	// Model of the device, eg. CRS326-24G-2S+.
+	BoardName string `json:"board-name"`
+	Platform  string `json:"platform"`
+}
+
+// SystemResource_Update is an update to a ROS `system/resource` record. Any unset field will not be updated.
+type SystemResource_Update struct {
+}
+
+// ToUpdate returns an update setting all settable fields of a `system/resource` record to
+// their values in r. Fields unset in r which ROS doesn't accept empty (eg.
+// addresses, port lists or enums) are left out, so that listed records can be
+// written back. List values are shared between r and the update.
+func (r *SystemResource) ToUpdate() *SystemResource_Update {
+	u := &SystemResource_Update{}
+	return u
+}
+
+// DiffSystemResource returns an update which changes current into desired, setting only
+// the settable fields that differ between them. Read-only fields, and fields
+// left out by ToUpdate when unset in desired, are ignored. The update IsEmpty if
+// there is nothing to change.
+func DiffSystemResource(current, desired *SystemResource) *SystemResource_Update {
+	u := &SystemResource_Update{}
+	return u
+}
+
+// IsEmpty returns whether the update does not set any field, ie. applying it
+// would be a no-op.
+func (u *SystemResource_Update) IsEmpty() bool {
+	return true
+}
+
+// SystemResourceGet returns the `system/resource` singleton record.
+func (c *Client) SystemResourceGet(ctx context.Context) (*SystemResource, error) {
+	body, err := c.doGET(ctx, "system/resource", "", nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not GET: %w", err)
+	}
+	defer body.Close()
+
+	var target SystemResource
+	if err := json.NewDecoder(body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	return &target, nil
+}
+
+// SystemResource_Schema describes the `system/resource` menu.
+var SystemResource_Schema = &MenuSchema{
+	Path:      "system/resource",
+	Singleton: true,
+	Properties: []*PropertySchema{
+		{Name: "version", Kind: KindString, ReadOnly: true},
+		{Name: "uptime", Kind: KindDuration, ReadOnly: true},
+		{Name: "build-time", Kind: KindString, ReadOnly: true},
+		{Name: "cpu-load", Kind: KindNumber, ReadOnly: true},
+		{Name: "free-memory", Kind: KindBytes, ReadOnly: true},
+		{Name: "total-memory", Kind: KindBytes, ReadOnly: true},
+		{Name: "architecture-name", Kind: KindString, ReadOnly: true},
+		{Name: "board-name", Kind: KindString, ReadOnly: true},
+		{Name: "platform", Kind: KindString, ReadOnly: true},
+	},
+}
+
+func init() {
+	registerMenu(SystemResource_Schema)
+}
@@ -0,0 +1,679 @@
+// Package rostest implements a fake, in-memory ROS7 REST API server for use in
+// tests of code built on the ros package.
+//
+// The fake implements the REST semantics of every menu known to the ros
+// package (ie. described in gen/types.text.pb): record ID allocation, GET,
+// PUT, PATCH and DELETE of records, print queries, singleton set, moving and
+// placing records of ordered menus, rejection of
+// unknown and read-only properties, validation of typed values and
+// RouterOS-shaped error bodies. Its state can be preloaded and inspected by
+// tests.
+package rostest
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+
+	"github.com/q3k/ros7api/ros"
+)
+
+// Row is a ROS record or command reply row, as a map from property name to
+// serialized ROS value.
+type Row = ros.Row
+
+func copyRow(r Row) Row {
+	res := make(Row)
+	for k, v := range r {
+		res[k] = v
+	}
+	return res
+}
+
+// CommandHandler implements a ROS command on the fake server. It's called with
+// the validated command arguments and returns reply rows, or an error which
+// will be returned to the client as a 400 Bad Request.
+type CommandHandler func(args Row) ([]Row, error)
+
+// table is the state of a table menu.
+type table struct {
+	rows []Row
+}
+
+// Server is a fake ROS7 REST API server. It must be created using NewServer.
+type Server struct {
+	// Username and Password accepted by the server. These can be changed at
+	// any time.
+	Username string
+	Password string
+
+	srv *httptest.Server
+
+	mu         sync.Mutex
+	tables     map[string]*table
+	singletons map[string]Row
+	commands   map[string]CommandHandler
+	nextID     int
+}
+
+// DefaultVersion is the ROS version reported by the server in system/resource,
+// unless changed using Set.
+const DefaultVersion = "7.16 (stable)"
+
+// NewServer starts a new fake server with empty state, accepting the admin
+// user with an empty password (ie. the ROS defaults). The only preloaded
+// record is the version in system/resource.
+func NewServer() *Server {
+	s := &Server{
+		Username:   "admin",
+		tables:     make(map[string]*table),
+		singletons: make(map[string]Row),
+		commands:   make(map[string]CommandHandler),
+		nextID:     1,
+	}
+	s.singletons["system/resource"] = Row{"version": DefaultVersion}
+	s.srv = httptest.NewTLSServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// Close shuts down the server.
+func (s *Server) Close() {
+	s.srv.Close()
+}
+
+// Address returns the address of the server, as used in ros.Client.Address.
+func (s *Server) Address() string {
+	return strings.TrimPrefix(s.srv.URL, "https://")
+}
+
+// Client returns a ros.Client connected to this server using its current
+// credentials.
+func (s *Server) Client() *ros.Client {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return &ros.Client{
+		Address:  s.Address(),
+		Username: s.Username,
+		Password: s.Password,
+		HTTP:     s.srv.Client(),
+	}
+}
+
+// mustMenu returns the schema of the given menu, panicking if it's not known or
+// not of the given type. Used by state manipulation functions.
+func mustMenu(path string, singleton bool) *ros.MenuSchema {
+	m := ros.LookupMenu(path)
+	if m == nil {
+		panic(fmt.Sprintf("unknown menu %q", path))
+	}
+	if singleton && !m.Singleton {
+		panic(fmt.Sprintf("menu %q is not a singleton", path))
+	}
+	if !singleton && !m.Table {
+		panic(fmt.Sprintf("menu %q is not a table", path))
+	}
+	return m
+}
+
+// Add preloads a record into a table menu, returning its newly allocated ID.
+// Read-only properties can be set, but the values must be valid. Add panics
+// if the menu is not a known table, or if any property is invalid.
+func (s *Server) Add(path string, fields Row) ros.RecordID {
+	m := mustMenu(path, false)
+	row, err := validate(m.Properties, fields, true)
+	if err != nil {
+		panic(fmt.Sprintf("invalid record for %q: %v", path, err))
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.add(path, row)
+}
+
+// Set preloads fields of a singleton menu. Read-only properties can be set,
+// but the values must be valid. Set panics if the menu is not a known
+// singleton, or if any property is invalid.
+func (s *Server) Set(path string, fields Row) {
+	m := mustMenu(path, true)
+	row, err := validate(m.Properties, fields, true)
+	if err != nil {
+		panic(fmt.Sprintf("invalid record for %q: %v", path, err))
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.set(path, row)
+}
+
+// Records returns copies of all records within a table menu, with their IDs
+// in the .id property.
+func (s *Server) Records(path string) []Row {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t, ok := s.tables[path]
+	if !ok {
+		return nil
+	}
+	var res []Row
+	for _, r := range t.rows {
+		res = append(res, copyRow(r))
+	}
+	return res
+}
+
+// Record returns a copy of a record within a table menu by ID, or nil if not
+// found.
+func (s *Server) Record(path string, id ros.RecordID) Row {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, r := s.find(path, id)
+	if r == nil {
+		return nil
+	}
+	return copyRow(r)
+}
+
+// Singleton returns a copy of the record within a singleton menu.
+func (s *Server) Singleton(path string) Row {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return copyRow(s.singletons[path])
+}
+
+// HandleCommand sets the handler for a command (eg. system/reboot). Commands
+// without a handler return no rows.
+func (s *Server) HandleCommand(path string, h CommandHandler) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.commands[path] = h
+}
+
+func (s *Server) add(path string, row Row) ros.RecordID {
+	t, ok := s.tables[path]
+	if !ok {
+		t = &table{}
+		s.tables[path] = t
+	}
+	id := ros.RecordID(fmt.Sprintf("*%X", s.nextID))
+	s.nextID++
+	row[".id"] = string(id)
+	t.rows = append(t.rows, row)
+	return id
+}
+
+func (s *Server) set(path string, row Row) {
+	cur, ok := s.singletons[path]
+	if !ok {
+		cur = make(Row)
+		s.singletons[path] = cur
+	}
+	for k, v := range row {
+		cur[k] = v
+	}
+}
+
+// move moves records within a table menu, in the given order, before the
+// record with ID before, or to the end if before is empty.
+func (s *Server) move(path string, ids []ros.RecordID, before ros.RecordID) error {
+	moving := make(map[string]bool)
+	var moved []Row
+	for _, id := range ids {
+		_, rec := s.find(path, id)
+		if rec == nil {
+			return errBadRequest("no such item (%s)", id)
+		}
+		if moving[string(id)] {
+			continue
+		}
+		moving[string(id)] = true
+		moved = append(moved, rec)
+	}
+	if moving[string(before)] {
+		return errBadRequest("cannot move item before itself")
+	}
+	t := s.tables[path]
+	var rest []Row
+	for _, r := range t.rows {
+		if !moving[r[".id"]] {
+			rest = append(rest, r)
+		}
+	}
+	pos := len(rest)
+	if before != "" {
+		pos = -1
+		for i, r := range rest {
+			if r[".id"] == string(before) {
+				pos = i
+			}
+		}
+		if pos == -1 {
+			return errBadRequest("no such item (%s)", before)
+		}
+	}
+	rows := append([]Row{}, rest[:pos]...)
+	rows = append(rows, moved...)
+	t.rows = append(rows, rest[pos:]...)
+	return nil
+}
+
+// find returns the index and record within a table menu by ID, or nil if not
+// found.
+func (s *Server) find(path string, id ros.RecordID) (int, Row) {
+	t, ok := s.tables[path]
+	if !ok {
+		return 0, nil
+	}
+	for i, r := range t.rows {
+		if r[".id"] == string(id) {
+			return i, r
+		}
+	}
+	return 0, nil
+}
+
+// apiError is an error returned to the client in a ROS error body.
+type apiError struct {
+	status int
+	detail string
+}
+
+func (e *apiError) Error() string {
+	return fmt.Sprintf("%d %s: %s", e.status, http.StatusText(e.status), e.detail)
+}
+
+func errBadRequest(format string, args ...interface{}) *apiError {
+	return &apiError{status: http.StatusBadRequest, detail: fmt.Sprintf(format, args...)}
+}
+
+func errNotFound() *apiError {
+	return &apiError{status: http.StatusNotFound, detail: "no such item"}
+}
+
+// validate checks that all given fields are known properties with valid
+// values, and returns them in their canonical form. Read-only properties are
+// only accepted if allowReadOnly is set.
+func validate(properties []*ros.PropertySchema, fields Row, allowReadOnly bool) (Row, error) {
+	res := make(Row)
+	for k, v := range fields {
+		var prop *ros.PropertySchema
+		for _, p := range properties {
+			if p.Name == k {
+				prop = p
+				break
+			}
+		}
+		if prop == nil {
+			return nil, errBadRequest("unknown parameter %s", k)
+		}
+		if prop.ReadOnly && !allowReadOnly {
+			return nil, errBadRequest("property %s is read-only", k)
+		}
+		cv, err := prop.Canonical(v)
+		if err != nil {
+			return nil, errBadRequest("value of %s: %v", k, err)
+		}
+		res[k] = cv
+	}
+	return res, nil
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	e, ok := err.(*apiError)
+	if !ok {
+		e = &apiError{status: http.StatusInternalServerError, detail: err.Error()}
+	}
+	body := map[string]interface{}{
+		"error":   e.status,
+		"message": http.StatusText(e.status),
+	}
+	if e.detail != "" {
+		body["detail"] = e.detail
+	}
+	writeJSON(w, e.status, body)
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	wantUser, wantPass := s.Username, s.Password
+	s.mu.Unlock()
+	user, pass, ok := r.BasicAuth()
+	if !ok || subtle.ConstantTimeCompare([]byte(user), []byte(wantUser)) != 1 || subtle.ConstantTimeCompare([]byte(pass), []byte(wantPass)) != 1 {
+		writeError(w, &apiError{status: http.StatusUnauthorized})
+		return
+	}
+
+	path := strings.TrimPrefix(r.URL.Path, "/rest/")
+	if path == r.URL.Path {
+		writeError(w, &apiError{status: http.StatusNotFound})
+		return
+	}
+
+	var body Row
+	var rawBody map[string]json.RawMessage
+	if r.Method == "PUT" || r.Method == "PATCH" || r.Method == "POST" {
+		if err := json.NewDecoder(r.Body).Decode(&rawBody); err != nil {
+			writeError(w, errBadRequest("could not parse JSON body: %v", err))
+			return
+		}
+		body = make(Row)
+		for k, v := range rawBody {
+			if strings.HasPrefix(k, ".") {
+				// Print options, parsed separately.
+				continue
+			}
+			var s string
+			if err := json.Unmarshal(v, &s); err != nil {
+				writeError(w, errBadRequest("value of %s is not a string", k))
+				return
+			}
+			body[k] = s
+		}
+	}
+
+	s.mu.Lock()
+	res, status, err := s.serve(r, path, body, rawBody)
+	s.mu.Unlock()
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	if status == http.StatusNoContent {
+		w.WriteHeader(status)
+		return
+	}
+	writeJSON(w, status, res)
+}
+
+// serve handles a request to a given REST path (without the /rest/ prefix).
+// It must be called with s.mu held.
+func (s *Server) serve(r *http.Request, path string, body Row, rawBody map[string]json.RawMessage) (interface{}, int, error) {
+	// Requests to a menu itself.
+	if m := ros.LookupMenu(path); m != nil && (m.Table || m.Singleton) {
+		switch {
+		case m.Table && r.Method == "GET":
+			var proplist []string
+			var query []string
+			for k, vs := range r.URL.Query() {
+				if k == ".proplist" {
+					proplist = strings.Split(vs[0], ",")
+					continue
+				}
+				query = append(query, k+"="+vs[0])
+			}
+			return s.print(m, proplist, query)
+		case m.Table && r.Method == "PUT":
+			before, placed := body["place-before"]
+			if m.Ordered {
+				delete(body, "place-before")
+			}
+			row, err := validate(m.Properties, body, false)
+			if err != nil {
+				return nil, 0, err
+			}
+			if placed && m.Ordered {
+				if _, rec := s.find(path, ros.RecordID(before)); rec == nil {
+					return nil, 0, errBadRequest("no such item (place-before)")
+				}
+			}
+			id := s.add(path, row)
+			if placed && m.Ordered {
+				if err := s.move(path, []ros.RecordID{id}, ros.RecordID(before)); err != nil {
+					return nil, 0, err
+				}
+			}
+			_, rec := s.find(path, id)
+			return copyRow(rec), http.StatusOK, nil
+		case m.Singleton && r.Method == "GET":
+			row := copyRow(s.singletons[path])
+			return row, http.StatusOK, nil
+		}
+		return nil, 0, errBadRequest("unsupported method %s on %s", r.Method, path)
+	}
+
+	// Requests to a record, print, set or command within a menu.
+	parent, last := "", path
+	if i := strings.LastIndex(path, "/"); i != -1 {
+		parent, last = path[:i], path[i+1:]
+	}
+	m := ros.LookupMenu(parent)
+	if m == nil {
+		return nil, 0, errBadRequest("no such command or directory (%s)", path)
+	}
+
+	if m.Table && strings.HasPrefix(last, "*") {
+		id := ros.RecordID(last)
+		i, rec := s.find(parent, id)
+		if rec == nil {
+			return nil, 0, errNotFound()
+		}
+		switch r.Method {
+		case "GET":
+			return copyRow(rec), http.StatusOK, nil
+		case "PATCH":
+			row, err := validate(m.Properties, body, false)
+			if err != nil {
+				return nil, 0, err
+			}
+			for k, v := range row {
+				rec[k] = v
+			}
+			return copyRow(rec), http.StatusOK, nil
+		case "DELETE":
+			t := s.tables[parent]
+			t.rows = append(t.rows[:i], t.rows[i+1:]...)
+			return nil, http.StatusNoContent, nil
+		}
+		return nil, 0, errBadRequest("unsupported method %s on %s", r.Method, path)
+	}
+
+	if r.Method != "POST" {
+		return nil, 0, errBadRequest("no such command or directory (%s)", path)
+	}
+	switch {
+	case m.Table && last == "print":
+		var proplist, query []string
+		if v, ok := rawBody[".proplist"]; ok {
+			if err := json.Unmarshal(v, &proplist); err != nil {
+				var s string
+				if err := json.Unmarshal(v, &s); err != nil {
+					return nil, 0, errBadRequest("invalid .proplist")
+				}
+				proplist = strings.Split(s, ",")
+			}
+		}
+		if v, ok := rawBody[".query"]; ok {
+			if err := json.Unmarshal(v, &query); err != nil {
+				return nil, 0, errBadRequest("invalid .query")
+			}
+		}
+		if len(body) != 0 {
+			return nil, 0, errBadRequest("unexpected print arguments")
+		}
+		return s.print(m, proplist, query)
+	case m.Ordered && last == "move":
+		var ids []ros.RecordID
+		for k, v := range body {
+			switch k {
+			case "numbers":
+				for _, id := range strings.Split(v, ",") {
+					if id != "" {
+						ids = append(ids, ros.RecordID(id))
+					}
+				}
+			case "destination":
+			default:
+				return nil, 0, errBadRequest("unknown parameter %s", k)
+			}
+		}
+		if len(ids) == 0 {
+			return nil, 0, errBadRequest("missing numbers")
+		}
+		if err := s.move(parent, ids, ros.RecordID(body["destination"])); err != nil {
+			return nil, 0, err
+		}
+		return []Row{}, http.StatusOK, nil
+	case m.Singleton && last == "set":
+		row, err := validate(m.Properties, body, false)
+		if err != nil {
+			return nil, 0, err
+		}
+		s.set(parent, row)
+		return []Row{}, http.StatusOK, nil
+	}
+
+	c := m.Command(last)
+	if c == nil {
+		return nil, 0, errBadRequest("no such command or directory (%s)", path)
+	}
+	args, err := validate(c.Arguments, body, false)
+	if err != nil {
+		return nil, 0, err
+	}
+	h := s.commands[path]
+	if h == nil {
+		return []Row{}, http.StatusOK, nil
+	}
+	// Run the handler without the lock, so that it can manipulate state.
+	s.mu.Unlock()
+	rows, err := h(args)
+	s.mu.Lock()
+	if err != nil {
+		if _, ok := err.(*apiError); !ok {
+			err = errBadRequest("%v", err)
+		}
+		return nil, 0, err
+	}
+	if rows == nil {
+		rows = []Row{}
+	}
+	return rows, http.StatusOK, nil
+}
+
+// print returns all records of a table menu matching the given query words
+// (eg. vlan-ids=3005, or #| to OR the two previous conditions), projected to
+// the given proplist.
+func (s *Server) print(m *ros.MenuSchema, proplist, query []string) (interface{}, int, error) {
+	var res []Row
+	t := s.tables[m.Path]
+	if t != nil {
+		for _, rec := range t.rows {
+			match, err := evalQuery(m, rec, query)
+			if err != nil {
+				return nil, 0, err
+			}
+			if !match {
+				continue
+			}
+			if len(proplist) == 0 {
+				res = append(res, copyRow(rec))
+				continue
+			}
+			row := make(Row)
+			for _, p := range proplist {
+				if v, ok := rec[p]; ok {
+					row[p] = v
+				}
+			}
+			res = append(res, row)
+		}
+	}
+	if res == nil {
+		res = []Row{}
+	}
+	return res, http.StatusOK, nil
+}
+
+// evalQuery evaluates ROS print query words against a record. Supported words
+// are name=value, name<value, name>value (numbers only), name (has property),
+// -name (does not have property), and the #!, #& and #| stack operations.
+// Values left on the stack are ANDed.
+func evalQuery(m *ros.MenuSchema, rec Row, query []string) (bool, error) {
+	var stack []bool
+	pop := func() (bool, error) {
+		if len(stack) == 0 {
+			return false, errBadRequest("query stack underflow")
+		}
+		v := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		return v, nil
+	}
+	for _, w := range query {
+		switch {
+		case w == "#!":
+			v, err := pop()
+			if err != nil {
+				return false, err
+			}
+			stack = append(stack, !v)
+		case w == "#&" || w == "#|":
+			a, err := pop()
+			if err != nil {
+				return false, err
+			}
+			b, err := pop()
+			if err != nil {
+				return false, err
+			}
+			if w == "#&" {
+				stack = append(stack, a && b)
+			} else {
+				stack = append(stack, a || b)
+			}
+		case strings.HasPrefix(w, "-"):
+			_, ok := rec[w[1:]]
+			stack = append(stack, !ok)
+		default:
+			i := strings.IndexAny(w, "=<>")
+			if i == -1 {
+				_, ok := rec[w]
+				stack = append(stack, ok)
+				continue
+			}
+			name, op, value := w[:i], w[i], w[i+1:]
+			cur, ok := rec[name]
+			if name != ".id" {
+				p := m.Property(name)
+				if p == nil {
+					return false, errBadRequest("unknown property %s in query", name)
+				}
+				cv, err := p.Canonical(value)
+				if err != nil {
+					return false, errBadRequest("value of %s in query: %v", name, err)
+				}
+				value = cv
+				if op != '=' && p.Kind != ros.KindNumber {
+					return false, errBadRequest("cannot compare non-number %s", name)
+				}
+			}
+			switch op {
+			case '=':
+				stack = append(stack, ok && cur == value)
+			default:
+				var a, b ros.Number
+				if !ok || a.UnmarshalJSON([]byte(fmt.Sprintf("%q", cur))) != nil || b.UnmarshalJSON([]byte(fmt.Sprintf("%q", value))) != nil {
+					stack = append(stack, false)
+					continue
+				}
+				if op == '<' {
+					stack = append(stack, a < b)
+				} else {
+					stack = append(stack, a > b)
+				}
+			}
+		}
+	}
+	for _, v := range stack {
+		if !v {
+			return false, nil
+		}
+	}
+	return true, nil
+}
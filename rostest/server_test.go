@@ -0,0 +1,709 @@
+package rostest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/q3k/ros7api/ros"
+)
+
+// TestCRUD exercises the fake server through generated ros.Client methods.
+func TestCRUD(t *testing.T) {
+	ctx := context.Background()
+	s := NewServer()
+	defer s.Close()
+	c := s.Client()
+
+	dyn := s.Add("interface/bridge/vlan", Row{
+		"bridge":   "bridge1",
+		"vlan-ids": "1",
+		"dynamic":  "true",
+	})
+
+	vlan, err := c.InterfaceBridgeVlanAdd(ctx, &ros.InterfaceBridgeVlan_Update{
+		Bridge:  ros.StringPtr("bridge1"),
+		VlanIDs: ros.NumberPtr(3005),
+		Tagged:  ros.StringListPtr("ether1", "ether2"),
+	})
+	if err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+	if vlan.ID == "" || vlan.ID == dyn {
+		t.Errorf("Add returned invalid ID %q", vlan.ID)
+	}
+
+	vlans, err := c.InterfaceBridgeVlanList(ctx)
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if want, got := 2, len(vlans); want != got {
+		t.Fatalf("wanted %d vlans, got %d", want, got)
+	}
+
+	found, err := c.InterfaceBridgeVlanListWith(ctx, &ros.InterfaceBridgeVlan_ListOptions{
+		Filter: &ros.InterfaceBridgeVlan_Filter{Dynamic: ros.BooleanPtr(true)},
+	})
+	if err != nil {
+		t.Fatalf("List with filter: %v", err)
+	}
+	if len(found) != 1 || found[0].ID != dyn {
+		t.Errorf("List with filter returned %+v", found)
+	}
+
+	found, err = c.InterfaceBridgeVlanFind(ctx, &ros.InterfaceBridgeVlan_Filter{
+		VlanIDs: ros.NumberPtr(3005),
+	}, ros.InterfaceBridgeVlan_FieldID, ros.InterfaceBridgeVlan_FieldTagged)
+	if err != nil {
+		t.Fatalf("Find: %v", err)
+	}
+	if len(found) != 1 || found[0].ID != vlan.ID || len(found[0].Tagged) != 2 || found[0].Bridge != "" {
+		t.Errorf("Find returned %+v", found)
+	}
+
+	if _, err := c.InterfaceBridgeVlanPatch(ctx, vlan.ID, &ros.InterfaceBridgeVlan_Update{
+		Disabled: ros.BooleanPtr(true),
+	}); err != nil {
+		t.Fatalf("Patch: %v", err)
+	}
+	if want, got := "true", s.Record("interface/bridge/vlan", vlan.ID)["disabled"]; want != got {
+		t.Errorf("wanted disabled %q, got %q", want, got)
+	}
+
+	got, err := c.InterfaceBridgeVlanGet(ctx, vlan.ID)
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if !got.Disabled || got.VlanIDs != 3005 {
+		t.Errorf("Get returned %+v", got)
+	}
+
+	if err := c.InterfaceBridgeVlanRemove(ctx, vlan.ID); err != nil {
+		t.Fatalf("Remove: %v", err)
+	}
+	if _, err := c.InterfaceBridgeVlanGet(ctx, vlan.ID); !ros.IsNotFound(err) {
+		t.Errorf("Get after Remove should return not found, got %v", err)
+	}
+	if err := c.InterfaceBridgeVlanRemove(ctx, vlan.ID); !ros.IsNotFound(err) {
+		t.Errorf("second Remove should return not found, got %v", err)
+	}
+	if want, got := 1, len(s.Records("interface/bridge/vlan")); want != got {
+		t.Errorf("wanted %d records left, got %d", want, got)
+	}
+}
+
+// TestValidation ensures the fake server rejects requests that ROS would
+// reject.
+func TestValidation(t *testing.T) {
+	ctx := context.Background()
+	s := NewServer()
+	defer s.Close()
+	c := s.Client()
+
+	for i, te := range []struct {
+		method string
+		path   string
+		body   string
+		status int
+		detail string
+	}{
+		{"PUT", "interface/bridge/vlan", `{"dynamic":"true"}`, 400, "property dynamic is read-only"},
+		{"PUT", "interface/bridge/vlan", `{"foo":"bar"}`, 400, "unknown parameter foo"},
+		{"PUT", "interface/bridge/port", `{"edge":"sometimes"}`, 400, `value of edge: unknown edge value "sometimes"`},
+		{"PUT", "interface/bridge/port", `{"pvid":"ten"}`, 400, `value of pvid: invalid pvid: invalid number "ten": strconv.ParseInt: parsing "ten": invalid syntax`},
+		{"GET", "interface/bridge/port/*1337", ``, 404, "no such item"},
+		{"GET", "interface/foo", ``, 400, "no such command or directory (interface/foo)"},
+	} {
+		status, body := rawRequest(t, s, te.method, te.path, te.body)
+		if status != te.status {
+			t.Errorf("%d: wanted status %d, got %d", i, te.status, status)
+		}
+		if int(body["error"].(float64)) != te.status || body["detail"] != te.detail {
+			t.Errorf("%d: unexpected error body %v", i, body)
+		}
+	}
+
+	id := s.Add("interface/bridge/port", Row{"interface": "ether1", "bridge": "bridge1"})
+	if _, err := c.InterfaceBridgePortPatch(ctx, "*1337", &ros.InterfaceBridgePort_Update{
+		PVID: ros.NumberPtr(10),
+	}); !ros.IsNotFound(err) {
+		t.Errorf("Patch of missing record should return not found, got %v", err)
+	}
+	if _, err := c.InterfaceBridgePortPatch(ctx, id, &ros.InterfaceBridgePort_Update{
+		PVID: ros.NumberPtr(10),
+	}); err != nil {
+		t.Errorf("Patch: %v", err)
+	}
+
+	s.Password = "hunter2"
+	if _, err := c.InterfaceBridgePortList(ctx); !ros.IsUnauthorized(err) {
+		t.Errorf("List with bad password should return unauthorized, got %v", err)
+	}
+	if _, err := s.Client().InterfaceBridgePortList(ctx); err != nil {
+		t.Errorf("List with new password: %v", err)
+	}
+}
+
+// TestSingletonAndCommands exercises singleton menus, a command with a custom
+// handler, and a command without one.
+func TestSingletonAndCommands(t *testing.T) {
+	ctx := context.Background()
+	s := NewServer()
+	defer s.Close()
+	c := s.Client()
+
+	s.Set("ip/dns", Row{"servers": "1.1.1.1", "cache-used": "10"})
+	if err := c.IpDnsSet(ctx, &ros.IpDns_Update{
+		AllowRemoteRequests: ros.BooleanPtr(true),
+	}); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+	dns, err := c.IpDnsGet(ctx)
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if !dns.AllowRemoteRequests || dns.CacheUsed != 10 || len(dns.Servers) != 1 {
+		t.Errorf("Get returned %+v", dns)
+	}
+
+	var gotArgs Row
+	s.HandleCommand("ping", func(args Row) ([]Row, error) {
+		gotArgs = args
+		return []Row{{"seq": "0", "host": args["address"], "time": "1ms"}}, nil
+	})
+	rows, err := c.Ping(ctx, &ros.Ping_Args{
+		Address: ros.StringPtr("10.0.0.1"),
+		Count:   ros.NumberPtr(1),
+	})
+	if err != nil {
+		t.Fatalf("Ping: %v", err)
+	}
+	if gotArgs["count"] != "1" || len(rows) != 1 || rows[0].Host != "10.0.0.1" {
+		t.Errorf("unexpected Ping args %v, reply %+v", gotArgs, rows)
+	}
+
+	if err := c.SystemReboot(ctx); err != nil {
+		t.Errorf("Reboot: %v", err)
+	}
+}
+
+// rawRequest performs a request against the fake server without going through
+// ros.Client, returning the status code and decoded JSON body.
+func rawRequest(t *testing.T, s *Server, method, path, body string) (int, map[string]interface{}) {
+	t.Helper()
+	req, err := http.NewRequest(method, "https://"+s.Address()+"/rest/"+path, strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("NewRequest: %v", err)
+	}
+	req.SetBasicAuth(s.Username, s.Password)
+	resp, err := s.srv.Client().Do(req)
+	if err != nil {
+		t.Fatalf("Do: %v", err)
+	}
+	defer resp.Body.Close()
+	var res map[string]interface{}
+	if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+		t.Fatalf("Decode: %v", err)
+	}
+	return resp.StatusCode, res
+}
+
+// TestAddresses ensures IPv4 and IPv6 addresses are (de)serialized into typed
+// prefixes.
+func TestAddresses(t *testing.T) {
+	ctx := context.Background()
+	s := NewServer()
+	defer s.Close()
+	c := s.Client()
+
+	v4, err := ros.ParseIPNet("10.0.0.1/24")
+	if err != nil {
+		t.Fatalf("ParseIPNet: %v", err)
+	}
+	if _, err := c.IpAddressAdd(ctx, &ros.IpAddress_Update{
+		Address:   v4,
+		Interface: ros.StringPtr("bridge1"),
+	}); err != nil {
+		t.Fatalf("IpAddressAdd: %v", err)
+	}
+	v6, err := ros.ParseIPNet("2001:db8::/64")
+	if err != nil {
+		t.Fatalf("ParseIPNet: %v", err)
+	}
+	if _, err := c.Ipv6AddressAdd(ctx, &ros.Ipv6Address_Update{
+		Address:   v6,
+		Interface: ros.StringPtr("bridge1"),
+		EUI64:     ros.BooleanPtr(true),
+		Advertise: ros.BooleanPtr(true),
+	}); err != nil {
+		t.Fatalf("Ipv6AddressAdd: %v", err)
+	}
+	s.Add("ipv6/address", Row{"address": "fe80::1/64", "interface": "bridge1", "dynamic": "true", "link-local": "true"})
+
+	addrs, err := c.IpAddressList(ctx)
+	if err != nil {
+		t.Fatalf("IpAddressList: %v", err)
+	}
+	if len(addrs) != 1 || !addrs[0].Address.Equal(*v4) {
+		t.Errorf("IpAddressList returned %+v", addrs)
+	}
+	addrs6, err := c.Ipv6AddressListWith(ctx, &ros.Ipv6Address_ListOptions{
+		Filter: &ros.Ipv6Address_Filter{EUI64: ros.BooleanPtr(true)},
+	})
+	if err != nil {
+		t.Fatalf("Ipv6AddressList: %v", err)
+	}
+	if len(addrs6) != 1 || !addrs6[0].Address.Equal(*v6) || !bool(addrs6[0].EUI64) {
+		t.Fatalf("Ipv6AddressList returned %+v", addrs6)
+	}
+	if ones, _ := addrs6[0].Address.Network.Mask.Size(); ones != 64 {
+		t.Errorf("wanted /64 prefix, got /%d", ones)
+	}
+}
+
+// TestRoutes ensures routes are (de)serialized with typed gateways.
+func TestRoutes(t *testing.T) {
+	ctx := context.Background()
+	s := NewServer()
+	defer s.Close()
+	c := s.Client()
+
+	dst, err := ros.ParseIPNet("2001:db8::/32")
+	if err != nil {
+		t.Fatalf("ParseIPNet: %v", err)
+	}
+	gw, err := ros.ParseGatewayList("fe80::1%ether1")
+	if err != nil {
+		t.Fatalf("ParseGatewayList: %v", err)
+	}
+	check := ros.Ipv6Route_CheckGatewayPing
+	if _, err := c.Ipv6RouteAdd(ctx, &ros.Ipv6Route_Update{
+		DstAddress:   dst,
+		Gateway:      &gw,
+		Distance:     ros.NumberPtr(10),
+		CheckGateway: &check,
+	}); err != nil {
+		t.Fatalf("Ipv6RouteAdd: %v", err)
+	}
+	routes, err := c.Ipv6RouteList(ctx)
+	if err != nil {
+		t.Fatalf("Ipv6RouteList: %v", err)
+	}
+	if len(routes) != 1 {
+		t.Fatalf("wanted 1 route, got %+v", routes)
+	}
+	if r := routes[0]; !r.Gateway.Equal(gw) || r.Gateway[0].Interface != "ether1" || r.Distance != 10 || r.CheckGateway != check {
+		t.Errorf("Ipv6RouteList returned %+v", r)
+	}
+}
+
+// TestRouteGateways ensures ECMP routes and routes with an empty gateway are
+// listed.
+func TestRouteGateways(t *testing.T) {
+	ctx := context.Background()
+	s := NewServer()
+	defer s.Close()
+	c := s.Client()
+
+	s.Add("ip/route", Row{"dst-address": "0.0.0.0/0", "gateway": "10.0.0.1,10.0.0.2", "immediate-gw": "10.0.0.1%ether1,10.0.0.2%ether2"})
+	s.Add("ip/route", Row{"dst-address": "192.0.2.0/24", "gateway": "", "immediate-gw": "", "blackhole": "true"})
+	routes, err := c.IpRouteList(ctx)
+	if err != nil {
+		t.Fatalf("IpRouteList: %v", err)
+	}
+	if len(routes) != 2 {
+		t.Fatalf("wanted 2 routes, got %+v", routes)
+	}
+	want, err := ros.ParseGatewayList("10.0.0.1,10.0.0.2")
+	if err != nil {
+		t.Fatalf("ParseGatewayList: %v", err)
+	}
+	if r := routes[0]; !r.Gateway.Equal(want) || len(r.ImmediateGW) != 2 || r.ImmediateGW[1].Interface != "ether2" {
+		t.Errorf("ECMP route returned as %+v", r)
+	}
+	if r := routes[1]; len(r.Gateway) != 0 || len(r.ImmediateGW) != 0 || r.ToUpdate().Gateway != nil {
+		t.Errorf("blackhole route returned as %+v", r)
+	}
+}
+
+// TestRouteRoundTrip ensures routes without a gateway can be listed and
+// written back through ToUpdate.
+func TestRouteRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	s := NewServer()
+	defer s.Close()
+	c := s.Client()
+
+	dst, err := ros.ParseIPNet("192.0.2.0/24")
+	if err != nil {
+		t.Fatalf("ParseIPNet: %v", err)
+	}
+	if _, err := c.IpRouteAdd(ctx, &ros.IpRoute_Update{
+		DstAddress: dst,
+		Blackhole:  ros.BooleanPtr(true),
+	}); err != nil {
+		t.Fatalf("IpRouteAdd: %v", err)
+	}
+	routes, err := c.IpRouteList(ctx)
+	if err != nil {
+		t.Fatalf("IpRouteList: %v", err)
+	}
+	if len(routes) != 1 {
+		t.Fatalf("wanted 1 route, got %+v", routes)
+	}
+	r := routes[0]
+	r.Comment = "sinkhole"
+	if _, err := c.IpRoutePatch(ctx, r.ID, r.ToUpdate()); err != nil {
+		t.Fatalf("IpRoutePatch: %v", err)
+	}
+	got, err := c.IpRouteGet(ctx, r.ID)
+	if err != nil {
+		t.Fatalf("IpRouteGet: %v", err)
+	}
+	if got.Comment != "sinkhole" || got.Blackhole != true || !got.DstAddress.Equal(*dst) {
+		t.Errorf("IpRouteGet returned %+v", got)
+	}
+	if u := ros.DiffIpRoute(got, &r); !u.IsEmpty() {
+		t.Errorf("DiffIpRoute returned %+v, wanted empty update", u)
+	}
+}
+
+// TestFirewall ensures firewall rules are (de)serialized with their shared
+// and table-specific properties, and validated per table.
+func TestFirewall(t *testing.T) {
+	ctx := context.Background()
+	s := NewServer()
+	defer s.Close()
+	c := s.Client()
+
+	ports, err := ros.ParsePortList("22,8000-8080")
+	if err != nil {
+		t.Fatalf("ParsePortList: %v", err)
+	}
+	chain := ros.IpFirewallFilter_Chain("ssh-guard")
+	action := ros.IpFirewallFilter_ActionDrop
+	protocol := ros.FirewallRule_ProtocolTcp
+	if _, err := c.IpFirewallFilterAdd(ctx, &ros.IpFirewallFilter_Update{
+		Chain:           &chain,
+		Action:          &action,
+		Protocol:        &protocol,
+		DstPort:         ports,
+		ConnectionState: ros.FirewallConntrack_ConnectionStateListPtr(true, ros.FirewallConntrack_ConnectionStateEstablished, ros.FirewallConntrack_ConnectionStateRelated),
+	}); err != nil {
+		t.Fatalf("IpFirewallFilterAdd: %v", err)
+	}
+	rules, err := c.IpFirewallFilterList(ctx)
+	if err != nil {
+		t.Fatalf("IpFirewallFilterList: %v", err)
+	}
+	if len(rules) != 1 {
+		t.Fatalf("wanted 1 rule, got %+v", rules)
+	}
+	r := rules[0]
+	if r.Chain != chain || r.Action != action || r.Protocol != protocol || !r.DstPort.Equal(*ports) {
+		t.Errorf("IpFirewallFilterList returned %+v", r)
+	}
+	if want, got := "!established,related", r.ConnectionState.String(); want != got {
+		t.Errorf("wanted connection state %q, got %q", want, got)
+	}
+
+	if _, err := c.Ipv6FirewallAddressListAdd(ctx, &ros.Ipv6FirewallAddressList_Update{
+		List:    ros.StringPtr("trusted"),
+		Address: ros.StringPtr("2001:db8::/32"),
+	}); err != nil {
+		t.Fatalf("Ipv6FirewallAddressListAdd: %v", err)
+	}
+
+	for i, te := range []struct {
+		path   string
+		body   string
+		detail string
+	}{
+		{"ip/firewall/filter", `{"connection-state":"established,sideways"}`, `value of connection-state: unknown connection-state value "sideways"`},
+		{"ip/firewall/filter", `{"action":"masquerade"}`, `value of action: unknown action value "masquerade"`},
+		{"ip/firewall/nat", `{"to-ports":"70000"}`, `value of to-ports: invalid to-ports: invalid port range 70000-70000`},
+		{"ip/firewall/raw", `{"connection-state":"new"}`, "unknown parameter connection-state"},
+	} {
+		status, body := rawRequest(t, s, "PUT", te.path, te.body)
+		if status != 400 || body["detail"] != te.detail {
+			t.Errorf("%d: unexpected error %d %v", i, status, body)
+		}
+	}
+}
+
+// TestFirewallRoundTrip ensures rules without ports can be listed and written
+// back through ToUpdate.
+func TestFirewallRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	s := NewServer()
+	defer s.Close()
+	c := s.Client()
+
+	chain := ros.IpFirewallFilter_ChainInput
+	action := ros.IpFirewallFilter_ActionAccept
+	if _, err := c.IpFirewallFilterAdd(ctx, &ros.IpFirewallFilter_Update{
+		Chain:  &chain,
+		Action: &action,
+	}); err != nil {
+		t.Fatalf("IpFirewallFilterAdd: %v", err)
+	}
+	rules, err := c.IpFirewallFilterList(ctx)
+	if err != nil {
+		t.Fatalf("IpFirewallFilterList: %v", err)
+	}
+	if len(rules) != 1 {
+		t.Fatalf("wanted 1 rule, got %+v", rules)
+	}
+	r := rules[0]
+	r.Comment = "allow all"
+	if _, err := c.IpFirewallFilterPatch(ctx, r.ID, r.ToUpdate()); err != nil {
+		t.Fatalf("IpFirewallFilterPatch: %v", err)
+	}
+	got, err := c.IpFirewallFilterGet(ctx, r.ID)
+	if err != nil {
+		t.Fatalf("IpFirewallFilterGet: %v", err)
+	}
+	if got.Comment != "allow all" || got.Chain != chain || got.Action != action {
+		t.Errorf("IpFirewallFilterGet returned %+v", got)
+	}
+	if u := ros.DiffIpFirewallFilter(got, &r); !u.IsEmpty() {
+		t.Errorf("DiffIpFirewallFilter returned %+v, wanted empty update", u)
+	}
+}
+
+// TestQueueTypes ensures rates are (de)serialized and stored in canonical
+// form.
+func TestQueueTypes(t *testing.T) {
+	ctx := context.Background()
+	s := NewServer()
+	defer s.Close()
+	c := s.Client()
+
+	kind := ros.QueueType_KindPcq
+	if _, err := c.QueueTypeAdd(ctx, &ros.QueueType_Update{
+		Name:          ros.StringPtr("pcq-download"),
+		Kind:          &kind,
+		PCQRate:       ros.RatePtr(2000000),
+		PCQClassifier: ros.StringListPtr("dst-address"),
+	}); err != nil {
+		t.Fatalf("QueueTypeAdd: %v", err)
+	}
+	types, err := c.QueueTypeList(ctx)
+	if err != nil {
+		t.Fatalf("QueueTypeList: %v", err)
+	}
+	if len(types) != 1 {
+		t.Fatalf("wanted 1 queue type, got %+v", types)
+	}
+	if r := types[0]; r.Name != "pcq-download" || r.Kind != kind || r.PCQRate != 2000000 {
+		t.Errorf("QueueTypeList returned %+v", r)
+	}
+	if want, got := "2M", s.Records("queue/type")[0]["pcq-rate"]; want != got {
+		t.Errorf("wanted stored rate %q, got %q", want, got)
+	}
+
+	status, body := rawRequest(t, s, "PUT", "queue/type", `{"name":"broken","pcq-rate":"2Mbps"}`)
+	if status != 400 {
+		t.Errorf("unexpected reply to invalid rate: %d %v", status, body)
+	}
+}
+
+// TestQueueSimple ensures simple queue targets and upload/download rate pairs
+// are (de)serialized and stored in canonical form.
+func TestQueueSimple(t *testing.T) {
+	ctx := context.Background()
+	s := NewServer()
+	defer s.Close()
+	c := s.Client()
+
+	target, err := ros.ParseIPNetList("192.168.88.0/24,10.0.0.1/32")
+	if err != nil {
+		t.Fatalf("ParseIPNetList: %v", err)
+	}
+	if _, err := c.QueueSimpleAdd(ctx, &ros.QueueSimple_Update{
+		Name:     ros.StringPtr("lan"),
+		Target:   &target,
+		MaxLimit: ros.RatePairPtr(10000000, 20000000),
+		LimitAt:  ros.RatePairPtr(1000000, 1500000),
+	}); err != nil {
+		t.Fatalf("QueueSimpleAdd: %v", err)
+	}
+	queues, err := c.QueueSimpleList(ctx)
+	if err != nil {
+		t.Fatalf("QueueSimpleList: %v", err)
+	}
+	if len(queues) != 1 {
+		t.Fatalf("wanted 1 queue, got %+v", queues)
+	}
+	if r := queues[0]; !r.Target.Equal(target) || r.MaxLimit.Download != 20000000 || r.LimitAt.Upload != 1000000 {
+		t.Errorf("QueueSimpleList returned %+v", r)
+	}
+	rec := s.Records("queue/simple")[0]
+	if want, got := "10M/20M", rec["max-limit"]; want != got {
+		t.Errorf("wanted stored max-limit %q, got %q", want, got)
+	}
+	if want, got := "192.168.88.0/24,10.0.0.1/32", rec["target"]; want != got {
+		t.Errorf("wanted stored target %q, got %q", want, got)
+	}
+
+	status, body := rawRequest(t, s, "PUT", "queue/simple", `{"name":"broken","max-limit":"10M"}`)
+	if status != 400 {
+		t.Errorf("unexpected reply to invalid rate pair: %d %v", status, body)
+	}
+}
+
+// TestOrdered ensures records of ordered menus can be added before others and
+// moved.
+func TestOrdered(t *testing.T) {
+	ctx := context.Background()
+	s := NewServer()
+	defer s.Close()
+	c := s.Client()
+
+	var ids []ros.RecordID
+	for _, comment := range []string{"a", "b", "c"} {
+		ids = append(ids, s.Add("ip/firewall/nat", Row{"chain": "srcnat", "comment": comment}))
+	}
+	if _, err := c.IpFirewallNatAddBefore(ctx, &ros.IpFirewallNat_Update{Comment: ros.StringPtr("x")}, ids[1]); err != nil {
+		t.Fatalf("AddBefore: %v", err)
+	}
+	if err := c.IpFirewallNatMove(ctx, []ros.RecordID{ids[2], ids[0]}, ids[1]); err != nil {
+		t.Fatalf("Move: %v", err)
+	}
+	if err := c.IpFirewallNatMove(ctx, []ros.RecordID{ids[0]}, ""); err != nil {
+		t.Fatalf("Move to end: %v", err)
+	}
+	rules, err := c.IpFirewallNatList(ctx)
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	var got []string
+	for _, r := range rules {
+		got = append(got, r.Comment)
+	}
+	if want := "x,c,b,a"; strings.Join(got, ",") != want {
+		t.Errorf("wanted order %s, got %v", want, got)
+	}
+
+	for i, te := range []struct {
+		method string
+		path   string
+		body   string
+		detail string
+	}{
+		{"PUT", "ip/firewall/nat", `{"place-before":"*1337"}`, "no such item (place-before)"},
+		{"PUT", "ip/firewall/address-list", `{"place-before":"*1"}`, "unknown parameter place-before"},
+		{"POST", "ip/firewall/nat/move", `{"numbers":"*1337"}`, "no such item (*1337)"},
+		{"POST", "ip/firewall/nat/move", fmt.Sprintf(`{"numbers":%q,"destination":%q}`, ids[0], ids[0]), "cannot move item before itself"},
+	} {
+		status, body := rawRequest(t, s, te.method, te.path, te.body)
+		if status != 400 || body["detail"] != te.detail {
+			t.Errorf("%d: unexpected error %d %v", i, status, body)
+		}
+	}
+}
+
+// TestDHCP ensures pools, static and dynamic leases are (de)serialized with
+// typed ranges, MAC addresses and durations, and that leases can be made
+// static.
+func TestDHCP(t *testing.T) {
+	ctx := context.Background()
+	s := NewServer()
+	defer s.Close()
+	c := s.Client()
+
+	ranges, err := ros.ParseIPRangeList("10.0.0.10-10.0.0.200,10.0.0.250")
+	if err != nil {
+		t.Fatalf("ParseIPRangeList: %v", err)
+	}
+	if _, err := c.IpPoolAdd(ctx, &ros.IpPool_Update{
+		Name:   ros.StringPtr("lan"),
+		Ranges: &ranges,
+	}); err != nil {
+		t.Fatalf("IpPoolAdd: %v", err)
+	}
+	pools, err := c.IpPoolList(ctx)
+	if err != nil {
+		t.Fatalf("IpPoolList: %v", err)
+	}
+	if len(pools) != 1 || !pools[0].Ranges.Equal(ranges) || !pools[0].Ranges.Contains(net.ParseIP("10.0.0.100")) {
+		t.Fatalf("IpPoolList returned %+v", pools)
+	}
+
+	mac, err := net.ParseMAC("4c:5e:0c:01:02:03")
+	if err != nil {
+		t.Fatalf("ParseMAC: %v", err)
+	}
+	if _, err := c.IpDhcpServerLeaseAdd(ctx, &ros.IpDhcpServerLease_Update{
+		Server:     ros.StringPtr("lan"),
+		Address:    ros.IPPtr(net.ParseIP("10.0.0.5")),
+		MACAddress: ros.MACPtr(mac),
+		LeaseTime:  ros.DurationPtr(time.Hour),
+	}); err != nil {
+		t.Fatalf("IpDhcpServerLeaseAdd: %v", err)
+	}
+	dyn := s.Add("ip/dhcp-server/lease", Row{
+		"server":        "lan",
+		"address":       "10.0.0.10",
+		"mac-address":   "4C:5E:0C:0A:0B:0C",
+		"status":        "bound",
+		"expires-after": "9m40s",
+		"last-seen":     "20s",
+		"dynamic":       "true",
+	})
+
+	leases, err := c.IpDhcpServerLeaseListWith(ctx, &ros.IpDhcpServerLease_ListOptions{
+		Filter: &ros.IpDhcpServerLease_Filter{Dynamic: ros.BooleanPtr(true)},
+	})
+	if err != nil {
+		t.Fatalf("IpDhcpServerLeaseList: %v", err)
+	}
+	if len(leases) != 1 {
+		t.Fatalf("wanted 1 dynamic lease, got %+v", leases)
+	}
+	if l := leases[0]; l.ID != dyn || l.MACAddress.String() != "4C:5E:0C:0A:0B:0C" || l.Status != ros.IpDhcpServerLease_StatusBound || l.ExpiresAfter != ros.Duration(9*time.Minute+40*time.Second) {
+		t.Errorf("IpDhcpServerLeaseList returned %+v", l)
+	}
+
+	var gotArgs Row
+	s.HandleCommand("ip/dhcp-server/lease/make-static", func(args Row) ([]Row, error) {
+		gotArgs = args
+		return nil, nil
+	})
+	if err := c.IpDhcpServerLeaseMakeStatic(ctx, &ros.IpDhcpServerLeaseMakeStatic_Args{
+		Numbers: ros.StringListPtr(string(dyn)),
+	}); err != nil {
+		t.Fatalf("IpDhcpServerLeaseMakeStatic: %v", err)
+	}
+	if want, got := string(dyn), gotArgs["numbers"]; want != got {
+		t.Errorf("wanted make-static of %s, got %s", want, got)
+	}
+}
+
+// TestRetryAddBefore ensures adds placing records before others can be retried,
+// ie. that place-before isn't mistaken for a property when checking whether
+// a failed add created the record.
+func TestRetryAddBefore(t *testing.T) {
+	ctx := context.Background()
+	s := NewServer()
+	defer s.Close()
+	c := s.Client()
+	c.Retry = &ros.RetryPolicy{}
+
+	first := s.Add("ip/firewall/filter", Row{"chain": "input", "comment": "a"})
+	if _, err := c.IpFirewallFilterAddBefore(ctx, &ros.IpFirewallFilter_Update{Comment: ros.StringPtr("b")}, first); err != nil {
+		t.Fatalf("AddBefore: %v", err)
+	}
+	var got []string
+	for _, r := range s.Records("ip/firewall/filter") {
+		got = append(got, r["comment"])
+	}
+	if want := "b,a"; strings.Join(got, ",") != want {
+		t.Errorf("wanted order %s, got %v", want, got)
+	}
+}